@@ -72,6 +72,8 @@ var (
 			cert.ProposalHandler,
 			paramsclient.ProposalHandler,
 			shield.ProposalHandler,
+			shield.PoolOperatorProposalHandler,
+			cert.SlashProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -94,6 +96,7 @@ var (
 		gov.ModuleName:            {supply.Burner},
 		oracle.ModuleName:         {supply.Burner},
 		shield.ModuleName:         {supply.Burner},
+		cert.ModuleName:           {supply.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -205,6 +208,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	oracleSubspace := app.paramsKeeper.Subspace(oracle.DefaultParamSpace)
 	cvmSubspace := app.paramsKeeper.Subspace(cvm.DefaultParamSpace)
 	shieldSubspace := app.paramsKeeper.Subspace(shield.DefaultParamSpace)
+	certSubspace := app.paramsKeeper.Subspace(cert.DefaultParamSpace)
 
 	// initialize keepers
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -279,6 +283,8 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		keys[cert.StoreKey],
 		app.slashingKeeper,
 		stakingKeeper,
+		app.supplyKeeper,
+		certSubspace,
 	)
 	app.authKeeper = auth.NewKeeper(
 		app.certKeeper,
@@ -303,6 +309,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.supplyKeeper,
 		shieldSubspace,
 	)
+	app.shieldKeeper.SetReimbursementConverter(shield.NewFixedRateConverter(app.shieldKeeper, app.oracleKeeper, shield.DefaultMinPegScore))
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference so that it will contain these hooks.
 	app.stakingKeeper.Keeper = *stakingKeeper.Keeper.SetHooks(
@@ -358,7 +365,7 @@ func NewCertiKApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	// NOTE: Shield endblocker comes before staking because it queries
 	// unbonding delegations that staking endblocker deletes.
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, cvm.ModuleName, shield.ModuleName, staking.ModuleName, gov.ModuleName, oracle.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, cert.ModuleName, cvm.ModuleName, shield.ModuleName, staking.ModuleName, gov.ModuleName, oracle.ModuleName)
 
 	// NOTE: genutil moodule must occur after staking so that pools
 	// are properly initialized with tokens from genesis accounts.
@@ -3,4 +3,5 @@ package params
 // Default simulation operation weights for messages and gov proposals
 const (
 	DefaultWeightCertifierUpdateProposal int = 5
+	DefaultWeightCertifierSlashProposal  int = 2
 )
@@ -29,6 +29,7 @@ import (
 	"github.com/certikfoundation/shentu/x/auth"
 	certikauthcli "github.com/certikfoundation/shentu/x/auth/client/cli"
 	certikbankcli "github.com/certikfoundation/shentu/x/bank/client/cli"
+	certcli "github.com/certikfoundation/shentu/x/cert/client/cli"
 	cvmcli "github.com/certikfoundation/shentu/x/cvm/client/cli"
 )
 
@@ -71,6 +72,7 @@ func main() {
 		client.ConfigCmd(app.DefaultCLIHome),
 		queryCmd(cdc),
 		txCmd(cdc),
+		certcli.GetOfflineCmd(cdc),
 		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		oracle.ServeCommand(cdc),
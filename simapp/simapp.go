@@ -73,6 +73,8 @@ var (
 			cert.ProposalHandler,
 			paramsclient.ProposalHandler,
 			shield.ProposalHandler,
+			shield.PoolOperatorProposalHandler,
+			cert.SlashProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -95,6 +97,7 @@ var (
 		gov.ModuleName:            {supply.Burner},
 		oracle.ModuleName:         {supply.Burner},
 		shield.ModuleName:         {supply.Burner},
+		cert.ModuleName:           {supply.Burner},
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -209,6 +212,7 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	oracleSubspace := app.ParamsKeeper.Subspace(oracle.DefaultParamSpace)
 	cvmSubspace := app.ParamsKeeper.Subspace(cvm.DefaultParamSpace)
 	shieldSubspace := app.ParamsKeeper.Subspace(shield.DefaultParamSpace)
+	certSubspace := app.ParamsKeeper.Subspace(cert.DefaultParamSpace)
 
 	// initialize keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -283,6 +287,8 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		keys[cert.StoreKey],
 		app.SlashingKeeper,
 		stakingKeeper,
+		app.SupplyKeeper,
+		certSubspace,
 	)
 	app.AuthKeeper = auth.NewKeeper(
 		app.CertKeeper,
@@ -307,6 +313,7 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		app.SupplyKeeper,
 		shieldSubspace,
 	)
+	app.ShieldKeeper.SetReimbursementConverter(shield.NewFixedRateConverter(app.ShieldKeeper, app.OracleKeeper, shield.DefaultMinPegScore))
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference so that it will contain these hooks.
 	app.StakingKeeper.Keeper = *stakingKeeper.Keeper.SetHooks(
@@ -362,7 +369,7 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 
 	// NOTE: Shield endblocker comes before staking because it queries
 	// unbonding delegations that staking endblocker deletes.
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, cvm.ModuleName, shield.ModuleName, staking.ModuleName, gov.ModuleName, oracle.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, cert.ModuleName, cvm.ModuleName, shield.ModuleName, staking.ModuleName, gov.ModuleName, oracle.ModuleName)
 
 	// NOTE: genutil moodule must occur after staking so that pools
 	// are properly initialized with tokens from genesis accounts.
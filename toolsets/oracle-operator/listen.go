@@ -1,7 +1,10 @@
 package oracle
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -45,11 +48,37 @@ func Listen(ctx types.Context, ctkMsgChan chan<- interface{}, fatalError chan<-
 		return
 	}
 
+	// subscribe the blocks, whose end block events reopen recurring tasks
+	blockChan, err := client.Subscribe(ctx.Context(), "", "tm.event='NewBlock'", 1000)
+	if err != nil {
+		logger.Error("ctkClient subscribing", "error", err.Error())
+		fatalError <- err
+		return
+	}
+
+	reveals := newPendingReveals()
 	for {
 		select {
 		case <-ctx.Context().Done():
 			logger.Info("stop listening...")
 			return
+		case block := <-blockChan:
+			blockData, ok := block.Data.(tendermintTypes.EventDataNewBlock)
+			if !ok {
+				logger.Error("received non-block event", "block", block.Data)
+				continue
+			}
+			// the reveals are sent one block ahead, so that they are included from the reveal height on
+			for _, msg := range reveals.pop(blockData.Block.Height + 1) {
+				ctkMsgChan <- msg
+			}
+			for _, event := range blockData.ResultEndBlock.Events {
+				switch event.Type {
+				case "reopen_task":
+					logger.Info("Received event", "type", "reopen_task")
+					go handleMsgCreateTask(ctx.WithLoggerLabels("type", "reopen_task"), event, ctkMsgChan, reveals)
+				}
+			}
 		case tx := <-txChan:
 			// get tendermint transaction data in struct of ResponseDeliverTx
 			txData, ok := tx.Data.(tendermintTypes.EventDataTx)
@@ -60,15 +89,46 @@ func Listen(ctx types.Context, ctkMsgChan chan<- interface{}, fatalError chan<-
 				switch event.Type {
 				case "create_task":
 					logger.Info("Received event", "type", "create_task")
-					go handleMsgCreateTask(ctx.WithLoggerLabels("type", "create_task"), event, ctkMsgChan)
+					go handleMsgCreateTask(ctx.WithLoggerLabels("type", "create_task"), event, ctkMsgChan, reveals)
 				}
 			}
 		}
 	}
 }
 
+// pendingReveals holds the reveals of committed responses by the height they can be revealed at.
+type pendingReveals struct {
+	mtx     sync.Mutex
+	reveals map[int64][]oracle.MsgRevealTaskResponse
+}
+
+func newPendingReveals() *pendingReveals {
+	return &pendingReveals{reveals: make(map[int64][]oracle.MsgRevealTaskResponse)}
+}
+
+// add schedules a reveal at the given height.
+func (p *pendingReveals) add(height int64, msg oracle.MsgRevealTaskResponse) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reveals[height] = append(p.reveals[height], msg)
+}
+
+// pop removes and returns the reveals scheduled at or before the given height.
+func (p *pendingReveals) pop(height int64) []oracle.MsgRevealTaskResponse {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	var msgs []oracle.MsgRevealTaskResponse
+	for h, reveals := range p.reveals {
+		if h <= height {
+			msgs = append(msgs, reveals...)
+			delete(p.reveals, h)
+		}
+	}
+	return msgs
+}
+
 // handleMsgCreateTask parses MsgCreateTask TX data and passes organized message to endpoint querier.
-func handleMsgCreateTask(ctx types.Context, event abciTypes.Event, ctkMsgChan chan<- interface{}) {
+func handleMsgCreateTask(ctx types.Context, event abciTypes.Event, ctkMsgChan chan<- interface{}, reveals *pendingReveals) {
 	logger := ctx.Logger()
 	// parse event
 	msgCreateTask, err := parseMsgCreateTask(event)
@@ -123,12 +183,38 @@ func handleMsgCreateTask(ctx types.Context, event abciTypes.Event, ctkMsgChan ch
 		"payload", payload,
 	)
 	// push back
-	ctkMsgChan <- oracle.NewMsgTaskResponse(
-		msgCreateTask.Contract,
-		msgCreateTask.Function,
-		int64(score),
-		ctx.ClientContext().GetFromAddress(),
-	)
+	operator := ctx.ClientContext().GetFromAddress()
+	revealHeight := parseRevealHeight(event)
+	if revealHeight <= 0 {
+		ctkMsgChan <- oracle.NewMsgTaskResponse(msgCreateTask.Contract, msgCreateTask.Function, int64(score), operator)
+		return
+	}
+	// the task takes committed responses, which are revealed from the reveal height on
+	saltBytes := make([]byte, 16)
+	if _, err := rand.Read(saltBytes); err != nil {
+		logger.Error("generating salt", "error", err.Error())
+		return
+	}
+	salt := hex.EncodeToString(saltBytes)
+	hash := oracle.TaskResponseHash(msgCreateTask.Contract, msgCreateTask.Function, int64(score), salt, operator)
+	ctkMsgChan <- oracle.NewMsgCommitTaskResponse(msgCreateTask.Contract, msgCreateTask.Function, hash, operator)
+	reveals.add(revealHeight, oracle.NewMsgRevealTaskResponse(
+		msgCreateTask.Contract, msgCreateTask.Function, int64(score), salt, operator))
+}
+
+// parseRevealHeight returns the height from which committed responses to a task are revealed,
+// or zero if the task takes plain responses.
+func parseRevealHeight(event abciTypes.Event) int64 {
+	for _, v := range event.GetAttributes() {
+		if string(v.GetKey()) == "revealHeight" {
+			height, err := strconv.ParseInt(string(v.GetValue()), 10, 64)
+			if err != nil {
+				return 0
+			}
+			return height
+		}
+	}
+	return 0
 }
 
 // parseMsgCreateTask parses TX data of creating tasks.
@@ -17,6 +17,10 @@ func Push(ctx types.Context, ctkMsgChan <-chan interface{}, errorChan chan<- err
 			switch m := msg.(type) {
 			case oracle.MsgTaskResponse:
 				go PushMsgTaskResponse(ctx.WithLoggerLabels("type", "MsgTaskResponse"), m)
+			case oracle.MsgCommitTaskResponse:
+				go pushMsg(ctx.WithLoggerLabels("type", "MsgCommitTaskResponse"), m)
+			case oracle.MsgRevealTaskResponse:
+				go pushMsg(ctx.WithLoggerLabels("type", "MsgRevealTaskResponse"), m)
 			}
 		}
 	}
@@ -24,6 +28,11 @@ func Push(ctx types.Context, ctkMsgChan <-chan interface{}, errorChan chan<- err
 
 // PushMsgTaskResponse pushes MsgTaskResponse message to CertiK Chain.
 func PushMsgTaskResponse(ctx types.Context, msg oracle.MsgTaskResponse) {
+	pushMsg(ctx, msg)
+}
+
+// pushMsg pushes a task response message to CertiK Chain.
+func pushMsg(ctx types.Context, msg sdk.Msg) {
 	logger := ctx.Logger()
 	if err := msg.ValidateBasic(); err != nil {
 		ctx.Logger().Error(err.Error())
@@ -35,7 +44,7 @@ func PushMsgTaskResponse(ctx types.Context, msg oracle.MsgTaskResponse) {
 		return
 	}
 	ctx.Logger().Debug(
-		"CertiK MsgTaskResponse Receipt",
+		"CertiK "+msg.Type()+" Receipt",
 		"height", receipt.Height,
 		"txHash", receipt.TxHash,
 		"data", receipt.Data,
@@ -0,0 +1,14 @@
+package cert
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/keeper"
+)
+
+// EndBlocker marks the certificates whose validity has ended as expired
+// and removes the quorum certificates whose signing window has closed.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ExpireCertificates(ctx)
+	k.FailQuorumCertificates(ctx)
+}
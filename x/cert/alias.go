@@ -15,35 +15,74 @@ const (
 	CertificateTypeCompilation  = types.CertificateTypeCompilation
 	MaxTimestamp                = keeper.MaxTimestamp
 	ProposalTypeCertifierUpdate = types.ProposalTypeCertifierUpdate
+	ProposalTypeCertifierSlash  = types.ProposalTypeCertifierSlash
+	DefaultParamSpace           = types.ModuleName
 )
 
 var (
 	// function aliases
-	NewKeeper                   = keeper.NewKeeper
-	RegisterCodec               = types.RegisterCodec
-	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
-	NewCertifier                = types.NewCertifier
-	DefaultGenesisState         = types.DefaultGenesisState
-	NewGeneralCertificate       = types.NewGeneralCertificate
-	NewCompilationCertificate   = types.NewCompilationCertificate
-	NewCertifierUpdateProposal  = types.NewCertifierUpdateProposal
+	NewKeeper                    = keeper.NewKeeper
+	RegisterCodec                = types.RegisterCodec
+	GetGenesisStateFromAppState  = types.GetGenesisStateFromAppState
+	NewCertifier                 = types.NewCertifier
+	DefaultGenesisState          = types.DefaultGenesisState
+	NewGeneralCertificate        = types.NewGeneralCertificate
+	NewCompilationCertificate    = types.NewCompilationCertificate
+	NewAuditingCertificate       = types.NewAuditingCertificate
+	NewProofCertificate          = types.NewProofCertificate
+	NewOracleOperatorCertificate = types.NewOracleOperatorCertificate
+	NewQuorumCertificate         = types.NewQuorumCertificate
+	NewCertifierUpdateProposal   = types.NewCertifierUpdateProposal
+	NewCertifierSlashProposal    = types.NewCertifierSlashProposal
+	NewCertifierParams           = types.NewCertifierParams
+	NewCertificateBundle         = types.NewCertificateBundle
+	NewRevocation                = types.NewRevocation
+	RevocationReasonFromString   = types.RevocationReasonFromString
+	NewPlatformAttestation       = types.NewPlatformAttestation
+	HardwareTypeFromString       = types.HardwareTypeFromString
+	NewIdentityCertificate       = types.NewIdentityCertificate
+	NewIdentityClaim             = types.NewIdentityClaim
+	NewIdentityDisclosure        = types.NewIdentityDisclosure
+	IdentityClaimHash            = types.IdentityClaimHash
+	NewMsgDepositCertifierBond   = types.NewMsgDepositCertifierBond
 
 	// variable aliases
-	ProposalHandler           = client.ProposalHandler
-	ErrUnqualifiedCertifier   = types.ErrUnqualifiedCertifier
-	ErrRepeatedAlias          = types.ErrRepeatedAlias
-	ErrCertifierAlreadyExists = types.ErrCertifierAlreadyExists
+	ProposalHandler             = client.ProposalHandler
+	SlashProposalHandler        = client.SlashProposalHandler
+	ErrUnqualifiedCertifier     = types.ErrUnqualifiedCertifier
+	ErrRepeatedAlias            = types.ErrRepeatedAlias
+	ErrCertifierAlreadyExists   = types.ErrCertifierAlreadyExists
+	ErrInvalidSlashAmount       = types.ErrInvalidSlashAmount
+	ErrInvalidClaimProposal     = types.ErrInvalidClaimProposal
+	ErrInsufficientBond         = types.ErrInsufficientBond
+	ErrInvalidCertificateBundle = types.ErrInvalidCertificateBundle
+	ErrInvalidRevocationReason  = types.ErrInvalidRevocationReason
+	ErrInvalidHardwareType      = types.ErrInvalidHardwareType
+	ErrPlatformValidUntil       = types.ErrPlatformValidUntil
+	ErrIdentityNotCertified     = types.ErrIdentityNotCertified
+	ErrIdentityDisclosure       = types.ErrIdentityDisclosure
 )
 
 type (
 	Keeper                  = keeper.Keeper
 	GenesisState            = types.GenesisState
 	CertifierUpdateProposal = types.CertifierUpdateProposal
+	CertifierSlashProposal  = types.CertifierSlashProposal
+	CertifierParams         = types.CertifierParams
 	Certifier               = types.Certifier
 	Certifiers              = types.Certifiers
 	Certificate             = types.Certificate
+	CertificateBundle       = types.CertificateBundle
+	Revocation              = types.Revocation
+	RevocationReason        = types.RevocationReason
 	Validator               = types.Validator
 	Platform                = types.Platform
+	PlatformAttestation     = types.PlatformAttestation
+	HardwareType            = types.HardwareType
+	IdentityCertificate     = types.IdentityCertificate
+	IdentityClaim           = types.IdentityClaim
+	IdentityDisclosure      = types.IdentityDisclosure
 	Library                 = types.Library
 	AddOrRemove             = types.AddOrRemove
+	MsgDepositCertifierBond = types.MsgDepositCertifierBond
 )
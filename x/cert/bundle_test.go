@@ -0,0 +1,93 @@
+package cert_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// signedHeader returns a signed header whose commit is consistent with the header.
+func signedHeader(header tmtypes.Header) tmtypes.SignedHeader {
+	return tmtypes.SignedHeader{
+		Header: &header,
+		Commit: &tmtypes.Commit{
+			Height:     header.Height,
+			BlockID:    tmtypes.BlockID{Hash: header.Hash()},
+			Signatures: []tmtypes.CommitSig{tmtypes.NewCommitSigAbsent()},
+		},
+	}
+}
+
+func Test_CertificateBundle(t *testing.T) {
+	cdc := types.ModuleCdc
+	certifier := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	db := dbm.NewMemDB()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ms := rootmulti.NewStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// Proofs are only served for heights above the genesis block.
+	ms.Commit()
+	certificate := types.NewCompilationCertificate(types.CertificateTypeCompilation, "sourcecodehash0", "compiler1", "bytecodehash1", "", certifier)
+	certificate.SetCertificateID(1)
+	certificate.SetTxHash("txhash")
+	ms.GetKVStore(storeKey).Set(types.CertificateStoreKey(1), certificate.Bytes(cdc))
+	commitID := ms.Commit()
+
+	res := ms.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", types.StoreKey),
+		Data:   types.CertificateStoreKey(1),
+		Height: commitID.Version,
+		Prove:  true,
+	})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+
+	// The header of the next block commits to the app hash of the proven height.
+	header := tmtypes.Header{
+		ChainID:         "test-chain",
+		Height:          res.Height + 1,
+		AppHash:         commitID.Hash,
+		ValidatorsHash:  tmhash.Sum([]byte("validators")),
+		ProposerAddress: secp256k1.GenPrivKey().PubKey().Address(),
+	}
+	bundle := types.NewCertificateBundle(certificate, res.Height, res.Value, res.Proof, signedHeader(header))
+
+	// The bundle survives the JSON round trip of export and verify.
+	bz, err := cdc.MarshalJSON(bundle)
+	require.NoError(t, err)
+	var exported types.CertificateBundle
+	require.NoError(t, cdc.UnmarshalJSON(bz, &exported))
+	require.NoError(t, exported.Verify(cdc, header.Hash()))
+	require.Equal(t, "txhash", exported.TxHash)
+
+	// Untrusted headers, forged certificates and wrong app hashes are rejected.
+	require.Error(t, exported.Verify(cdc, []byte("untrusted")))
+
+	forged := types.NewCompilationCertificate(types.CertificateTypeCompilation, "sourcecodehash1", "compiler1", "bytecodehash1", "", certifier)
+	forged.SetCertificateID(1)
+	forged.SetTxHash("txhash")
+	tampered := exported
+	tampered.Certificate = forged
+	tampered.Value = forged.Bytes(cdc)
+	require.Error(t, tampered.Verify(cdc, header.Hash()))
+
+	wrongHeader := header
+	wrongHeader.AppHash = []byte("wrong app hash")
+	tampered = exported
+	tampered.Header = signedHeader(wrongHeader)
+	require.Error(t, tampered.Verify(cdc, wrongHeader.Hash()))
+}
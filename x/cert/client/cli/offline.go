@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+const (
+	FlagTrustedHash = "trusted-hash"
+)
+
+// GetOfflineCmd returns the certification commands which are neither queries nor transactions.
+func GetOfflineCmd(cdc *codec.Codec) *cobra.Command {
+	certOfflineCmds := &cobra.Command{
+		Use:   "cert",
+		Short: "Offline certification and event watching subcommands",
+	}
+
+	certOfflineCmds.AddCommand(
+		GetCmdVerifyCertificateBundle(cdc),
+		GetCmdIdentityClaimHash(),
+		GetCmdWatch(cdc),
+	)
+
+	return certOfflineCmds
+}
+
+// GetCmdVerifyCertificateBundle returns the command verifying an exported certificate bundle offline.
+func GetCmdVerifyCertificateBundle(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <bundle file>",
+		Short: "Verify an exported certificate bundle against a trusted block header",
+		Long: strings.TrimSpace(`Verify a certificate bundle produced by "certikcli query cert export" without
+connecting to a node. The bundle header must have the trusted hash, and the Merkle proof of
+the certificate must match the app hash of that header.
+
+$ certikcli cert verify certificate.json --trusted-hash <block hash>
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustedHash, err := hex.DecodeString(viper.GetString(FlagTrustedHash))
+			if err != nil {
+				return err
+			}
+			if len(trustedHash) == 0 {
+				return fmt.Errorf("trusted block header hash is required")
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var bundle types.CertificateBundle
+			if err := cdc.UnmarshalJSON(bz, &bundle); err != nil {
+				return err
+			}
+
+			if err := bundle.Verify(cdc, trustedHash); err != nil {
+				return err
+			}
+			fmt.Println(bundle.String())
+			fmt.Println("Certificate bundle verified.")
+			return nil
+		},
+	}
+	cmd.Flags().String(FlagTrustedHash, "", "hex encoded hash of the trusted block header")
+	return cmd
+}
+
+// GetCmdIdentityClaimHash returns the command computing the hash of an identity claim.
+func GetCmdIdentityClaimHash() *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-hash <attribute> <value> [<salt>]",
+		Short: "Compute the hash of an identity claim",
+		Long: strings.TrimSpace(`Compute the hash committing to the value of an identity attribute, to be
+included in an identity certificate. Unless a salt is given, a random salt is generated. The
+value and the salt are later disclosed to verify the claim.
+
+$ certikcli cert claim-hash jurisdiction CH
+`),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var salt string
+			if len(args) == 3 {
+				salt = args[2]
+			} else {
+				bz := make([]byte, 16)
+				if _, err := rand.Read(bz); err != nil {
+					return err
+				}
+				salt = hex.EncodeToString(bz)
+			}
+			fmt.Printf("Claim: %s=%s\n", args[0], types.IdentityClaimHash(args[0], args[1], salt))
+			fmt.Printf("Disclosure: %s=%s:%s\n", args[0], args[1], salt)
+			return nil
+		},
+	}
+}
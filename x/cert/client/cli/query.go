@@ -3,10 +3,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -30,8 +34,17 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdValidator(queryRoute, cdc),
 		GetCmdValidators(queryRoute, cdc),
 		GetCmdPlatform(queryRoute, cdc),
+		GetCmdPlatforms(queryRoute, cdc),
 		GetCmdCertificate(queryRoute, cdc),
 		GetCmdCertificates(queryRoute, cdc),
+		GetCmdExportCertificate(cdc),
+		GetCmdLibrary(queryRoute, cdc),
+		GetCmdLibraries(queryRoute, cdc),
+		GetCmdCertifierReputation(queryRoute, cdc),
+		GetCmdCertifierParams(queryRoute, cdc),
+		GetCmdRevocation(queryRoute, cdc),
+		GetCmdRevocationList(queryRoute, cdc),
+		GetCmdVerifyIdentity(queryRoute, cdc),
 	)...)
 
 	return certQueryCmds
@@ -180,7 +193,10 @@ func GetCmdCertificates(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 			page := viper.GetInt(FlagPage)
 			limit := viper.GetInt(FlagLimit)
-			params := types.NewQueryCertificatesParams(page, limit, certifierAddress, contentTypeString, content)
+			params := types.NewQueryCertificatesParams(
+				page, limit, certifierAddress, contentTypeString, content, viper.GetString(FlagCertType),
+				viper.GetInt64(FlagMinHeight), viper.GetInt64(FlagMaxHeight), viper.GetUint64(FlagCursor),
+			)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -198,11 +214,88 @@ func GetCmdCertificates(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagCertifier, "", "certificates issued by certifier")
 	cmd.Flags().String(FlagContent, "", "certificates by request content")
 	cmd.Flags().String(FlagContentType, "", "type of request content")
-	cmd.Flags().Int(FlagPage, 1, "pagination page of certificates to to query for")
+	cmd.Flags().String(FlagCertType, "", "type of certificate")
+	cmd.Flags().Int64(FlagMinHeight, 0, "minimum block height at which the certificates were issued")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "maximum block height at which the certificates were issued, 0 for no maximum")
+	cmd.Flags().Uint64(FlagCursor, 0, "query certificates older than the given certificate ID, as returned in next_cursor")
+	cmd.Flags().Int(FlagPage, 1, "pagination page of certificates to to query for, 0 to skip counting the total")
 	cmd.Flags().Int(FlagLimit, 100, "pagination limit of certificates to query for")
 	return cmd
 }
 
+// GetCmdExportCertificate returns the command exporting a certificate together with
+// the Merkle proof of its store entry and the signed header committing to it.
+func GetCmdExportCertificate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "export <certificate id>",
+		Short: "Export a certificate as a bundle that can be verified offline",
+		Long: strings.TrimSpace(`Export a certificate as a bundle that can be verified offline.
+
+The bundle contains the certificate, the hash of the tx that issued it, a Merkle proof
+of its store entry and the signed header of the next block, whose app hash commits to it.
+Unless --height is given, the certificate is proven at the height before the latest block.
+
+$ certikcli query cert export 1 > certificate.json
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			// The app hash for a height is only committed to in the header of the next block.
+			height := cliCtx.Height
+			if height == 0 {
+				status, err := node.Status()
+				if err != nil {
+					return err
+				}
+				height = status.SyncInfo.LatestBlockHeight - 1
+			}
+
+			res, err := cliCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+				Data:   types.CertificateStoreKey(id),
+				Height: height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+			if len(res.Value) == 0 {
+				return types.ErrCertificateNotExists
+			}
+
+			var certificate types.Certificate
+			if err := cdc.UnmarshalBinaryLengthPrefixed(res.Value, &certificate); err != nil {
+				return err
+			}
+
+			nextHeight := res.Height + 1
+			commit, err := node.Commit(&nextHeight)
+			if err != nil {
+				return err
+			}
+
+			// The bundle is always printed as JSON so that it can be read back by the verify command.
+			bundle := types.NewCertificateBundle(certificate, res.Height, res.Value, res.Proof, commit.SignedHeader)
+			out, err := codec.MarshalJSONIndent(cdc, bundle)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+			return nil
+		},
+	}
+}
+
 // GetCmdPlatform returns the validator host platform certification query command.
 func GetCmdPlatform(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -227,3 +320,201 @@ func GetCmdPlatform(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPlatforms returns the query command ranking validators by certified host platform.
+func GetCmdPlatforms(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "platforms",
+		Short: "Get validators ranked by certified host platform",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryPlatformsParams(viper.GetBool(FlagCurrentOnly), viper.GetString(FlagHardwareType))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/platforms", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+			var out types.QueryResPlatforms
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Bool(FlagCurrentOnly, false, "only list platforms with attestations that have not expired")
+	cmd.Flags().String(FlagHardwareType, "", "minimum hardware type of the listed platforms (vm, bare-metal, hsm or tee)")
+	return cmd
+}
+
+// GetCmdLibrary returns the certificate library query command.
+func GetCmdLibrary(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "library <address>",
+		Short: "Get certificate library information",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/library/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			var out types.Library
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdLibraries returns all certificate libraries query command.
+func GetCmdLibraries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "libraries",
+		Short: "Get all certificate libraries",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/libraries", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+			var out types.QueryResLibraries
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdCertifierReputation returns the certifier reputation query command.
+func GetCmdCertifierReputation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reputation <address>",
+		Short: "Get the reputation of a certifier",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/reputation/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			var out types.CertifierReputation
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdCertifierParams returns the certifier parameters query command.
+func GetCmdCertifierParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get the certifier parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+			var out types.CertifierParams
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdRevocation returns the certificate revocation query command.
+func GetCmdRevocation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revocation <certificate id>",
+		Short: "Get the revocation of a certificate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/revocation/%s", queryRoute, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			var out types.Revocation
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdRevocationList returns the revocation list query command.
+func GetCmdRevocationList(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revocations",
+		Short: "Get the list of revoked certificates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryRevocationListParams(viper.GetInt64(FlagSinceHeight))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/revocations", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+			var out types.RevocationList
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Int64(FlagSinceHeight, 0, "only list the certificates revoked after the given block height")
+	return cmd
+}
+
+// GetCmdVerifyIdentity returns the command verifying disclosed identity attributes.
+func GetCmdVerifyIdentity(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-identity <address> [<attribute>=<value>:<salt>...]",
+		Short: "Verify disclosed identity attributes against the claims of an identity certificate",
+		Long: strings.TrimSpace(`Verify that an address is a certified identity and, for each disclosed
+attribute, that the value and salt hash to the claim in its identity certificate.
+
+$ certikcli query cert verify-identity <address> jurisdiction=CH:9f1c2e
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			identity, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			disclosures := make([]types.IdentityDisclosure, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				disclosure, err := types.IdentityDisclosureFromString(arg)
+				if err != nil {
+					return err
+				}
+				disclosures = append(disclosures, disclosure)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryVerifyIdentityParams(identity, disclosures))
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/verifyidentity", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+			var out types.QueryResVerifyIdentity
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
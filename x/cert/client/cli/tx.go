@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -29,9 +30,36 @@ const (
 	FlagCompiler     = "compiler"
 	FlagBytecodeHash = "bytecode-hash"
 	FlagDescription  = "description"
+	FlagValidUntil   = "valid-until"
+	FlagReportHash   = "report-hash"
+	FlagScope        = "scope"
+	FlagAuditorFirm  = "auditor-firm"
+	FlagCritical     = "critical"
+	FlagMajor        = "major"
+	FlagMedium       = "medium"
+	FlagMinor        = "minor"
+	FlagInfo         = "informational"
+	FlagSpecHash     = "spec-hash"
+	FlagProver       = "prover"
+	FlagProperties   = "properties"
+	FlagName         = "name"
+	FlagDataSources  = "data-sources"
+	FlagThreshold    = "threshold"
+	FlagWindow       = "window"
 	FlagCertifier    = "certifier"
 	FlagPage         = "page"
 	FlagLimit        = "limit"
+	FlagCertType     = "certificate-type"
+	FlagMinHeight    = "min-height"
+	FlagMaxHeight    = "max-height"
+	FlagCursor       = "cursor"
+	FlagReason       = "reason"
+	FlagSinceHeight  = "since-height"
+	FlagHardwareType = "hardware-type"
+	FlagOS           = "os"
+	FlagQuoteHash    = "quote-hash"
+	FlagCurrentOnly  = "current-only"
+	FlagClaims       = "claims"
 )
 
 // GetTxCmd returns the transaction commands for the certification module.
@@ -47,6 +75,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdCertifyPlatform(cdc),
 		GetCmdIssueCertificate(cdc),
 		GetCmdRevokeCertificate(cdc),
+		GetCmdRenewCertificate(cdc),
+		GetCmdProposeQuorumCertificate(cdc),
+		GetCmdSignQuorumCertificate(cdc),
+		GetCmdPublishLibrary(cdc),
+		GetCmdInvalidateLibrary(cdc),
+		GetCmdDepositCertifierBond(cdc),
 	)...)
 
 	return certTxCmds
@@ -129,6 +163,11 @@ func GetCmdIssueCertificate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			validUntil, err := parseValidUntil(viper.GetString(FlagValidUntil))
+			if err != nil {
+				return err
+			}
+
 			certificateTypeString := strings.ToLower(args[0])
 			switch certificateTypeString {
 			case "compilation":
@@ -140,7 +179,67 @@ func GetCmdIssueCertificate(cdc *codec.Codec) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				msg := types.NewMsgCertifyCompilation(args[2], compiler, bytecodeHash, description, from)
+				msg := types.NewMsgCertifyCompilation(args[2], compiler, bytecodeHash, description, from, validUntil)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+
+			case "auditing":
+				reportHash := viper.GetString(FlagReportHash)
+				if reportHash == "" {
+					return fmt.Errorf("report hash is required to issue an auditing certificate")
+				}
+				severityCounts := types.NewSeverityCounts(
+					viper.GetUint64(FlagCritical),
+					viper.GetUint64(FlagMajor),
+					viper.GetUint64(FlagMedium),
+					viper.GetUint64(FlagMinor),
+					viper.GetUint64(FlagInfo),
+				)
+				msg := types.NewMsgCertifyAuditing(args[1], args[2], reportHash, viper.GetString(FlagScope), severityCounts,
+					viper.GetString(FlagAuditorFirm), viper.GetString(FlagDescription), from, validUntil)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+
+			case "proof":
+				msg := types.NewMsgCertifyProof(args[1], args[2], viper.GetString(FlagSpecHash), viper.GetString(FlagProver),
+					splitList(viper.GetString(FlagProperties)), viper.GetString(FlagDescription), from, validUntil)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+
+			case "oracleoperator":
+				if types.RequestContentTypeFromString(args[1]) != types.RequestContentTypeAddress {
+					return types.ErrInvalidRequestContentType
+				}
+				operator, err := sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+				msg := types.NewMsgCertifyOracleOperator(operator, viper.GetString(FlagName),
+					splitList(viper.GetString(FlagDataSources)), viper.GetString(FlagDescription), from, validUntil)
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+
+			case "identity":
+				if types.RequestContentTypeFromString(args[1]) != types.RequestContentTypeAddress {
+					return types.ErrInvalidRequestContentType
+				}
+				identity, err := sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+				claims, err := parseIdentityClaims(viper.GetString(FlagClaims))
+				if err != nil {
+					return err
+				}
+				msg := types.NewMsgCertifyIdentity(identity, claims, viper.GetString(FlagDescription), from, validUntil)
 				if err := msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -148,7 +247,7 @@ func GetCmdIssueCertificate(cdc *codec.Codec) *cobra.Command {
 
 			default:
 				description := viper.GetString(FlagDescription)
-				msg := types.NewMsgCertifyGeneral(certificateTypeString, args[1], args[2], description, from)
+				msg := types.NewMsgCertifyGeneral(certificateTypeString, args[1], args[2], description, from, validUntil)
 				if err := msg.ValidateBasic(); err != nil {
 					return err
 				}
@@ -160,10 +259,57 @@ func GetCmdIssueCertificate(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagCompiler, "", "compiler version")
 	cmd.Flags().String(FlagBytecodeHash, "", "bytecode hash")
 	cmd.Flags().String(FlagDescription, "", "description")
+	cmd.Flags().String(FlagValidUntil, "", "time until which the certificate is valid in RFC3339 format (permanent if empty)")
+	cmd.Flags().String(FlagReportHash, "", "audit report hash (auditing)")
+	cmd.Flags().String(FlagScope, "", "audit scope (auditing)")
+	cmd.Flags().String(FlagAuditorFirm, "", "auditor firm (auditing)")
+	cmd.Flags().Uint64(FlagCritical, 0, "number of critical findings (auditing)")
+	cmd.Flags().Uint64(FlagMajor, 0, "number of major findings (auditing)")
+	cmd.Flags().Uint64(FlagMedium, 0, "number of medium findings (auditing)")
+	cmd.Flags().Uint64(FlagMinor, 0, "number of minor findings (auditing)")
+	cmd.Flags().Uint64(FlagInfo, 0, "number of informational findings (auditing)")
+	cmd.Flags().String(FlagSpecHash, "", "specification hash (proof)")
+	cmd.Flags().String(FlagProver, "", "prover (proof)")
+	cmd.Flags().String(FlagProperties, "", "comma separated properties proven (proof)")
+	cmd.Flags().String(FlagName, "", "operator name (oracleoperator)")
+	cmd.Flags().String(FlagDataSources, "", "comma separated data sources (oracleoperator)")
+	cmd.Flags().String(FlagClaims, "", "comma separated <attribute>=<claim hash> pairs, see \"certikcli cert claim-hash\" (identity)")
 
 	return cmd
 }
 
+// splitList splits a comma separated list, ignoring empty entries.
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseIdentityClaims parses a comma separated list of <attribute>=<claim hash> pairs.
+func parseIdentityClaims(list string) ([]types.IdentityClaim, error) {
+	claims := []types.IdentityClaim{}
+	for _, item := range splitList(list) {
+		pair := strings.SplitN(item, "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid identity claim %q, expecting <attribute>=<claim hash>", item)
+		}
+		claims = append(claims, types.NewIdentityClaim(pair[0], pair[1]))
+	}
+	return claims, nil
+}
+
+// parseValidUntil parses an RFC3339 time, where an empty string means no expiry.
+func parseValidUntil(validUntil string) (time.Time, error) {
+	if validUntil == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, validUntil)
+}
+
 // parseCertifyCompilation parses flags for compilation certificate.
 func parseCertifyCompilationFlags() (string, string, string, error) {
 	compiler := viper.GetString(FlagCompiler)
@@ -180,7 +326,7 @@ func parseCertifyCompilationFlags() (string, string, string, error) {
 
 // GetCmdCertifyPlatform returns the validator host platform certification transaction command.
 func GetCmdCertifyPlatform(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "certify-platform <validator pubkey> <platform>",
 		Short: "Certify a validator's host platform",
 		Args:  cobra.ExactArgs(2),
@@ -199,7 +345,13 @@ func GetCmdCertifyPlatform(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCertifyPlatform(cliCtx.GetFromAddress(), validator, args[1])
+			validUntil, err := parseValidUntil(viper.GetString(FlagValidUntil))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCertifyPlatform(cliCtx.GetFromAddress(), validator, args[1], viper.GetString(FlagHardwareType),
+				viper.GetString(FlagOS), viper.GetString(FlagQuoteHash), validUntil)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -207,11 +359,16 @@ func GetCmdCertifyPlatform(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(FlagHardwareType, "", "hardware type of the platform (vm, bare-metal, hsm or tee)")
+	cmd.Flags().String(FlagOS, "", "operating system of the platform")
+	cmd.Flags().String(FlagQuoteHash, "", "hex-encoded SHA-256 hash of the remote attestation quote")
+	cmd.Flags().String(FlagValidUntil, "", "time until which the attestation is valid in RFC3339 format (permanent if empty)")
+	return cmd
 }
 
 // GetCmdRevokeCertificate returns the certificate revoke command
 func GetCmdRevokeCertificate(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "revoke-certificate <certificateID> [<description>]",
 		Short: "revoke a certificate",
 		Args:  cobra.RangeArgs(1, 2),
@@ -235,7 +392,218 @@ func GetCmdRevokeCertificate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgRevokeCertificate(cliCtx.GetFromAddress(), id, description)
+			msg := types.NewMsgRevokeCertificate(cliCtx.GetFromAddress(), id, viper.GetString(FlagReason), description)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagReason, "unspecified", "reason of the revocation: unspecified, key-compromise, superseded, "+
+		"vulnerability-found, cessation-of-operation or privilege-withdrawn")
+	return cmd
+}
+
+// GetCmdRenewCertificate returns the certificate renewal command.
+func GetCmdRenewCertificate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "renew-certificate <certificateID> [<valid until>]",
+		Short: "renew a certificate until the given RFC3339 time, or permanently if omitted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var validUntil time.Time
+			if len(args) > 1 {
+				validUntil, err = parseValidUntil(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewCertificate(cliCtx.GetFromAddress(), id, validUntil)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdProposeQuorumCertificate returns the quorum certificate proposal command.
+func GetCmdProposeQuorumCertificate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-quorum-certificate <certificate type> <request content type> <request content>",
+		Short: "Propose a certificate that becomes valid once enough certifiers have signed it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+
+			proposer := cliCtx.GetFromAddress()
+			if err := accGetter.EnsureExists(proposer); err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(viper.GetString(FlagWindow))
+			if err != nil {
+				return err
+			}
+			validUntil, err := parseValidUntil(viper.GetString(FlagValidUntil))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgProposeQuorumCertificate(args[0], args[1], args[2], viper.GetString(FlagDescription),
+				proposer, viper.GetUint64(FlagThreshold), window, validUntil)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagThreshold, 1, "number of certifier signatures required")
+	cmd.Flags().String(FlagWindow, "24h", "duration within which the threshold must be reached")
+	cmd.Flags().String(FlagDescription, "", "description")
+	cmd.Flags().String(FlagValidUntil, "", "time until which the certificate is valid in RFC3339 format (permanent if empty)")
+
+	return cmd
+}
+
+// GetCmdSignQuorumCertificate returns the quorum certificate signing command.
+func GetCmdSignQuorumCertificate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-quorum-certificate <certificateID>",
+		Short: "Co-sign a pending quorum certificate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			signer := cliCtx.GetFromAddress()
+			if err := accGetter.EnsureExists(signer); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignQuorumCertificate(signer, id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdPublishLibrary returns the certificate library publication command.
+func GetCmdPublishLibrary(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "publish-library <library address>",
+		Short: "Publish a certificate library",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			library, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPublishLibrary(cliCtx.GetFromAddress(), library)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdInvalidateLibrary returns the certificate library invalidation command.
+func GetCmdInvalidateLibrary(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invalidate-library <library address>",
+		Short: "Invalidate a certificate library",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			library, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgInvalidateLibrary(cliCtx.GetFromAddress(), library)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDepositCertifierBond returns the certifier bond deposit command.
+func GetCmdDepositCertifierBond(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-bond <amount>",
+		Short: "Deposit a certifier bond",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositCertifierBond(cliCtx.GetFromAddress(), amount)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -305,3 +673,70 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitSlashProposal implements the command to submit a certifier-slash proposal
+func GetCmdSubmitSlashProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certifier-slash [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to slash the bond of a certifier",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a certifier slash proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The optional claim_proposal_id
+links the proposal to a passed Shield claim proposal.
+Example:
+$ %s tx gov submit-proposal certifier-slash <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Slash Joe Shmoe",
+  "description": "A contract certified by Joe Shmoe was exploited",
+  "certifier": "certik1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": [
+    {
+      "denom": "uctk",
+      "amount": "500000000"
+    }
+  ],
+  "claim_proposal_id": 3,
+  "deposit": [
+    {
+      "denom": "ctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseCertifierSlashProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCertifierSlashProposal(
+				proposal.Title,
+				proposal.Description,
+				from,
+				proposal.Certifier,
+				proposal.Amount,
+				proposal.ClaimProposalID,
+			)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -19,6 +19,16 @@ type (
 		AddOrRemove types.AddOrRemove `json:"add_or_remove" yaml:"add_or_remove"`
 		Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
 	}
+
+	// CertifierSlashProposalJSON defines a CertifierSlashProposal with a deposit
+	CertifierSlashProposalJSON struct {
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Certifier       sdk.AccAddress `json:"certifier" yaml:"certifier"`
+		Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+		ClaimProposalID uint64         `json:"claim_proposal_id" yaml:"claim_proposal_id"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCertifierUpdateProposalJSON reads and parses a CertifierUpdateProposalJSON from a file.
@@ -36,3 +46,19 @@ func ParseCertifierUpdateProposalJSON(cdc *codec.Codec, proposalFile string) (Ce
 
 	return proposal, nil
 }
+
+// ParseCertifierSlashProposalJSON reads and parses a CertifierSlashProposalJSON from a file.
+func ParseCertifierSlashProposalJSON(cdc *codec.Codec, proposalFile string) (CertifierSlashProposalJSON, error) {
+	proposal := CertifierSlashProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
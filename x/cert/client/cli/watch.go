@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"bytes"
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// Watch command flags
+const (
+	FlagFile       = "file"
+	FlagWebhook    = "webhook"
+	FlagRetries    = "retries"
+	FlagRetryDelay = "retry-delay"
+	FlagStdout     = "stdout"
+)
+
+// WatchEvent is the normalized form of a certificate event forwarded to the watch sinks.
+type WatchEvent struct {
+	Type          string            `json:"type"`
+	Height        int64             `json:"height"`
+	TxHash        string            `json:"tx_hash"`
+	CertificateID string            `json:"certificate_id"`
+	Subject       string            `json:"subject"`
+	Certifier     string            `json:"certifier"`
+	Attributes    map[string]string `json:"attributes"`
+}
+
+// watchSubjectKeys are the event attributes holding what a certificate is about, in order of preference.
+var watchSubjectKeys = []string{"request_content", "source_code_hash", "operator", "identity"}
+
+// isWatchedEvent returns whether an event is a certificate issuance, a revocation
+// or a quorum certificate signature which has reached the quorum.
+func isWatchedEvent(event abci.Event) bool {
+	switch {
+	case event.Type == types.EventTypeCertify,
+		strings.HasPrefix(event.Type, types.EventTypeCertify+"_"),
+		event.Type == types.EventTypeRevokeCertificate:
+		return true
+	case event.Type == types.EventTypeSignQuorumCert:
+		for _, attribute := range event.GetAttributes() {
+			if string(attribute.GetKey()) == "quorum_reached" {
+				return string(attribute.GetValue()) == "true"
+			}
+		}
+	}
+	return false
+}
+
+// NewWatchEvent normalizes a certificate event of a transaction.
+func NewWatchEvent(event abci.Event, height int64, txHash string) WatchEvent {
+	watchEvent := WatchEvent{
+		Type:       event.Type,
+		Height:     height,
+		TxHash:     txHash,
+		Attributes: make(map[string]string),
+	}
+	for _, attribute := range event.GetAttributes() {
+		watchEvent.Attributes[string(attribute.GetKey())] = string(attribute.GetValue())
+	}
+	watchEvent.CertificateID = watchEvent.Attributes["certificate_id"]
+	watchEvent.Certifier = watchEvent.Attributes["certifier"]
+	for _, key := range watchSubjectKeys {
+		if subject, ok := watchEvent.Attributes[key]; ok {
+			watchEvent.Subject = subject
+			break
+		}
+	}
+	return watchEvent
+}
+
+// watchSink receives normalized certificate events.
+type watchSink interface {
+	Write(event WatchEvent) error
+}
+
+// writerSink writes certificate events as JSON lines.
+type writerSink struct {
+	w io.Writer
+}
+
+// Write implements watchSink.
+func (s writerSink) Write(event WatchEvent) error {
+	return json.NewEncoder(s.w).Encode(event)
+}
+
+// webhookSink posts certificate events to an HTTP endpoint, retrying failed
+// deliveries with an exponential backoff.
+type webhookSink struct {
+	url     string
+	retries int
+	delay   time.Duration
+	client  *http.Client
+	sleep   func(time.Duration)
+}
+
+// Write implements watchSink.
+func (s webhookSink) Write(event WatchEvent) error {
+	bz, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	delay := s.delay
+	for attempt := 0; ; attempt++ {
+		err = s.post(bz)
+		if err == nil || attempt >= s.retries {
+			return err
+		}
+		s.sleep(delay)
+		delay *= 2
+	}
+}
+
+// post delivers one request to the webhook.
+func (s webhookSink) post(bz []byte) error {
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s responded with status %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+// GetCmdWatch returns the command watching certificate issuance and revocation events.
+// It is not a query command, so it registers the node flag itself.
+func GetCmdWatch(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [<flags>]",
+		Short: "Watch certificate issuance and revocation events",
+		Long: strings.TrimSpace(`Subscribe to certify_* and revoke_certificate events of the node, as well as sign_quorum_certificate
+events once the quorum is reached, and forward them as JSON.
+
+Events are written to stdout unless a file or a webhook is given. Failed webhook deliveries are retried
+with an exponential backoff. Use --content to only forward events about the given contents, such as contract addresses.
+
+Example:
+$ certikcli cert watch --content 0x1234,0x5678 --webhook https://example.com/hooks/cert --retries 5
+`),
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var sinks []watchSink
+			if file := viper.GetString(FlagFile); file != "" {
+				f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				sinks = append(sinks, writerSink{f})
+			}
+			if url := viper.GetString(FlagWebhook); url != "" {
+				sinks = append(sinks, webhookSink{
+					url:     url,
+					retries: viper.GetInt(FlagRetries),
+					delay:   viper.GetDuration(FlagRetryDelay),
+					client:  &http.Client{Timeout: 10 * time.Second},
+					sleep:   time.Sleep,
+				})
+			}
+			if len(sinks) == 0 || viper.GetBool(FlagStdout) {
+				sinks = append(sinks, writerSink{cmd.OutOrStdout()})
+			}
+
+			contents := make(map[string]bool)
+			for _, content := range viper.GetStringSlice(FlagContent) {
+				contents[content] = true
+			}
+
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			if err := node.Start(); err != nil {
+				return err
+			}
+			defer node.Stop() //nolint
+
+			ctx, cancel := gocontext.WithCancel(gocontext.Background())
+			defer cancel()
+			signals := make(chan os.Signal, 1)
+			signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-signals
+				cancel()
+			}()
+
+			txChan, err := node.Subscribe(ctx, "certikcli", "tm.event='Tx'", 1000)
+			if err != nil {
+				return err
+			}
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case tx, ok := <-txChan:
+					if !ok {
+						return fmt.Errorf("subscription to %s closed", cliCtx.NodeURI)
+					}
+					txData, ok := tx.Data.(tmtypes.EventDataTx)
+					if !ok {
+						continue
+					}
+					txHash := fmt.Sprintf("%X", tmtypes.Tx(txData.Tx).Hash())
+					for _, event := range txData.Result.Events {
+						if !isWatchedEvent(event) {
+							continue
+						}
+						watchEvent := NewWatchEvent(event, txData.Height, txHash)
+						if len(contents) > 0 && !contents[watchEvent.Subject] {
+							continue
+						}
+						for _, sink := range sinks {
+							if err := sink.Write(watchEvent); err != nil {
+								fmt.Fprintf(cmd.ErrOrStderr(), "failed to forward %s event of tx %s: %s\n",
+									watchEvent.Type, txHash, err)
+							}
+						}
+					}
+				}
+			}
+		},
+	}
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().StringSlice(FlagContent, nil, "only forward events about the given certificate contents")
+	cmd.Flags().String(FlagFile, "", "append events as JSON lines to the given file")
+	cmd.Flags().String(FlagWebhook, "", "post events as JSON to the given URL")
+	cmd.Flags().Int(FlagRetries, 3, "number of retries of a failed webhook delivery")
+	cmd.Flags().Duration(FlagRetryDelay, time.Second, "delay before the first retry of a failed webhook delivery")
+	cmd.Flags().Bool(FlagStdout, false, "also write events to stdout when a file or a webhook is given")
+	return cmd
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+func newTestEvent(eventType string, attributes ...string) abci.Event {
+	event := abci.Event{Type: eventType}
+	for i := 0; i+1 < len(attributes); i += 2 {
+		event.Attributes = append(event.Attributes, kv.Pair{Key: []byte(attributes[i]), Value: []byte(attributes[i+1])})
+	}
+	return event
+}
+
+func TestNewWatchEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   abci.Event
+		id      string
+		subject string
+	}{
+		{
+			"request content",
+			newTestEvent(types.EventTypeCertify, "certificate_id", "1", "certifier", "certik1abc",
+				"source_code_hash", "hash", "request_content", "0x1234"),
+			"1",
+			"0x1234",
+		},
+		{
+			"source code hash",
+			newTestEvent(types.EventTypeCertifyCompilation, "certificate_id", "2", "certifier", "certik1abc",
+				"source_code_hash", "hash"),
+			"2",
+			"hash",
+		},
+		{
+			"operator",
+			newTestEvent(types.EventTypeCertifyOracleOperator, "certificate_id", "3", "certifier", "certik1abc",
+				"operator", "certik1op"),
+			"3",
+			"certik1op",
+		},
+		{
+			"no subject",
+			newTestEvent(types.EventTypeRevokeCertificate, "certificate_id", "4", "certifier", "certik1abc"),
+			"4",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := NewWatchEvent(tt.event, 10, "TXHASH")
+			require.Equal(t, tt.event.Type, event.Type)
+			require.Equal(t, int64(10), event.Height)
+			require.Equal(t, "TXHASH", event.TxHash)
+			require.Equal(t, tt.id, event.CertificateID)
+			require.Equal(t, "certik1abc", event.Certifier)
+			require.Equal(t, tt.subject, event.Subject)
+			require.Equal(t, len(tt.event.Attributes), len(event.Attributes))
+			for _, attribute := range tt.event.Attributes {
+				require.Equal(t, string(attribute.Value), event.Attributes[string(attribute.Key)])
+			}
+		})
+	}
+}
+
+func TestIsWatchedEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   abci.Event
+		watched bool
+	}{
+		{"certify", newTestEvent(types.EventTypeCertify), true},
+		{"certify auditing", newTestEvent(types.EventTypeCertifyAuditing), true},
+		{"certify identity", newTestEvent(types.EventTypeCertifyIdentity), true},
+		{"revoke certificate", newTestEvent(types.EventTypeRevokeCertificate), true},
+		{"quorum reached", newTestEvent(types.EventTypeSignQuorumCert, "signer", "certik1abc", "quorum_reached", "true"), true},
+		{"quorum not reached", newTestEvent(types.EventTypeSignQuorumCert, "quorum_reached", "false"), false},
+		{"signature without quorum attribute", newTestEvent(types.EventTypeSignQuorumCert), false},
+		{"propose quorum certificate", newTestEvent(types.EventTypeProposeQuorumCert), false},
+		{"renew certificate", newTestEvent(types.EventTypeRenewCertificate), false},
+		{"publish library", newTestEvent(types.EventTypePublishLibrary), false},
+		{"certifier prefix", newTestEvent("certifier_update"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.watched, isWatchedEvent(tt.event))
+		})
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	event := NewWatchEvent(newTestEvent(types.EventTypeCertify, "certificate_id", "1", "request_content", "0x1234"), 10, "TXHASH")
+
+	tests := []struct {
+		name     string
+		failures int
+		retries  int
+		requests int
+		delays   []time.Duration
+		err      bool
+	}{
+		{"delivered at once", 0, 3, 1, nil, false},
+		{"delivered after retries", 2, 3, 3, []time.Duration{time.Second, 2 * time.Second}, false},
+		{"retries exhausted", 5, 2, 3, []time.Duration{time.Second, 2 * time.Second}, true},
+		{"no retries", 1, 0, 1, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received []WatchEvent
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				var watchEvent WatchEvent
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&watchEvent))
+				received = append(received, watchEvent)
+				if len(received) <= tt.failures {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			defer server.Close()
+
+			var delays []time.Duration
+			sink := webhookSink{
+				url:     server.URL,
+				retries: tt.retries,
+				delay:   time.Second,
+				client:  server.Client(),
+				sleep:   func(delay time.Duration) { delays = append(delays, delay) },
+			}
+			err := sink.Write(event)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, received, tt.requests)
+			for _, watchEvent := range received {
+				require.Equal(t, event, watchEvent)
+			}
+			require.Equal(t, tt.delays, delays)
+		})
+	}
+}
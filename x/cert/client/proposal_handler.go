@@ -10,4 +10,6 @@ import (
 // param change proposal handler
 var (
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	// certifier slash proposal handler
+	SlashProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSlashProposal, rest.SlashProposalRESTHandler)
 )
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -26,10 +27,26 @@ func RegisterQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		validatorsHandler(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/platform/{pubkey}", types.QuerierRoute),
 		platformHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/platforms", types.QuerierRoute),
+		platformsHandler(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/certificate/id/{certificateid}", types.QuerierRoute),
 		certificateHandler(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/certificates", types.QuerierRoute),
 		certificatesHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/library/{address}", types.QuerierRoute),
+		libraryHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/libraries", types.QuerierRoute),
+		librariesHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/reputation/{address}", types.QuerierRoute),
+		reputationHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", types.QuerierRoute),
+		paramsHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/revocation/{certificateid}", types.QuerierRoute),
+		revocationHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/revocations", types.QuerierRoute),
+		revocationsHandler(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/verifyidentity/{address}", types.QuerierRoute),
+		verifyIdentityHandler(cliCtx)).Methods("GET")
 }
 
 func certifierHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -183,7 +200,36 @@ func certificatesHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		contentType := r.URL.Query().Get("requestcontenttype")
 		content := r.URL.Query().Get("requestcontent")
 
-		params := types.NewQueryCertificatesParams(page, limit, certifierAddress, contentType, content)
+		certType := r.URL.Query().Get("certificatetype")
+
+		var minHeight, maxHeight int64
+		if v := r.URL.Query().Get("minheight"); v != "" {
+			minHeight, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if v := r.URL.Query().Get("maxheight"); v != "" {
+			maxHeight, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		var cursor uint64
+		if v := r.URL.Query().Get("cursor"); v != "" {
+			cursor, err = strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryCertificatesParams(
+			page, limit, certifierAddress, contentType, content, certType, minHeight, maxHeight, cursor,
+		)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -223,3 +269,218 @@ func platformHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func platformsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var currentOnly bool
+		if v := r.URL.Query().Get("currentonly"); v != "" {
+			var err error
+			currentOnly, err = strconv.ParseBool(v)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		params := types.NewQueryPlatformsParams(currentOnly, r.URL.Query().Get("hardwaretype"))
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/platforms", types.QuerierRoute)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func libraryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		address := vars["address"]
+
+		route := fmt.Sprintf("custom/%s/library/%s", types.QuerierRoute, address)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func librariesHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/libraries", types.QuerierRoute)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func reputationHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		address := vars["address"]
+
+		route := fmt.Sprintf("custom/%s/reputation/%s", types.QuerierRoute, address)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func paramsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/params", types.QuerierRoute)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func revocationHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		vars := mux.Vars(r)
+		certificateID := vars["certificateid"]
+
+		route := fmt.Sprintf("custom/%s/revocation/%s", types.QuerierRoute, certificateID)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func revocationsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var sinceHeight int64
+		if v := r.URL.Query().Get("sinceheight"); v != "" {
+			var err error
+			sinceHeight, err = strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryRevocationListParams(sinceHeight))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/revocations", types.QuerierRoute)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func verifyIdentityHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		identity, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var disclosures []types.IdentityDisclosure
+		for _, v := range r.URL.Query()["disclosure"] {
+			disclosure, err := types.IdentityDisclosureFromString(v)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			disclosures = append(disclosures, disclosure)
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryVerifyIdentityParams(identity, disclosures))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/verifyidentity", types.QuerierRoute)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
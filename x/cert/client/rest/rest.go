@@ -3,6 +3,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -43,6 +44,7 @@ type certifyGeneralReq struct {
 	Content         string       `json:"content"`
 	Description     string       `json:"description"`
 	Certifier       string       `json:"certifier"`
+	ValidUntil      time.Time    `json:"valid_until"`
 }
 
 type certifyCompilationReq struct {
@@ -51,22 +53,111 @@ type certifyCompilationReq struct {
 	Compiler       string       `json:"compiler"`
 	BytecodeHash   string       `json:"bytecode_hash"`
 	Description    string       `json:"description"`
+	ValidUntil     time.Time    `json:"valid_until"`
+}
+
+type certifyAuditingReq struct {
+	BaseReq        rest.BaseReq         `json:"base_req"`
+	ContentType    string               `json:"content_type"`
+	Content        string               `json:"content"`
+	ReportHash     string               `json:"report_hash"`
+	Scope          string               `json:"scope"`
+	SeverityCounts types.SeverityCounts `json:"severity_counts"`
+	AuditorFirm    string               `json:"auditor_firm"`
+	Description    string               `json:"description"`
+	ValidUntil     time.Time            `json:"valid_until"`
+}
+
+type certifyProofReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	ContentType string       `json:"content_type"`
+	Content     string       `json:"content"`
+	SpecHash    string       `json:"spec_hash"`
+	Prover      string       `json:"prover"`
+	Properties  []string     `json:"properties"`
+	Description string       `json:"description"`
+	ValidUntil  time.Time    `json:"valid_until"`
+}
+
+type certifyOracleOperatorReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Operator    string       `json:"operator"`
+	Name        string       `json:"name"`
+	DataSources []string     `json:"data_sources"`
+	Description string       `json:"description"`
+	ValidUntil  time.Time    `json:"valid_until"`
+}
+
+type certifyIdentityReq struct {
+	BaseReq     rest.BaseReq          `json:"base_req"`
+	Identity    string                `json:"identity"`
+	Claims      []types.IdentityClaim `json:"claims"`
+	Description string                `json:"description"`
+	ValidUntil  time.Time             `json:"valid_until"`
 }
 
 type certifyPlatformReq struct {
-	BaseReq   rest.BaseReq `json:"base_req"`
-	Certifier string       `json:"certifier"`
-	Validator string       `json:"validator"`
-	Platform  string       `json:"platform"`
+	BaseReq      rest.BaseReq `json:"base_req"`
+	Certifier    string       `json:"certifier"`
+	Validator    string       `json:"validator"`
+	Platform     string       `json:"platform"`
+	HardwareType string       `json:"hardware_type"`
+	OS           string       `json:"os"`
+	QuoteHash    string       `json:"quote_hash"`
+	ValidUntil   time.Time    `json:"valid_until"`
 }
 
 type revokeCertificateReq struct {
 	BaseReq       rest.BaseReq `json:"base_req"`
 	Revoker       string       `json:"revoker"`
 	CertificateID uint64       `json:"certificate_id"`
+	Reason        string       `json:"reason"`
 	Description   string       `json:"description"`
 }
 
+type publishLibraryReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Publisher string       `json:"publisher"`
+	Library   string       `json:"library"`
+}
+
+type invalidateLibraryReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Invalidator string       `json:"invalidator"`
+	Library     string       `json:"library"`
+}
+
+type renewCertificateReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Renewer       string       `json:"renewer"`
+	CertificateID uint64       `json:"certificate_id"`
+	ValidUntil    time.Time    `json:"valid_until"`
+}
+
+type proposeQuorumCertificateReq struct {
+	BaseReq            rest.BaseReq `json:"base_req"`
+	CertificateType    string       `json:"certificate_type"`
+	RequestContentType string       `json:"request_content_type"`
+	RequestContent     string       `json:"request_content"`
+	Description        string       `json:"description"`
+	Proposer           string       `json:"proposer"`
+	Threshold          uint64       `json:"threshold"`
+	Window             string       `json:"window"`
+	ValidUntil         time.Time    `json:"valid_until"`
+}
+
+type signQuorumCertificateReq struct {
+	BaseReq       rest.BaseReq `json:"base_req"`
+	Signer        string       `json:"signer"`
+	CertificateID uint64       `json:"certificate_id"`
+}
+
+type depositCertifierBondReq struct {
+	BaseReq   rest.BaseReq `json:"base_req"`
+	Certifier string       `json:"certifier"`
+	Amount    sdk.Coins    `json:"amount"`
+}
+
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool spend REST handler with a given sub-route.
 func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
@@ -111,3 +202,48 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// SlashProposalRESTHandler returns a ProposalRESTHandler that exposes the certifier slash REST handler with a given sub-route.
+func SlashProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "certifier_slash",
+		Handler:  postSlashProposalHandlerFn(cliCtx),
+	}
+}
+
+func postSlashProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CertifierSlashProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		from, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		content := types.NewCertifierSlashProposal(
+			req.Title,
+			req.Description,
+			from,
+			req.Certifier,
+			req.Amount,
+			req.ClaimProposalID,
+		)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, from)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -25,8 +26,28 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		certifyGeneralHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/certify/compilation", types.ModuleName),
 		certifyCompilationHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/certify/auditing", types.ModuleName),
+		certifyAuditingHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/certify/proof", types.ModuleName),
+		certifyProofHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/certify/oracle-operator", types.ModuleName),
+		certifyOracleOperatorHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/certify/identity", types.ModuleName),
+		certifyIdentityHandler(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/%s/revoke/certificate", types.ModuleName),
 		revokeCertificateHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/renew/certificate", types.ModuleName),
+		renewCertificateHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/quorum/propose", types.ModuleName),
+		proposeQuorumCertificateHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/quorum/sign", types.ModuleName),
+		signQuorumCertificateHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/publish/library", types.ModuleName),
+		publishLibraryHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/invalidate/library", types.ModuleName),
+		invalidateLibraryHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/certifier/bond", types.ModuleName),
+		depositCertifierBondHandler(cliCtx)).Methods("POST")
 }
 
 func proposeCertifierHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -118,7 +139,7 @@ func certifyGeneralHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgCertifyGeneral(req.CertificateType, req.ContentType, req.Content, req.Description, certifier)
+		msg := types.NewMsgCertifyGeneral(req.CertificateType, req.ContentType, req.Content, req.Description, certifier, req.ValidUntil)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -145,7 +166,7 @@ func certifyCompilationHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgCertifyCompilation(req.SourceCodeHash, req.Compiler, req.BytecodeHash, req.Description, certifier)
+		msg := types.NewMsgCertifyCompilation(req.SourceCodeHash, req.Compiler, req.BytecodeHash, req.Description, certifier, req.ValidUntil)
 
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -156,6 +177,126 @@ func certifyCompilationHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func certifyAuditingHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req certifyAuditingReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		certifier, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgCertifyAuditing(req.ContentType, req.Content, req.ReportHash, req.Scope, req.SeverityCounts,
+			req.AuditorFirm, req.Description, certifier, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func certifyProofHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req certifyProofReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		certifier, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgCertifyProof(req.ContentType, req.Content, req.SpecHash, req.Prover, req.Properties,
+			req.Description, certifier, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func certifyOracleOperatorHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req certifyOracleOperatorReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		certifier, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		operator, err := sdk.AccAddressFromBech32(req.Operator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgCertifyOracleOperator(operator, req.Name, req.DataSources, req.Description, certifier, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func certifyIdentityHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req certifyIdentityReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+		certifier, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		identity, err := sdk.AccAddressFromBech32(req.Identity)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgCertifyIdentity(identity, req.Claims, req.Description, certifier, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
 func certifyPlatformHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req certifyPlatformReq
@@ -181,7 +322,9 @@ func certifyPlatformHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgCertifyPlatform(certifier, validator, req.Platform)
+		msg := types.NewMsgCertifyPlatform(
+			certifier, validator, req.Platform, req.HardwareType, req.OS, req.QuoteHash, req.ValidUntil,
+		)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -210,7 +353,191 @@ func revokeCertificateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgRevokeCertificate(revoker, req.CertificateID, req.Description)
+		msg := types.NewMsgRevokeCertificate(revoker, req.CertificateID, req.Reason, req.Description)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func renewCertificateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req renewCertificateReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		renewer, err := sdk.AccAddressFromBech32(req.Renewer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgRenewCertificate(renewer, req.CertificateID, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func proposeQuorumCertificateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req proposeQuorumCertificateReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		proposer, err := sdk.AccAddressFromBech32(req.Proposer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		window, err := time.ParseDuration(req.Window)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgProposeQuorumCertificate(req.CertificateType, req.RequestContentType, req.RequestContent,
+			req.Description, proposer, req.Threshold, window, req.ValidUntil)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func signQuorumCertificateHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req signQuorumCertificateReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgSignQuorumCertificate(signer, req.CertificateID)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func publishLibraryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req publishLibraryReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		publisher, err := sdk.AccAddressFromBech32(req.Publisher)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		library, err := sdk.AccAddressFromBech32(req.Library)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgPublishLibrary(publisher, library)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func invalidateLibraryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req invalidateLibraryReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		invalidator, err := sdk.AccAddressFromBech32(req.Invalidator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		library, err := sdk.AccAddressFromBech32(req.Library)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgInvalidateLibrary(invalidator, library)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		clientrest.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func depositCertifierBondHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req depositCertifierBondReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		certifier, err := sdk.AccAddressFromBech32(req.Certifier)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgDepositCertifierBond(certifier, req.Amount)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
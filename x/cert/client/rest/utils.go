@@ -19,4 +19,16 @@ type (
 		AddOrRemove types.AddOrRemove `json:"add_or_remove" yaml:"add_or_remove"`
 		Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
 	}
+
+	// CertifierSlashProposalReq defines a certifier slash proposal request body.
+	CertifierSlashProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Certifier       sdk.AccAddress `json:"certifier" yaml:"certifier"`
+		Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+		ClaimProposalID uint64         `json:"claim_proposal_id" yaml:"claim_proposal_id"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -24,11 +24,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, certifier := range certifiers {
 		k.SetCertifier(ctx, certifier)
 	}
-	if len(certifiers) > 0 {
-		cert := certifiers[0].Address
-		for _, platform := range platforms {
-			_ = k.CertifyPlatform(ctx, cert, platform.Validator, platform.Description)
-		}
+	for _, platform := range platforms {
+		k.SetPlatform(ctx, platform)
 	}
 	for _, validator := range validators {
 		k.SetValidator(ctx, validator.PubKey, validator.Certifier)
@@ -38,14 +35,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		return certificates[i].ID() < certificates[j].ID()
 	})
 	for _, certificate := range certificates {
-		k.AddCertIDToCertifier(ctx, certificate.Certifier(), certificate.ID())
-		k.SetContentCertID(ctx, certificate.Type(), certificate.RequestContent(), certificate.ID())
 		k.SetCertificate(ctx, certificate)
+		k.SetCertificateIndexes(ctx, certificate)
+		if !certificate.ValidUntil().IsZero() && !certificate.Expired() {
+			k.InsertCertificateExpirationQueue(ctx, certificate.ID(), certificate.ValidUntil())
+		}
+		if quorumCertificate, ok := certificate.(*types.QuorumCertificate); ok && !quorumCertificate.QuorumReached() {
+			k.InsertQuorumDeadlineQueue(ctx, certificate.ID(), quorumCertificate.Deadline)
+		} else {
+			k.SetContentCertID(ctx, certificate.Type(), certificate.RequestContent(), certificate.ID())
+		}
 	}
 	for _, library := range libraries {
 		k.SetLibrary(ctx, library.Address, library.Publisher)
 	}
+	for _, revocation := range data.Revocations {
+		k.SetRevocation(ctx, revocation)
+	}
 	k.SetNextCertificateID(ctx, data.NextCertificateID)
+	k.SetCertifierParams(ctx, data.CertifierParams)
 }
 
 // ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis.
@@ -56,6 +64,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	certificates := k.GetAllCertificates(ctx)
 	libraries := k.GetAllLibraries(ctx)
 	nextCertID := k.GetNextCertificateID(ctx)
+	certifierParams := k.GetCertifierParams(ctx)
+	revocations := k.GetAllRevocations(ctx)
 
 	return GenesisState{
 		Certifiers:        certifiers,
@@ -64,5 +74,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		Certificates:      certificates,
 		Libraries:         libraries,
 		NextCertificateID: nextCertID,
+		CertifierParams:   certifierParams,
+		Revocations:       revocations,
 	}
 }
@@ -2,6 +2,7 @@ package cert
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -25,8 +26,28 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCertifyGeneral(ctx, k, msg)
 		case types.MsgCertifyCompilation:
 			return handleMsgCertifyCompilation(ctx, k, msg)
+		case types.MsgCertifyAuditing:
+			return handleMsgCertifyAuditing(ctx, k, msg)
+		case types.MsgCertifyProof:
+			return handleMsgCertifyProof(ctx, k, msg)
+		case types.MsgCertifyOracleOperator:
+			return handleMsgCertifyOracleOperator(ctx, k, msg)
+		case types.MsgCertifyIdentity:
+			return handleMsgCertifyIdentity(ctx, k, msg)
 		case types.MsgRevokeCertificate:
 			return handleMsgRevokeCertificate(ctx, k, msg)
+		case types.MsgRenewCertificate:
+			return handleMsgRenewCertificate(ctx, k, msg)
+		case types.MsgProposeQuorumCertificate:
+			return handleMsgProposeQuorumCertificate(ctx, k, msg)
+		case types.MsgSignQuorumCertificate:
+			return handleMsgSignQuorumCertificate(ctx, k, msg)
+		case types.MsgPublishLibrary:
+			return handleMsgPublishLibrary(ctx, k, msg)
+		case types.MsgInvalidateLibrary:
+			return handleMsgInvalidateLibrary(ctx, k, msg)
+		case types.MsgDepositCertifierBond:
+			return handleMsgDepositCertifierBond(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Unrecognized cert Msg type: %v", msg.Type())
 		}
@@ -56,6 +77,7 @@ func handleMsgCertifyCompilation(ctx sdk.Context, k Keeper, msg types.MsgCertify
 		msg.Description,
 		msg.Certifier,
 	)
+	certificate.SetValidUntil(msg.ValidUntil)
 	certificateID, err := k.IssueCertificate(ctx, certificate)
 	if err != nil {
 		return nil, err
@@ -75,7 +97,7 @@ func handleMsgCertifyCompilation(ctx sdk.Context, k Keeper, msg types.MsgCertify
 }
 
 func handleMsgCertifyPlatform(ctx sdk.Context, k Keeper, msg types.MsgCertifyPlatform) (*sdk.Result, error) {
-	if err := k.CertifyPlatform(ctx, msg.Certifier, msg.Validator, msg.Platform); err != nil {
+	if err := k.CertifyPlatform(ctx, msg.Certifier, msg.Validator, msg.Platform, msg.Attestation()); err != nil {
 		return nil, err
 	}
 	return &sdk.Result{}, nil
@@ -92,6 +114,7 @@ func handleMsgCertifyGeneral(ctx sdk.Context, k Keeper, msg types.MsgCertifyGene
 	if err != nil {
 		return nil, err
 	}
+	certificate.SetValidUntil(msg.ValidUntil)
 	certificateID, err := k.IssueCertificate(ctx, certificate)
 	if err != nil {
 		return nil, err
@@ -111,12 +134,131 @@ func handleMsgCertifyGeneral(ctx sdk.Context, k Keeper, msg types.MsgCertifyGene
 	}, nil
 }
 
+func handleMsgCertifyAuditing(ctx sdk.Context, k Keeper, msg types.MsgCertifyAuditing) (*sdk.Result, error) {
+	certificate, err := types.NewAuditingCertificate(
+		msg.RequestContentType,
+		msg.RequestContent,
+		types.NewAuditingCertificateContent(msg.ReportHash, msg.Scope, msg.SeverityCounts, msg.AuditorFirm),
+		msg.Description,
+		msg.Certifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	certificate.SetValidUntil(msg.ValidUntil)
+	certificateID, err := k.IssueCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	certEvent := sdk.NewEvent(
+		types.EventTypeCertifyAuditing,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificateID, 10)),
+		sdk.NewAttribute("request_content_type", msg.RequestContentType),
+		sdk.NewAttribute("request_content", msg.RequestContent),
+		sdk.NewAttribute("report_hash", msg.ReportHash),
+		sdk.NewAttribute("critical", strconv.FormatUint(msg.SeverityCounts.Critical, 10)),
+		sdk.NewAttribute("major", strconv.FormatUint(msg.SeverityCounts.Major, 10)),
+		sdk.NewAttribute("auditor_firm", msg.AuditorFirm),
+		sdk.NewAttribute("certifier", msg.Certifier.String()),
+	)
+	ctx.EventManager().EmitEvent(certEvent)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgCertifyProof(ctx sdk.Context, k Keeper, msg types.MsgCertifyProof) (*sdk.Result, error) {
+	certificate, err := types.NewProofCertificate(
+		msg.RequestContentType,
+		msg.RequestContent,
+		types.NewProofCertificateContent(msg.SpecHash, msg.Prover, msg.Properties),
+		msg.Description,
+		msg.Certifier,
+	)
+	if err != nil {
+		return nil, err
+	}
+	certificate.SetValidUntil(msg.ValidUntil)
+	certificateID, err := k.IssueCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	certEvent := sdk.NewEvent(
+		types.EventTypeCertifyProof,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificateID, 10)),
+		sdk.NewAttribute("request_content_type", msg.RequestContentType),
+		sdk.NewAttribute("request_content", msg.RequestContent),
+		sdk.NewAttribute("spec_hash", msg.SpecHash),
+		sdk.NewAttribute("prover", msg.Prover),
+		sdk.NewAttribute("certifier", msg.Certifier.String()),
+	)
+	ctx.EventManager().EmitEvent(certEvent)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgCertifyOracleOperator(ctx sdk.Context, k Keeper, msg types.MsgCertifyOracleOperator) (*sdk.Result, error) {
+	certificate := types.NewOracleOperatorCertificate(
+		msg.Operator,
+		types.NewOracleOperatorCertificateContent(msg.Name, msg.DataSources),
+		msg.Description,
+		msg.Certifier,
+	)
+	certificate.SetValidUntil(msg.ValidUntil)
+	certificateID, err := k.IssueCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	certEvent := sdk.NewEvent(
+		types.EventTypeCertifyOracleOperator,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificateID, 10)),
+		sdk.NewAttribute("operator", msg.Operator.String()),
+		sdk.NewAttribute("name", msg.Name),
+		sdk.NewAttribute("certifier", msg.Certifier.String()),
+	)
+	ctx.EventManager().EmitEvent(certEvent)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgCertifyIdentity(ctx sdk.Context, k Keeper, msg types.MsgCertifyIdentity) (*sdk.Result, error) {
+	certificate := types.NewIdentityCertificate(
+		msg.Identity,
+		types.NewIdentityCertificateContent(msg.Claims),
+		msg.Description,
+		msg.Certifier,
+	)
+	certificate.SetValidUntil(msg.ValidUntil)
+	certificateID, err := k.IssueCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	attributes := make([]string, 0, len(msg.Claims))
+	for _, claim := range msg.Claims {
+		attributes = append(attributes, claim.Attribute)
+	}
+	certEvent := sdk.NewEvent(
+		types.EventTypeCertifyIdentity,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificateID, 10)),
+		sdk.NewAttribute("identity", msg.Identity.String()),
+		sdk.NewAttribute("claims", strings.Join(attributes, ",")),
+		sdk.NewAttribute("certifier", msg.Certifier.String()),
+	)
+	ctx.EventManager().EmitEvent(certEvent)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 func handleMsgRevokeCertificate(ctx sdk.Context, k Keeper, msg types.MsgRevokeCertificate) (*sdk.Result, error) {
 	certificate, err := k.GetCertificateByID(ctx, msg.ID)
 	if err != nil {
 		return nil, err
 	}
-	if err := k.RevokeCertificate(ctx, certificate, msg.Revoker); err != nil {
+	reason := types.RevocationReasonFromString(msg.Reason)
+	if err := k.RevokeCertificate(ctx, certificate, msg.Revoker, reason, msg.Description); err != nil {
 		return nil, err
 	}
 	revokeEvent := sdk.NewEvent(
@@ -124,16 +266,135 @@ func handleMsgRevokeCertificate(ctx sdk.Context, k Keeper, msg types.MsgRevokeCe
 		sdk.NewAttribute("revoker", msg.Revoker.String()),
 		sdk.NewAttribute("revoked_certificate", certificate.String()),
 		sdk.NewAttribute("revoke_description", msg.Description),
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificate.ID(), 10)),
+		sdk.NewAttribute("certificate_type", certificate.Type().String()),
+		sdk.NewAttribute("certifier", certificate.Certifier().String()),
+		sdk.NewAttribute("request_content", certificate.RequestContent().RequestContent),
+		sdk.NewAttribute("reason", reason.String()),
+		sdk.NewAttribute("reason_code", strconv.Itoa(reason.CRLReasonCode())),
 	)
 	ctx.EventManager().EmitEvent(revokeEvent)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgRenewCertificate(ctx sdk.Context, k Keeper, msg types.MsgRenewCertificate) (*sdk.Result, error) {
+	certificate, err := k.GetCertificateByID(ctx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RenewCertificate(ctx, certificate, msg.Renewer, msg.ValidUntil); err != nil {
+		return nil, err
+	}
+	renewEvent := sdk.NewEvent(
+		types.EventTypeRenewCertificate,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(msg.ID, 10)),
+		sdk.NewAttribute("renewer", msg.Renewer.String()),
+		sdk.NewAttribute("valid_until", msg.ValidUntil.String()),
+	)
+	ctx.EventManager().EmitEvent(renewEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgProposeQuorumCertificate(ctx sdk.Context, k Keeper, msg types.MsgProposeQuorumCertificate) (*sdk.Result, error) {
+	certificate, err := types.NewQuorumCertificate(
+		msg.CertificateType,
+		msg.RequestContentType,
+		msg.RequestContent,
+		msg.Description,
+		msg.Proposer,
+		msg.Threshold,
+		ctx.BlockTime().Add(msg.Window),
+	)
+	if err != nil {
+		return nil, err
+	}
+	certificate.SetValidUntil(msg.ValidUntil)
+	certificateID, err := k.ProposeQuorumCertificate(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+	proposeEvent := sdk.NewEvent(
+		types.EventTypeProposeQuorumCert,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(certificateID, 10)),
+		sdk.NewAttribute("certificate_type", msg.CertificateType),
+		sdk.NewAttribute("request_content_type", msg.RequestContentType),
+		sdk.NewAttribute("request_content", msg.RequestContent),
+		sdk.NewAttribute("threshold", strconv.FormatUint(msg.Threshold, 10)),
+		sdk.NewAttribute("deadline", certificate.Deadline.String()),
+		sdk.NewAttribute("proposer", msg.Proposer.String()),
+	)
+	ctx.EventManager().EmitEvent(proposeEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSignQuorumCertificate(ctx sdk.Context, k Keeper, msg types.MsgSignQuorumCertificate) (*sdk.Result, error) {
+	quorumReached, err := k.SignQuorumCertificate(ctx, msg.ID, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := k.GetCertificateByID(ctx, msg.ID)
+	if err != nil {
+		return nil, err
+	}
+	signEvent := sdk.NewEvent(
+		types.EventTypeSignQuorumCert,
+		sdk.NewAttribute("certificate_id", strconv.FormatUint(msg.ID, 10)),
+		sdk.NewAttribute("certificate_type", certificate.Type().String()),
+		sdk.NewAttribute("request_content_type", certificate.RequestContent().RequestContentType.String()),
+		sdk.NewAttribute("request_content", certificate.RequestContent().RequestContent),
+		sdk.NewAttribute("signer", msg.Signer.String()),
+		sdk.NewAttribute("quorum_reached", strconv.FormatBool(quorumReached)),
+	)
+	ctx.EventManager().EmitEvent(signEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgPublishLibrary(ctx sdk.Context, k Keeper, msg types.MsgPublishLibrary) (*sdk.Result, error) {
+	if err := k.PublishLibrary(ctx, msg.Library, msg.Publisher); err != nil {
+		return nil, err
+	}
+	publishEvent := sdk.NewEvent(
+		types.EventTypePublishLibrary,
+		sdk.NewAttribute("library", msg.Library.String()),
+		sdk.NewAttribute("publisher", msg.Publisher.String()),
+	)
+	ctx.EventManager().EmitEvent(publishEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgInvalidateLibrary(ctx sdk.Context, k Keeper, msg types.MsgInvalidateLibrary) (*sdk.Result, error) {
+	if err := k.InvalidateLibrary(ctx, msg.Library, msg.Invalidator); err != nil {
+		return nil, err
+	}
+	invalidateEvent := sdk.NewEvent(
+		types.EventTypeInvalidateLibrary,
+		sdk.NewAttribute("library", msg.Library.String()),
+		sdk.NewAttribute("invalidator", msg.Invalidator.String()),
+	)
+	ctx.EventManager().EmitEvent(invalidateEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgDepositCertifierBond(ctx sdk.Context, k Keeper, msg types.MsgDepositCertifierBond) (*sdk.Result, error) {
+	if err := k.DepositCertifierBond(ctx, msg.Certifier, msg.Amount); err != nil {
+		return nil, err
+	}
+	depositEvent := sdk.NewEvent(
+		types.EventTypeDepositCertifierBond,
+		sdk.NewAttribute("certifier", msg.Certifier.String()),
+		sdk.NewAttribute("amount", msg.Amount.String()),
+	)
+	ctx.EventManager().EmitEvent(depositEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func NewCertifierUpdateProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case types.CertifierUpdateProposal:
 			return keeper.HandleCertifierUpdateProposal(ctx, k, c)
+		case types.CertifierSlashProposal:
+			return keeper.HandleCertifierSlashProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cert proposal content type: %T", c)
 		}
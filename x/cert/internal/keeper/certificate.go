@@ -3,11 +3,11 @@ package keeper
 import (
 	"encoding/binary"
 	"encoding/hex"
-	"strings"
+	"strconv"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
@@ -79,58 +79,20 @@ func (k Keeper) GetCertificateType(ctx sdk.Context, id uint64) (types.Certificat
 // certifier -> []CertID
 //
 
-// SetCertifierCertIDs stores the list of certificate IDs under the
-// given certifier key.
-func (k Keeper) SetCertifierCertIDs(ctx sdk.Context, certifier sdk.AccAddress, ids []uint64) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(ids)
-	store.Set(types.CertifierCertIDsKey(certifier), bz)
-}
-
-// GetCertifierCertIDs retrieves the list of certificate IDs under
-// the given certifier key.
+// GetCertifierCertIDs retrieves the IDs of the certificates issued
+// by the given certifier in ascending order.
 func (k Keeper) GetCertifierCertIDs(ctx sdk.Context, certifier sdk.AccAddress) []uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.CertifierCertIDsKey(certifier))
+	iterator := sdk.KVStorePrefixIterator(store, types.CertifierIndexPrefix(certifier))
+	defer iterator.Close()
+
 	var ids []uint64
-	if bz != nil {
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ids)
-		return ids
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, types.CertificateIDFromIndexKey(iterator.Key()))
 	}
 	return ids
 }
 
-// AddCertIDToCertifier adds an ID to the list of certificate IDs
-// certified by a given certifier.
-func (k Keeper) AddCertIDToCertifier(ctx sdk.Context, certifier sdk.AccAddress, id uint64) {
-	ids := k.GetCertifierCertIDs(ctx, certifier)
-	ids = append(ids, id)
-	k.SetCertifierCertIDs(ctx, certifier, ids)
-}
-
-// DeleteCertIDFromCertifier deletes an ID from the list of
-// certificate IDs issued by a given certifier.
-func (k Keeper) DeleteCertIDFromCertifier(ctx sdk.Context, certifier sdk.AccAddress, id uint64) error {
-	ids := k.GetCertifierCertIDs(ctx, certifier)
-	if len(ids) == 0 {
-		return types.ErrCertificateNotExists
-	}
-	if len(ids) > 1 {
-		for i := range ids {
-			if ids[i] == id {
-				ids = append(ids[:i], ids[i+1:]...)
-				k.SetCertifierCertIDs(ctx, certifier, ids)
-				return nil
-			}
-		}
-	} else {
-		store := ctx.KVStore(k.storeKey)
-		store.Delete(types.CertifierCertIDsKey(certifier))
-		return nil
-	}
-	return types.ErrCertificateNotExists
-}
-
 // GetCertificatesByCertifier gets certificates certified by a given certifier.
 func (k Keeper) GetCertificatesByCertifier(ctx sdk.Context, certifier sdk.AccAddress) []types.Certificate {
 	ids := k.GetCertifierCertIDs(ctx, certifier)
@@ -219,7 +181,25 @@ func (k Keeper) IterateCertificatesByType(ctx sdk.Context, certType types.Certif
 	}
 }
 
-// IsCertified checks if a certificate of given type and content exists.
+// IsCertificateExpired checks if a certificate has been marked as
+// expired or passed its validity period.
+func (k Keeper) IsCertificateExpired(ctx sdk.Context, certificate types.Certificate) bool {
+	if certificate.Expired() {
+		return true
+	}
+	return !certificate.ValidUntil().IsZero() && !certificate.ValidUntil().After(ctx.BlockTime())
+}
+
+// IsCertificateValid checks if a certificate has not expired and, for
+// quorum certificates, has been signed by enough certifiers.
+func (k Keeper) IsCertificateValid(ctx sdk.Context, certificate types.Certificate) bool {
+	if quorumCertificate, ok := certificate.(*types.QuorumCertificate); ok && !quorumCertificate.QuorumReached() {
+		return false
+	}
+	return !k.IsCertificateExpired(ctx, certificate)
+}
+
+// IsCertified checks if a valid certificate of given type and content exists.
 func (k Keeper) IsCertified(ctx sdk.Context, contentType string, content string, certType string) bool {
 	requestContent, err := types.NewRequestContent(contentType, content)
 	if err != nil {
@@ -227,16 +207,17 @@ func (k Keeper) IsCertified(ctx sdk.Context, contentType string, content string,
 	}
 	certificateType := types.CertificateTypeFromString(certType)
 
-	_, found := k.GetCertificateByTypeAndContent(ctx, certificateType, requestContent)
-	return found
+	certificate, found := k.GetCertificateByTypeAndContent(ctx, certificateType, requestContent)
+	return found && k.IsCertificateValid(ctx, certificate)
 }
 
-// IsContentCertified checks if a certificate of given content exists.
+// IsContentCertified checks if a valid certificate of given content exists.
 func (k Keeper) IsContentCertified(ctx sdk.Context, requestContent string) bool {
 	for _, certType := range types.CertificateTypes {
 		for _, requestContentType := range types.RequestContentTypes {
 			requestContent := types.RequestContent{RequestContentType: requestContentType, RequestContent: requestContent}
-			if _, found := k.GetCertificateByTypeAndContent(ctx, certType, requestContent); found {
+			if certificate, found := k.GetCertificateByTypeAndContent(ctx, certType, requestContent); found &&
+				k.IsCertificateValid(ctx, certificate) {
 				return true
 			}
 		}
@@ -249,16 +230,26 @@ func (k Keeper) IssueCertificate(ctx sdk.Context, c types.Certificate) (uint64,
 	if !k.IsCertifier(ctx, c.Certifier()) {
 		return 0, types.ErrUnqualifiedCertifier
 	}
-	if k.IsCertified(ctx, c.RequestContent().RequestContentType.String(), c.RequestContent().RequestContent, c.Type().String()) {
+	// Expired certificates still occupy their content and need to be renewed.
+	if _, found := k.GetContentCertID(ctx, c.Type(), c.RequestContent()); found {
 		return 0, types.ErrDuplicateCertificate
 	}
+	if !c.ValidUntil().IsZero() && !c.ValidUntil().After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidValidUntil
+	}
 
 	c.SetCertificateID(k.GetNextCertificateID(ctx))
 	c.SetTxHash(hex.EncodeToString(tmhash.Sum(ctx.TxBytes())))
+	c.SetIssuedHeight(ctx.BlockHeight())
 
-	k.AddCertIDToCertifier(ctx, c.Certifier(), c.ID())
-	k.SetContentCertID(ctx, c.Type(), c.RequestContent(), c.ID())
+	if !isPendingQuorumCertificate(c) {
+		k.SetContentCertID(ctx, c.Type(), c.RequestContent(), c.ID())
+	}
 	k.SetCertificate(ctx, c)
+	k.SetCertificateIndexes(ctx, c)
+	if !c.ValidUntil().IsZero() {
+		k.InsertCertificateExpirationQueue(ctx, c.ID(), c.ValidUntil())
+	}
 
 	k.SetNextCertificateID(ctx, c.ID() + 1)
 	return c.ID(), nil
@@ -289,76 +280,187 @@ func (k Keeper) GetAllCertificates(ctx sdk.Context) (certificates []types.Certif
 	return certificates
 }
 
-// GetCertificatesFiltered gets certificates filtered.
+// GetCertificatesFiltered gets the certificates matching the query parameters
+// from the newest to the oldest, together with the number of matches. Queries
+// with a cursor or without a page stop once the page is full, so the number of
+// matches is the number of certificates returned.
 func (k Keeper) GetCertificatesFiltered(ctx sdk.Context, params types.QueryCertificatesParams) (uint64, []types.Certificate, error) {
-	filteredCertificates := []types.Certificate{}
-	callback := func(certificate types.Certificate) bool {
-		if len(params.Certifier) != 0 && !certificate.Certifier().Equals(params.Certifier) {
+	filter, err := newCertificateFilter(params)
+	if err != nil {
+		return 0, nil, err
+	}
+	if filter.cursor != 0 {
+		if filter.cursorHeight, err = k.certificateIssuedHeight(ctx, filter.cursor); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	limit := certificatesQueryLimit(params)
+	paged := params.Cursor == 0 && params.Page > 0
+	offset := uint64(0)
+	if paged {
+		offset = uint64(params.Page-1) * uint64(limit)
+	}
+
+	var total uint64
+	certificates := []types.Certificate{}
+	k.iterateFilteredCertificateIDs(ctx, filter, func(id uint64) bool {
+		if !paged && len(certificates) == limit {
+			return true
+		}
+		total++
+		if total <= offset || len(certificates) == limit {
 			return false
 		}
-		if params.ContentType != "" &&
-			(strings.ToUpper(params.ContentType) != strings.ToUpper(certificate.RequestContent().RequestContentType.String()) ||
-				certificate.RequestContent().RequestContent != params.Content) {
+		certificate, err := k.GetCertificateByID(ctx, id)
+		if err != nil {
 			return false
 		}
-		filteredCertificates = append([]types.Certificate{certificate}, filteredCertificates...)
+		certificates = append(certificates, certificate)
 		return false
+	})
+	return total, certificates, nil
+}
+
+// RevokeCertificate revokes a certificate and records it in the revocation registry.
+func (k Keeper) RevokeCertificate(
+	ctx sdk.Context, certificate types.Certificate, revoker sdk.AccAddress, reason types.RevocationReason, description string,
+) error {
+	if !k.IsCertifier(ctx, revoker) {
+		return types.ErrUnqualifiedRevoker
+	}
+	if types.RevocationReasonFromString(reason.String()) == types.RevocationReasonNil {
+		return types.ErrInvalidRevocationReason
 	}
 
-	// Choose an efficient iteration mechanism.
-	if len(params.Certifier) != 0 {
-		if params.ContentType != "" && params.Content != "" {
-			for _, certType := range types.CertificateTypes {
-				requestContent, err := types.NewRequestContent(params.ContentType, params.Content)
-				if err != nil {
-					return 0, nil, err
-				}
-				certificate, found := k.GetCertificateByTypeAndContent(ctx, certType, requestContent)
-				if !found {
-					return 0, nil, types.ErrCertificateNotExists
-				}
-				return 1, []types.Certificate{certificate}, nil
-			}
-		} else {
-			k.IterateAllCertificate(ctx, callback)
-		}
-	} else if params.ContentType != "" && params.Content != "" {
-		requestContent, err := types.NewRequestContent(params.ContentType, params.Content)
-		if err != nil {
-			return 0, nil, err
-		}
-		filteredCertificates = k.GetCertificatesByContent(ctx, requestContent)
+	if quorumCertificate, ok := certificate.(*types.QuorumCertificate); ok && !quorumCertificate.QuorumReached() {
+		k.RemoveFromQuorumDeadlineQueue(ctx, certificate.ID(), quorumCertificate.Deadline)
 	} else {
-		k.IterateAllCertificate(ctx, callback)
+		k.incrementRevokedCertificates(ctx, certificate.Certifier())
 	}
+	k.SetRevocation(ctx, types.NewRevocation(certificate, revoker, reason, description, ctx.BlockHeight(), ctx.BlockTime()))
+	return k.deleteCertificateRecords(ctx, certificate)
+}
 
-	// Post-processing
-	total := uint64(len(filteredCertificates))
+// deleteCertificateRecords deletes a certificate together with its
+// indexes and its expiration queue entry.
+func (k Keeper) deleteCertificateRecords(ctx sdk.Context, certificate types.Certificate) error {
+	if !isPendingQuorumCertificate(certificate) {
+		k.DeleteContentCertID(ctx, certificate.Type(), certificate.RequestContent())
+	}
+	k.DeleteCertificateIndexes(ctx, certificate)
+	if err := k.DeleteCertificate(ctx, certificate); err != nil {
+		return err
+	}
+	if !certificate.ValidUntil().IsZero() && !certificate.Expired() {
+		k.RemoveFromCertificateExpirationQueue(ctx, certificate.ID(), certificate.ValidUntil())
+	}
+	return nil
+}
 
-	start, end := client.Paginate(len(filteredCertificates), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		filteredCertificates = []types.Certificate{}
-	} else {
-		filteredCertificates = filteredCertificates[start:end]
+// RenewCertificate extends the validity of a certificate while preserving its ID.
+// A zero validUntil renews the certificate permanently.
+func (k Keeper) RenewCertificate(ctx sdk.Context, certificate types.Certificate, renewer sdk.AccAddress, validUntil time.Time) error {
+	if !k.IsCertifier(ctx, renewer) {
+		return types.ErrUnqualifiedCertifier
+	}
+	if !validUntil.IsZero() && !validUntil.After(ctx.BlockTime()) {
+		return types.ErrInvalidValidUntil
 	}
 
-	return total, filteredCertificates, nil
+	if !certificate.ValidUntil().IsZero() && !certificate.Expired() {
+		k.RemoveFromCertificateExpirationQueue(ctx, certificate.ID(), certificate.ValidUntil())
+	}
+	certificate.SetValidUntil(validUntil)
+	certificate.SetExpired(false)
+	k.SetCertificate(ctx, certificate)
+	if !validUntil.IsZero() {
+		k.InsertCertificateExpirationQueue(ctx, certificate.ID(), validUntil)
+	}
+	return nil
 }
 
-// RevokeCertificate revokes a certificate.
-func (k Keeper) RevokeCertificate(ctx sdk.Context, certificate types.Certificate, revoker sdk.AccAddress) error {
-	if !k.IsCertifier(ctx, revoker) {
-		return types.ErrUnqualifiedRevoker
+//
+// valid_until -> []CertID
+//
+
+// GetCertificateExpirationQueueTimeSlice gets the IDs of certificates expiring at the given time.
+func (k Keeper) GetCertificateExpirationQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CertificateExpirationQueueKey(timestamp))
+	if bz == nil {
+		return []uint64{}
 	}
+	var ids []uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ids)
+	return ids
+}
 
-	if err := k.DeleteCertIDFromCertifier(ctx, certificate.Certifier(), certificate.ID()); err != nil {
-		return err
+// SetCertificateExpirationQueueTimeSlice sets the IDs of certificates expiring at the given time.
+func (k Keeper) SetCertificateExpirationQueueTimeSlice(ctx sdk.Context, timestamp time.Time, ids []uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if len(ids) == 0 {
+		store.Delete(types.CertificateExpirationQueueKey(timestamp))
+		return
 	}
-	k.DeleteContentCertID(ctx, certificate.Type(), certificate.RequestContent())
-	if err := k.DeleteCertificate(ctx, certificate); err != nil {
-		return err
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(ids)
+	store.Set(types.CertificateExpirationQueueKey(timestamp), bz)
+}
+
+// InsertCertificateExpirationQueue adds a certificate ID to the expiration queue.
+func (k Keeper) InsertCertificateExpirationQueue(ctx sdk.Context, id uint64, validUntil time.Time) {
+	ids := k.GetCertificateExpirationQueueTimeSlice(ctx, validUntil)
+	ids = append(ids, id)
+	k.SetCertificateExpirationQueueTimeSlice(ctx, validUntil, ids)
+}
+
+// RemoveFromCertificateExpirationQueue removes a certificate ID from the expiration queue.
+func (k Keeper) RemoveFromCertificateExpirationQueue(ctx sdk.Context, id uint64, validUntil time.Time) {
+	ids := k.GetCertificateExpirationQueueTimeSlice(ctx, validUntil)
+	for i := range ids {
+		if ids[i] == id {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	k.SetCertificateExpirationQueueTimeSlice(ctx, validUntil, ids)
+}
+
+// CertificateExpirationQueueIterator returns an iterator of certificates expiring no later than endTime.
+func (k Keeper) CertificateExpirationQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.CertificateExpirationQueueKeyPrefix,
+		sdk.InclusiveEndBytes(types.CertificateExpirationQueueKey(endTime)))
+}
+
+// ExpireCertificates marks all certificates whose validity has ended
+// by the current block time as expired.
+func (k Keeper) ExpireCertificates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.CertificateExpirationQueueIterator(ctx, ctx.BlockTime())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ids []uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ids)
+		for _, id := range ids {
+			certificate, err := k.GetCertificateByID(ctx, id)
+			if err != nil {
+				continue
+			}
+			certificate.SetExpired(true)
+			k.SetCertificate(ctx, certificate)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExpireCertificate,
+					sdk.NewAttribute("certificate_id", strconv.FormatUint(id, 10)),
+					sdk.NewAttribute("valid_until", certificate.ValidUntil().String()),
+				),
+			)
+		}
+		store.Delete(iterator.Key())
 	}
-	return nil
 }
 
 // GetCertifiedIdentities returns a list of addresses certified as identities.
@@ -375,6 +477,9 @@ func (k Keeper) GetCertifiedIdentities(ctx sdk.Context) []sdk.AccAddress {
 		if err != nil {
 			panic(err)
 		}
+		if !k.IsCertificateValid(ctx, certificate) {
+			continue
+		}
 		addr, _ := sdk.AccAddressFromBech32(certificate.RequestContent().RequestContent)
 		identities = append(identities, addr)	
 	}
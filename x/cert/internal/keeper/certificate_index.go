@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// defaultCertificatesQueryLimit is the number of certificates returned by a
+// certificates query which does not specify a limit.
+const defaultCertificatesQueryLimit = 100
+
+//
+// certificate type, certifier and issuance height indexes
+//
+
+// SetCertificateIndexes adds the type, certifier and issuance height index entries of a certificate.
+func (k Keeper) SetCertificateIndexes(ctx sdk.Context, certificate types.Certificate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CertificateTypeIndexKey(certificate.Type(), certificate.ID()), []byte{})
+	store.Set(types.CertifierIndexKey(certificate.Certifier(), certificate.ID()), []byte{})
+	store.Set(types.IssuedHeightIndexKey(certificate.IssuedHeight(), certificate.ID()), []byte{})
+}
+
+// DeleteCertificateIndexes removes the type, certifier and issuance height index entries of a certificate.
+func (k Keeper) DeleteCertificateIndexes(ctx sdk.Context, certificate types.Certificate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CertificateTypeIndexKey(certificate.Type(), certificate.ID()))
+	store.Delete(types.CertifierIndexKey(certificate.Certifier(), certificate.ID()))
+	store.Delete(types.IssuedHeightIndexKey(certificate.IssuedHeight(), certificate.ID()))
+}
+
+// certificateFilter is the parsed form of the certificates query parameters.
+type certificateFilter struct {
+	certifier   sdk.AccAddress
+	certType    types.CertificateType
+	contentType types.RequestContentType
+	content     string
+	minHeight   int64
+	maxHeight   int64
+	cursor      uint64
+	// cursorHeight is the issuance height of the cursor certificate.
+	cursorHeight int64
+}
+
+// newCertificateFilter parses and validates the certificates query parameters.
+func newCertificateFilter(params types.QueryCertificatesParams) (certificateFilter, error) {
+	filter := certificateFilter{
+		certifier: params.Certifier,
+		content:   params.Content,
+		minHeight: params.MinHeight,
+		maxHeight: params.MaxHeight,
+		cursor:    params.Cursor,
+	}
+	if params.CertificateType != "" {
+		filter.certType = types.CertificateTypeFromString(params.CertificateType)
+		if filter.certType == types.CertificateTypeNil {
+			return certificateFilter{}, types.ErrInvalidCertificateType
+		}
+	}
+	if params.ContentType != "" {
+		filter.contentType = types.RequestContentTypeFromString(params.ContentType)
+		if filter.contentType == types.RequestContentTypeNil {
+			return certificateFilter{}, types.ErrInvalidRequestContentType
+		}
+	}
+	if filter.minHeight < 0 || filter.maxHeight < 0 || (filter.maxHeight != 0 && filter.minHeight > filter.maxHeight) {
+		return certificateFilter{}, types.ErrInvalidHeightRange
+	}
+	return filter, nil
+}
+
+// hasHeightRange returns whether the filter restricts the issuance height.
+func (f certificateFilter) hasHeightRange() bool {
+	return f.minHeight != 0 || f.maxHeight != 0
+}
+
+// conditions returns the number of conditions the filter has, not counting the cursor.
+func (f certificateFilter) conditions() int {
+	conditions := 0
+	if len(f.certifier) != 0 {
+		conditions++
+	}
+	if f.certType != types.CertificateTypeNil {
+		conditions++
+	}
+	if f.contentType != types.RequestContentTypeNil {
+		conditions++
+	}
+	if f.hasHeightRange() {
+		conditions++
+	}
+	return conditions
+}
+
+// matches returns whether a certificate satisfies all conditions of the filter.
+func (f certificateFilter) matches(certificate types.Certificate) bool {
+	if len(f.certifier) != 0 && !certificate.Certifier().Equals(f.certifier) {
+		return false
+	}
+	if f.certType != types.CertificateTypeNil && certificate.Type() != f.certType {
+		return false
+	}
+	if f.contentType != types.RequestContentTypeNil {
+		if certificate.RequestContent().RequestContentType != f.contentType {
+			return false
+		}
+		if f.content != "" && certificate.RequestContent().RequestContent != f.content {
+			return false
+		}
+	}
+	if certificate.IssuedHeight() < f.minHeight {
+		return false
+	}
+	if f.maxHeight != 0 && certificate.IssuedHeight() > f.maxHeight {
+		return false
+	}
+	return true
+}
+
+// iterateFilteredCertificateIDs iterates over the IDs of the certificates
+// matching the filter from the newest to the oldest. The most selective index
+// drives the iteration and the certificates are only decoded when the filter
+// has conditions which the index does not cover.
+func (k Keeper) iterateFilteredCertificateIDs(ctx sdk.Context, filter certificateFilter, callback func(id uint64) (stop bool)) {
+	if filter.contentType != types.RequestContentTypeNil && filter.content != "" {
+		k.iterateCertificateIDsByContent(ctx, filter, callback)
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var iterator sdk.Iterator
+	switch {
+	case len(filter.certifier) != 0:
+		prefix := types.CertifierIndexPrefix(filter.certifier)
+		end := sdk.PrefixEndBytes(prefix)
+		if filter.cursor != 0 {
+			end = types.CertifierIndexKey(filter.certifier, filter.cursor)
+		}
+		iterator = store.ReverseIterator(prefix, end)
+	case filter.certType != types.CertificateTypeNil:
+		prefix := types.CertificateTypeIndexPrefix(filter.certType)
+		end := sdk.PrefixEndBytes(prefix)
+		if filter.cursor != 0 {
+			end = types.CertificateTypeIndexKey(filter.certType, filter.cursor)
+		}
+		iterator = store.ReverseIterator(prefix, end)
+	default:
+		// Certificate IDs grow with the issuance height, so the height index
+		// also serves as the ID ordered index of all certificates.
+		start := types.IssuedHeightIndexPrefix(filter.minHeight)
+		end := sdk.PrefixEndBytes(types.IssuedHeightIndexKeyPrefix)
+		if filter.maxHeight != 0 {
+			end = types.IssuedHeightIndexPrefix(filter.maxHeight + 1)
+		}
+		if filter.cursor != 0 && (filter.maxHeight == 0 || filter.cursorHeight <= filter.maxHeight) {
+			end = types.IssuedHeightIndexKey(filter.cursorHeight, filter.cursor)
+		}
+		if bytes.Compare(start, end) >= 0 {
+			return
+		}
+		iterator = store.ReverseIterator(start, end)
+	}
+	// The driving index covers at most one condition and never the request content type.
+	decode := filter.conditions() > 1 || (filter.conditions() == 1 && filter.contentType != types.RequestContentTypeNil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := types.CertificateIDFromIndexKey(iterator.Key())
+		if decode {
+			certificate, err := k.GetCertificateByID(ctx, id)
+			if err != nil || !filter.matches(certificate) {
+				continue
+			}
+		}
+		if callback(id) {
+			break
+		}
+	}
+}
+
+// iterateCertificateIDsByContent iterates over the IDs of the certificates
+// matching a filter with request content, using the content index.
+func (k Keeper) iterateCertificateIDsByContent(ctx sdk.Context, filter certificateFilter, callback func(id uint64) (stop bool)) {
+	requestContent := types.RequestContent{RequestContentType: filter.contentType, RequestContent: filter.content}
+	var ids []uint64
+	for _, certType := range types.CertificateTypes {
+		if filter.certType != types.CertificateTypeNil && certType != filter.certType {
+			continue
+		}
+		id, found := k.GetContentCertID(ctx, certType, requestContent)
+		if !found || (filter.cursor != 0 && id >= filter.cursor) {
+			continue
+		}
+		certificate, err := k.GetCertificateByID(ctx, id)
+		if err != nil || !filter.matches(certificate) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	for _, id := range ids {
+		if callback(id) {
+			break
+		}
+	}
+}
+
+// certificateIssuedHeight returns the issuance height of a current or revoked certificate.
+func (k Keeper) certificateIssuedHeight(ctx sdk.Context, id uint64) (int64, error) {
+	if certificate, err := k.GetCertificateByID(ctx, id); err == nil {
+		return certificate.IssuedHeight(), nil
+	}
+	revocation, err := k.GetRevocation(ctx, id)
+	if err != nil {
+		return 0, types.ErrCertificateNotExists
+	}
+	return revocation.Certificate.IssuedHeight(), nil
+}
+
+// certificatesQueryLimit returns the page size of a certificates query.
+func certificatesQueryLimit(params types.QueryCertificatesParams) int {
+	if params.Limit <= 0 {
+		return defaultCertificatesQueryLimit
+	}
+	return params.Limit
+}
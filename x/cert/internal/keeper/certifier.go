@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
 )
@@ -15,7 +16,7 @@ func (k Keeper) SetCertifier(ctx sdk.Context, certifier types.Certifier) {
 	}
 }
 
-// deleteCertifier deletes a certifier.
+// deleteCertifier deletes a certifier and returns the remaining bond.
 func (k Keeper) deleteCertifier(ctx sdk.Context, certifierAddress sdk.AccAddress) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -23,6 +24,11 @@ func (k Keeper) deleteCertifier(ctx sdk.Context, certifierAddress sdk.AccAddress
 	if err != nil {
 		return err
 	}
+	if !certifier.Bond.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, certifierAddress, certifier.Bond); err != nil {
+			return err
+		}
+	}
 	alias := certifier.Alias
 	store.Delete(types.CertifierAliasStoreKey(alias))
 	store.Delete(types.CertifierStoreKey(certifierAddress))
@@ -93,3 +99,67 @@ func (k Keeper) GetAllCertifiers(ctx sdk.Context) types.Certifiers {
 	})
 	return certifiers
 }
+
+// DepositCertifierBond transfers a bond deposit from a certifier's account to the module account.
+// The first deposit must bring the certifier's bond to at least the minimum bond.
+func (k Keeper) DepositCertifierBond(ctx sdk.Context, certifierAddress sdk.AccAddress, amount sdk.Coins) error {
+	certifier, err := k.GetCertifier(ctx, certifierAddress)
+	if err != nil {
+		return types.ErrUnqualifiedCertifier
+	}
+	bond := certifier.Bond.Add(amount...)
+	if minBond := k.GetCertifierParams(ctx).MinBond; !bond.IsAllGTE(minBond) {
+		return sdkerrors.Wrapf(types.ErrInsufficientBond, "bond %s, minimum %s", bond, minBond)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, certifierAddress, types.ModuleName, amount); err != nil {
+		return err
+	}
+	certifier.Bond = bond
+	k.SetCertifier(ctx, certifier)
+	return nil
+}
+
+// SlashCertifier burns up to the given amount from a certifier's bond and returns the slashed amount.
+func (k Keeper) SlashCertifier(ctx sdk.Context, certifierAddress sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	certifier, err := k.GetCertifier(ctx, certifierAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	slashed := sdk.NewCoins()
+	for _, coin := range amount {
+		bonded := certifier.Bond.AmountOf(coin.Denom)
+		slashed = slashed.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(bonded, coin.Amount)))
+	}
+	if slashed.IsZero() {
+		return nil, types.ErrInvalidSlashAmount
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+		return nil, err
+	}
+	certifier.Bond = certifier.Bond.Sub(slashed)
+	k.SetCertifier(ctx, certifier)
+	return slashed, nil
+}
+
+// incrementRevokedCertificates records a revoked certificate against the
+// certifier who issued it, if it is still a certifier.
+func (k Keeper) incrementRevokedCertificates(ctx sdk.Context, certifierAddress sdk.AccAddress) {
+	certifier, err := k.GetCertifier(ctx, certifierAddress)
+	if err != nil {
+		return
+	}
+	certifier.RevokedCertificates++
+	k.SetCertifier(ctx, certifier)
+}
+
+// GetCertifierReputation returns the reputation of a certifier derived
+// from its issued and revoked certificates.
+func (k Keeper) GetCertifierReputation(ctx sdk.Context, certifierAddress sdk.AccAddress) (types.CertifierReputation, error) {
+	certifier, err := k.GetCertifier(ctx, certifierAddress)
+	if err != nil {
+		return types.CertifierReputation{}, err
+	}
+	active := uint64(len(k.GetCertifierCertIDs(ctx, certifierAddress)))
+	return types.NewCertifierReputation(certifierAddress, active, certifier.RevokedCertificates), nil
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// GetIdentityCertificate returns the identity certificate of an address.
+func (k Keeper) GetIdentityCertificate(ctx sdk.Context, identity sdk.AccAddress) (types.Certificate, bool) {
+	requestContent := types.RequestContent{RequestContentType: types.RequestContentTypeAddress, RequestContent: identity.String()}
+	return k.GetCertificateByTypeAndContent(ctx, types.CertificateTypeIdentity, requestContent)
+}
+
+// RequireCertifiedIdentity returns an error unless the address holds a valid identity
+// certificate with claims of all the given attributes. Modules use it to restrict
+// features, such as Shield purchases or oracle operation, to certified identities.
+func (k Keeper) RequireCertifiedIdentity(ctx sdk.Context, identity sdk.AccAddress, attributes ...string) error {
+	certificate, found := k.GetIdentityCertificate(ctx, identity)
+	if !found || !k.IsCertificateValid(ctx, certificate) {
+		return sdkerrors.Wrap(types.ErrIdentityNotCertified, identity.String())
+	}
+	// Identities certified by general certificates carry no claims.
+	var content types.IdentityCertificateContent
+	if identityCertificate, ok := certificate.(*types.IdentityCertificate); ok {
+		content = identityCertificate.CertContent
+	}
+	for _, attribute := range attributes {
+		if _, found := content.Claim(attribute); !found {
+			return sdkerrors.Wrapf(types.ErrIdentityNotCertified, "%s has no claim of attribute %s", identity, attribute)
+		}
+	}
+	return nil
+}
+
+// VerifyIdentityDisclosures checks the disclosed attribute values of an address
+// against the claims of its valid identity certificate and returns the certificate.
+func (k Keeper) VerifyIdentityDisclosures(
+	ctx sdk.Context, identity sdk.AccAddress, disclosures []types.IdentityDisclosure,
+) (types.Certificate, error) {
+	attributes := make([]string, 0, len(disclosures))
+	for _, disclosure := range disclosures {
+		attributes = append(attributes, disclosure.Attribute)
+	}
+	if err := k.RequireCertifiedIdentity(ctx, identity, attributes...); err != nil {
+		return nil, err
+	}
+	certificate, _ := k.GetIdentityCertificate(ctx, identity)
+	if len(disclosures) == 0 {
+		return certificate, nil
+	}
+	if err := certificate.(*types.IdentityCertificate).CertContent.Verify(disclosures); err != nil {
+		return nil, err
+	}
+	return certificate, nil
+}
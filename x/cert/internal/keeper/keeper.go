@@ -2,6 +2,8 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,38 +18,104 @@ type Keeper struct {
 	cdc            *codec.Codec
 	slashingKeeper types.SlashingKeeper
 	stakingKeeper  types.StakingKeeper
+	supplyKeeper   types.SupplyKeeper
+	paramSpace     types.ParamSubspace
 }
 
 // NewKeeper creates a new instance of the certifier keeper.
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, slashingKeeper types.SlashingKeeper, stakingKeeper types.StakingKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, slashingKeeper types.SlashingKeeper, stakingKeeper types.StakingKeeper,
+	supplyKeeper types.SupplyKeeper, paramSpace types.ParamSubspace) Keeper {
 	return Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
 		slashingKeeper: slashingKeeper,
 		stakingKeeper:  stakingKeeper,
+		supplyKeeper:   supplyKeeper,
+		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
 	}
 }
 
 // CertifyPlatform certifies a validator host platform by a certifier.
-func (k Keeper) CertifyPlatform(ctx sdk.Context, certifier sdk.AccAddress, validator crypto.PubKey, description string) error {
+func (k Keeper) CertifyPlatform(
+	ctx sdk.Context, certifier sdk.AccAddress, validator crypto.PubKey, description string, attestation types.PlatformAttestation,
+) error {
 	if !k.IsCertifier(ctx, certifier) {
 		return types.ErrRejectedValidator
 	}
+	if err := attestation.ValidateBasic(); err != nil {
+		return err
+	}
+	if !attestation.IsCurrent(ctx.BlockTime()) {
+		return types.ErrPlatformValidUntil
+	}
 	platform := types.Platform{
 		Validator:   validator,
 		Description: description,
+		Certifier:   certifier,
+		Attestation: attestation,
+		Height:      ctx.BlockHeight(),
 	}
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(platform)
-	ctx.KVStore(k.storeKey).Set(types.PlatformStoreKey(validator), bz)
+	k.SetPlatform(ctx, platform)
 	return nil
 }
 
-// GetPlatform returns the host platform of the validator.
-func (k Keeper) GetPlatform(ctx sdk.Context, validator crypto.PubKey) (string, bool) {
-	if platform := ctx.KVStore(k.storeKey).Get(types.PlatformStoreKey(validator)); platform != nil {
-		return string(platform), true
+// SetPlatform sets the host platform certificate of a validator.
+func (k Keeper) SetPlatform(ctx sdk.Context, platform types.Platform) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(platform)
+	ctx.KVStore(k.storeKey).Set(types.PlatformStoreKey(platform.Validator), bz)
+}
+
+// GetPlatform returns the host platform certificate of the validator.
+func (k Keeper) GetPlatform(ctx sdk.Context, validator crypto.PubKey) (types.Platform, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PlatformStoreKey(validator))
+	if bz == nil {
+		return types.Platform{}, false
 	}
-	return "", false
+	var platform types.Platform
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &platform)
+	return platform, true
+}
+
+// HasCurrentPlatformCertificate returns whether the validator has a host platform
+// certificate whose attestation has not expired.
+func (k Keeper) HasCurrentPlatformCertificate(ctx sdk.Context, validator crypto.PubKey) bool {
+	platform, found := k.GetPlatform(ctx, validator)
+	return found && platform.Attestation.IsCurrent(ctx.BlockTime())
+}
+
+// GetPlatformRanking returns the certified host platforms ranked by trust: current
+// attestations come first, followed by the hardware type and the attestation expiry.
+// Platforms with a hardware type below minHardwareType are left out, as are expired
+// attestations if currentOnly is set.
+func (k Keeper) GetPlatformRanking(
+	ctx sdk.Context, currentOnly bool, minHardwareType types.HardwareType,
+) []types.Platform {
+	now := ctx.BlockTime()
+	var platforms []types.Platform
+	for _, platform := range k.GetAllPlatforms(ctx) {
+		if platform.Attestation.HardwareType < minHardwareType {
+			continue
+		}
+		if currentOnly && !platform.Attestation.IsCurrent(now) {
+			continue
+		}
+		platforms = append(platforms, platform)
+	}
+	sort.SliceStable(platforms, func(i, j int) bool {
+		a, b := platforms[i].Attestation, platforms[j].Attestation
+		if a.IsCurrent(now) != b.IsCurrent(now) {
+			return a.IsCurrent(now)
+		}
+		if a.HardwareType != b.HardwareType {
+			return a.HardwareType > b.HardwareType
+		}
+		// A permanent attestation outranks any expiring one.
+		if a.ValidUntil.IsZero() != b.ValidUntil.IsZero() {
+			return a.ValidUntil.IsZero()
+		}
+		return a.ValidUntil.After(b.ValidUntil)
+	})
+	return platforms
 }
 
 // GetAllPlatforms gets all platform certificates for genesis export
@@ -36,8 +36,23 @@ func (k Keeper) getLibraryPublisher(ctx sdk.Context, library sdk.AccAddress) (sd
 	return nil, types.ErrLibraryNotExists
 }
 
+// GetLibrary gets a Certificate library registry.
+func (k Keeper) GetLibrary(ctx sdk.Context, library sdk.AccAddress) (types.Library, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LibraryStoreKey(library))
+	if bz == nil {
+		return types.Library{}, types.ErrLibraryNotExists
+	}
+	var libraryData types.Library
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &libraryData)
+	return libraryData, nil
+}
+
 // PublishLibrary publishes a new Certificate library.
 func (k Keeper) PublishLibrary(ctx sdk.Context, library sdk.AccAddress, publisher sdk.AccAddress) error {
+	if !k.IsCertifier(ctx, publisher) {
+		return types.ErrUnqualifiedCertifier
+	}
 	if k.IsLibrary(ctx, library) {
 		return types.ErrLibraryAlreadyExists
 	}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// SetCertifierParams sets the current certifier params to the global param store.
+func (k Keeper) SetCertifierParams(ctx sdk.Context, certifierParams types.CertifierParams) {
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyCertifierParams, &certifierParams)
+}
+
+// GetCertifierParams gets the current certifier params from the global param store.
+func (k Keeper) GetCertifierParams(ctx sdk.Context) types.CertifierParams {
+	var certifierParams types.CertifierParams
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyCertifierParams, &certifierParams)
+	return certifierParams
+}
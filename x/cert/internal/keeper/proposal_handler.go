@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
@@ -30,3 +32,20 @@ func HandleCertifierUpdateProposal(ctx sdk.Context, k Keeper, p types.CertifierU
 		return types.ErrAddOrRemove
 	}
 }
+
+// HandleCertifierSlashProposal is a handler for executing a passed certifier slash proposal
+func HandleCertifierSlashProposal(ctx sdk.Context, k Keeper, p types.CertifierSlashProposal) error {
+	slashed, err := k.SlashCertifier(ctx, p.Certifier, p.Amount)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashCertifier,
+			sdk.NewAttribute("certifier", p.Certifier.String()),
+			sdk.NewAttribute("amount", slashed.String()),
+			sdk.NewAttribute("claim_proposal_id", strconv.FormatUint(p.ClaimProposalID, 10)),
+		),
+	)
+	return nil
+}
@@ -26,10 +26,26 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryCertifiedValidators(ctx, path[1:], keeper)
 		case types.QueryPlatform:
 			return queryPlatform(ctx, path[1:], keeper)
+		case types.QueryPlatforms:
+			return queryPlatforms(ctx, path[1:], req, keeper)
 		case types.QueryCertificate:
 			return queryCertificate(ctx, path[1:], keeper)
 		case types.QueryCertificates:
 			return queryCertificates(ctx, path[1:], req, keeper)
+		case types.QueryLibrary:
+			return queryLibrary(ctx, path[1:], keeper)
+		case types.QueryLibraries:
+			return queryLibraries(ctx, path[1:], keeper)
+		case types.QueryCertifierReputation:
+			return queryCertifierReputation(ctx, path[1:], keeper)
+		case types.QueryCertifierParams:
+			return queryCertifierParams(ctx, path[1:], keeper)
+		case types.QueryRevocation:
+			return queryRevocation(ctx, path[1:], keeper)
+		case types.QueryRevocationList:
+			return queryRevocationList(ctx, path[1:], req, keeper)
+		case types.QueryVerifyIdentity:
+			return queryVerifyIdentity(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown cert query endpoint")
 		}
@@ -63,6 +79,37 @@ func queryCertifier(ctx sdk.Context, path []string, keeper Keeper) (res []byte,
 	return res, nil
 }
 
+// queryCertifierReputation returns the reputation of a certifier.
+func queryCertifierReputation(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+	certifierAddress, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+	reputation, err := keeper.GetCertifierReputation(ctx, certifierAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err = codec.MarshalJSONIndent(keeper.cdc, reputation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryCertifierParams(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	res, err = codec.MarshalJSONIndent(keeper.cdc, keeper.GetCertifierParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
 func queryCertifiers(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err error) {
 	err = validatePathLength(path, 0)
 	if err != nil {
@@ -138,10 +185,68 @@ func queryPlatform(ctx sdk.Context, path []string, keeper Keeper) (res []byte, e
 		return nil, nil
 	}
 
-	res, err2 := codec.MarshalJSONIndent(keeper.cdc, types.QueryResPlatform{Platform: platform})
+	res, err2 := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryResPlatform(platform, ctx.BlockTime()))
 	if err2 != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err2.Error())
 	}
 
 	return res, nil
 }
+
+// queryPlatforms returns the certified host platforms ranked by trust.
+func queryPlatforms(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryPlatformsParams
+	if len(req.Data) != 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+	minHardwareType := types.HardwareTypeFromString(params.MinHardwareType)
+	if minHardwareType == types.HardwareTypeNil {
+		return nil, types.ErrInvalidHardwareType
+	}
+
+	platforms := keeper.GetPlatformRanking(ctx, params.CurrentOnly, minHardwareType)
+	result := types.QueryResPlatforms{Platforms: make([]types.QueryResPlatform, 0, len(platforms))}
+	for _, platform := range platforms {
+		result.Platforms = append(result.Platforms, types.NewQueryResPlatform(platform, ctx.BlockTime()))
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryLibrary(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+	library, err := keeper.GetLibrary(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	res, err = codec.MarshalJSONIndent(keeper.cdc, library)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryLibraries(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	res, err = codec.MarshalJSONIndent(keeper.cdc, types.QueryResLibraries{Libraries: keeper.GetAllLibraries(ctx)})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"strconv"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -38,6 +39,8 @@ type QueryResCertificate struct {
 	Description        string                 `json:"description"`
 	Certifier          string                 `json:"certifier"`
 	TxHash             string                 `json:"txhash"`
+	ValidUntil         time.Time              `json:"valid_until"`
+	Expired            bool                   `json:"expired"`
 }
 
 func NewQueryResCertificate(
@@ -48,6 +51,8 @@ func NewQueryResCertificate(
 	description string,
 	certifier string,
 	txhash string,
+	validUntil time.Time,
+	expired bool,
 ) QueryResCertificate {
 	resRequestContent := NewQueryResRequestContent(
 		requestContent.RequestContentType,
@@ -62,6 +67,8 @@ func NewQueryResCertificate(
 		Description:        description,
 		Certifier:          certifier,
 		TxHash:             txhash,
+		ValidUntil:         validUntil,
+		Expired:            expired,
 	}
 }
 
@@ -86,6 +93,8 @@ func queryCertificate(ctx sdk.Context, path []string, keeper Keeper) ([]byte, er
 		certificate.Description(),
 		certificate.Certifier().String(),
 		certificate.TxHash(),
+		certificate.ValidUntil(),
+		keeper.IsCertificateExpired(ctx, certificate),
 	)
 	res, err := codec.MarshalJSONIndent(keeper.cdc, resCertificate)
 	if err != nil {
@@ -97,6 +106,7 @@ func queryCertificate(ctx sdk.Context, path []string, keeper Keeper) ([]byte, er
 type QueryResCertificates struct {
 	Total        uint64                `json:"total"`
 	Certificates []QueryResCertificate `json:"certificates"`
+	NextCursor   uint64                `json:"next_cursor"`
 }
 
 func queryCertificates(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
@@ -123,12 +133,90 @@ func queryCertificates(ctx sdk.Context, path []string, req abci.RequestQuery, ke
 			certificate.Description(),
 			certificate.Certifier().String(),
 			certificate.TxHash(),
+			certificate.ValidUntil(),
+			keeper.IsCertificateExpired(ctx, certificate),
 		)
 		resCertificates = append(resCertificates, resCertificate)
 	}
-	res, err := codec.MarshalJSONIndent(keeper.cdc, QueryResCertificates{Total: total, Certificates: resCertificates})
+	// A full page may be followed by older certificates, which the next query can start from.
+	var nextCursor uint64
+	if len(certificates) == certificatesQueryLimit(params) {
+		nextCursor = certificates[len(certificates)-1].ID()
+	}
+	res, err := codec.MarshalJSONIndent(
+		keeper.cdc,
+		QueryResCertificates{Total: total, Certificates: resCertificates, NextCursor: nextCursor},
+	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
 	return res, err
 }
+
+// queryRevocation returns the revocation registry entry of a certificate.
+func queryRevocation(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	revocation, err := keeper.GetRevocation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, revocation)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryRevocationList returns the CRL-style list of revoked certificates.
+func queryRevocationList(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryRevocationListParams
+	if len(req.Data) != 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetRevocationList(ctx, params.SinceHeight))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+// queryVerifyIdentity verifies disclosed identity attributes against the claims of an identity certificate.
+func queryVerifyIdentity(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryVerifyIdentityParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	certificate, err := keeper.VerifyIdentityDisclosures(ctx, params.Identity, params.Disclosures)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.QueryResVerifyIdentity{
+		Identity:      params.Identity,
+		CertificateID: certificate.ID(),
+		Certifier:     certificate.Certifier(),
+		Verified:      make([]string, 0, len(params.Disclosures)),
+	}
+	for _, disclosure := range params.Disclosures {
+		result.Verified = append(result.Verified, disclosure.Attribute)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
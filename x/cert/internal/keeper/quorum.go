@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// ProposeQuorumCertificate issues a quorum certificate signed by its proposer,
+// which stays pending until enough certifiers have co-signed it.
+func (k Keeper) ProposeQuorumCertificate(ctx sdk.Context, certificate *types.QuorumCertificate) (uint64, error) {
+	if certificate.Threshold == 0 || certificate.Threshold > uint64(len(k.GetAllCertifiers(ctx))) {
+		return 0, types.ErrInvalidThreshold
+	}
+	if !certificate.Deadline.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidQuorumWindow
+	}
+	id, err := k.IssueCertificate(ctx, certificate)
+	if err != nil {
+		return 0, err
+	}
+	if !certificate.QuorumReached() {
+		k.InsertQuorumDeadlineQueue(ctx, id, certificate.Deadline)
+	}
+	return id, nil
+}
+
+// SignQuorumCertificate adds a certifier's signature to a quorum certificate
+// and returns whether the quorum has been reached.
+func (k Keeper) SignQuorumCertificate(ctx sdk.Context, id uint64, signer sdk.AccAddress) (bool, error) {
+	if !k.IsCertifier(ctx, signer) {
+		return false, types.ErrUnqualifiedCertifier
+	}
+	certificate, err := k.GetCertificateByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	quorumCertificate, ok := certificate.(*types.QuorumCertificate)
+	if !ok {
+		return false, types.ErrNotQuorumCertificate
+	}
+	if !quorumCertificate.Deadline.After(ctx.BlockTime()) {
+		return false, types.ErrQuorumWindowClosed
+	}
+	if quorumCertificate.HasSigned(signer) {
+		return false, types.ErrAlreadySigned
+	}
+
+	reachedBefore := quorumCertificate.QuorumReached()
+	if !reachedBefore {
+		// Signatures of removed certifiers no longer count towards the quorum.
+		k.pruneQuorumSigners(ctx, quorumCertificate)
+	}
+	quorumCertificate.AddSigner(signer)
+	if !reachedBefore && quorumCertificate.QuorumReached() {
+		// Pending quorum certificates do not occupy their content, which
+		// may have been certified in the meantime.
+		if _, found := k.GetContentCertID(ctx, quorumCertificate.Type(), quorumCertificate.RequestContent()); found {
+			return false, types.ErrDuplicateCertificate
+		}
+		k.SetContentCertID(ctx, quorumCertificate.Type(), quorumCertificate.RequestContent(), id)
+		k.RemoveFromQuorumDeadlineQueue(ctx, id, quorumCertificate.Deadline)
+	}
+	k.SetCertificate(ctx, quorumCertificate)
+	return quorumCertificate.QuorumReached(), nil
+}
+
+// pruneQuorumSigners removes the signers of a quorum certificate who are no
+// longer certifiers.
+func (k Keeper) pruneQuorumSigners(ctx sdk.Context, certificate *types.QuorumCertificate) {
+	var signers []sdk.AccAddress
+	for _, signer := range certificate.Signers {
+		if k.IsCertifier(ctx, signer) {
+			signers = append(signers, signer)
+		}
+	}
+	certificate.Signers = signers
+}
+
+// isPendingQuorumCertificate returns whether a certificate is a quorum
+// certificate that has not reached its threshold yet.
+func isPendingQuorumCertificate(certificate types.Certificate) bool {
+	quorumCertificate, ok := certificate.(*types.QuorumCertificate)
+	return ok && !quorumCertificate.QuorumReached()
+}
+
+// IsQuorumCertified checks if a valid quorum certificate of given type and content exists.
+func (k Keeper) IsQuorumCertified(ctx sdk.Context, contentType string, content string, certType string) bool {
+	requestContent, err := types.NewRequestContent(contentType, content)
+	if err != nil {
+		return false
+	}
+	certificateType := types.CertificateTypeFromString(certType)
+
+	certificate, found := k.GetCertificateByTypeAndContent(ctx, certificateType, requestContent)
+	if !found {
+		return false
+	}
+	if _, ok := certificate.(*types.QuorumCertificate); !ok {
+		return false
+	}
+	return k.IsCertificateValid(ctx, certificate)
+}
+
+//
+// deadline -> []CertID
+//
+
+// GetQuorumDeadlineQueueTimeSlice gets the IDs of quorum certificates whose signing window closes at the given time.
+func (k Keeper) GetQuorumDeadlineQueueTimeSlice(ctx sdk.Context, timestamp time.Time) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.QuorumDeadlineQueueKey(timestamp))
+	if bz == nil {
+		return []uint64{}
+	}
+	var ids []uint64
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ids)
+	return ids
+}
+
+// SetQuorumDeadlineQueueTimeSlice sets the IDs of quorum certificates whose signing window closes at the given time.
+func (k Keeper) SetQuorumDeadlineQueueTimeSlice(ctx sdk.Context, timestamp time.Time, ids []uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if len(ids) == 0 {
+		store.Delete(types.QuorumDeadlineQueueKey(timestamp))
+		return
+	}
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(ids)
+	store.Set(types.QuorumDeadlineQueueKey(timestamp), bz)
+}
+
+// InsertQuorumDeadlineQueue adds a quorum certificate ID to the deadline queue.
+func (k Keeper) InsertQuorumDeadlineQueue(ctx sdk.Context, id uint64, deadline time.Time) {
+	ids := k.GetQuorumDeadlineQueueTimeSlice(ctx, deadline)
+	ids = append(ids, id)
+	k.SetQuorumDeadlineQueueTimeSlice(ctx, deadline, ids)
+}
+
+// RemoveFromQuorumDeadlineQueue removes a quorum certificate ID from the deadline queue.
+func (k Keeper) RemoveFromQuorumDeadlineQueue(ctx sdk.Context, id uint64, deadline time.Time) {
+	ids := k.GetQuorumDeadlineQueueTimeSlice(ctx, deadline)
+	for i := range ids {
+		if ids[i] == id {
+			ids = append(ids[:i], ids[i+1:]...)
+			break
+		}
+	}
+	k.SetQuorumDeadlineQueueTimeSlice(ctx, deadline, ids)
+}
+
+// QuorumDeadlineQueueIterator returns an iterator of quorum certificates whose signing window closes no later than endTime.
+func (k Keeper) QuorumDeadlineQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.QuorumDeadlineQueueKeyPrefix,
+		sdk.InclusiveEndBytes(types.QuorumDeadlineQueueKey(endTime)))
+}
+
+// FailQuorumCertificates removes the quorum certificates that did not
+// reach their threshold before the signing window closed.
+func (k Keeper) FailQuorumCertificates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.QuorumDeadlineQueueIterator(ctx, ctx.BlockTime())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ids []uint64
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ids)
+		for _, id := range ids {
+			certificate, err := k.GetCertificateByID(ctx, id)
+			if err != nil {
+				continue
+			}
+			if err := k.deleteCertificateRecords(ctx, certificate); err != nil {
+				panic(err)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeQuorumCertFailed,
+					sdk.NewAttribute("certificate_id", strconv.FormatUint(id, 10)),
+					sdk.NewAttribute("request_content", certificate.RequestContent().RequestContent),
+				),
+			)
+		}
+		store.Delete(iterator.Key())
+	}
+}
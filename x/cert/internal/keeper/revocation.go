@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/cert/internal/types"
+)
+
+// SetRevocation stores a revocation in the revocation registry.
+func (k Keeper) SetRevocation(ctx sdk.Context, revocation types.Revocation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RevocationStoreKey(revocation.Certificate.ID()), k.cdc.MustMarshalBinaryLengthPrefixed(revocation))
+}
+
+// GetRevocation returns the revocation of a certificate.
+func (k Keeper) GetRevocation(ctx sdk.Context, id uint64) (types.Revocation, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RevocationStoreKey(id))
+	if bz == nil {
+		return types.Revocation{}, types.ErrRevocationNotExists
+	}
+	var revocation types.Revocation
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &revocation)
+	return revocation, nil
+}
+
+// IterateRevocations iterates over the revocations in the order of certificate IDs and performs a callback function.
+func (k Keeper) IterateRevocations(ctx sdk.Context, callback func(revocation types.Revocation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RevocationsStoreKey())
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revocation types.Revocation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revocation)
+
+		if callback(revocation) {
+			break
+		}
+	}
+}
+
+// GetAllRevocations gets all revocations.
+func (k Keeper) GetAllRevocations(ctx sdk.Context) (revocations types.Revocations) {
+	k.IterateRevocations(ctx, func(revocation types.Revocation) bool {
+		revocations = append(revocations, revocation)
+		return false
+	})
+	return revocations
+}
+
+// GetRevocationList returns the CRL-style list of the certificates revoked after the given height.
+func (k Keeper) GetRevocationList(ctx sdk.Context, sinceHeight int64) types.RevocationList {
+	list := types.RevocationList{
+		ThisUpdate:          ctx.BlockTime(),
+		Height:              ctx.BlockHeight(),
+		RevokedCertificates: []types.RevocationListEntry{},
+	}
+	k.IterateRevocations(ctx, func(revocation types.Revocation) bool {
+		if revocation.Height > sinceHeight {
+			list.RevokedCertificates = append(list.RevokedCertificates, types.NewRevocationListEntry(revocation))
+		}
+		return false
+	})
+	return list
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CertificateBundle is a self-contained proof that a certificate is stored on chain.
+// The Merkle proof of the certificate store entry at Height is checked against the
+// app hash of Header, which is the signed header of the block at Height + 1.
+type CertificateBundle struct {
+	Certificate Certificate          `json:"certificate"`
+	TxHash      string               `json:"txhash"`
+	Height      int64                `json:"height"`
+	Value       []byte               `json:"value"`
+	Proof       *merkle.Proof        `json:"proof"`
+	Header      tmtypes.SignedHeader `json:"header"`
+}
+
+// NewCertificateBundle returns a new certificate bundle.
+func NewCertificateBundle(
+	certificate Certificate, height int64, value []byte, proof *merkle.Proof, header tmtypes.SignedHeader,
+) CertificateBundle {
+	return CertificateBundle{
+		Certificate: certificate,
+		TxHash:      certificate.TxHash(),
+		Height:      height,
+		Value:       value,
+		Proof:       proof,
+		Header:      header,
+	}
+}
+
+// Verify checks the bundle offline against the hash of a trusted block header.
+func (b CertificateBundle) Verify(cdc *codec.Codec, trustedHeaderHash []byte) error {
+	if b.Certificate == nil || b.Proof == nil || b.Header.Header == nil {
+		return sdkerrors.Wrap(ErrInvalidCertificateBundle, "missing certificate, proof or header")
+	}
+	if err := b.Header.ValidateBasic(b.Header.ChainID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidCertificateBundle, err.Error())
+	}
+	if !bytes.Equal(b.Header.Hash(), trustedHeaderHash) {
+		return sdkerrors.Wrapf(ErrInvalidCertificateBundle, "header hash %X is not trusted", b.Header.Hash())
+	}
+	if b.Header.Height != b.Height+1 {
+		return sdkerrors.Wrapf(ErrInvalidCertificateBundle, "header height %d does not follow proof height %d", b.Header.Height, b.Height)
+	}
+	if !bytes.Equal(b.Certificate.Bytes(cdc), b.Value) {
+		return sdkerrors.Wrap(ErrInvalidCertificateBundle, "certificate does not match the proven value")
+	}
+	if b.TxHash != b.Certificate.TxHash() {
+		return sdkerrors.Wrap(ErrInvalidCertificateBundle, "tx hash does not match the certificate")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(CertificateStoreKey(b.Certificate.ID()), merkle.KeyEncodingURL)
+	err := rootmulti.DefaultProofRuntime().VerifyValue(b.Proof, b.Header.AppHash, keyPath.String(), b.Value)
+	if err != nil {
+		return sdkerrors.Wrap(ErrInvalidCertificateBundle, err.Error())
+	}
+	return nil
+}
+
+// String implements fmt.Stringer.
+func (b CertificateBundle) String() string {
+	return fmt.Sprintf("Certificate bundle\n"+
+		"Height: %d\n"+
+		"Block hash: %X\n"+
+		"App hash: %X\n"+
+		"%s",
+		b.Height, b.Header.Hash(), b.Header.AppHash, b.Certificate)
+}
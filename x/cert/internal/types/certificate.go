@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -95,12 +96,27 @@ type Certificate interface {
 	FormattedCertificateContent() []KVPair
 	Description() string
 	TxHash() string
+	ValidUntil() time.Time
+	Expired() bool
+	IssuedHeight() int64
 
 	Bytes(*codec.Codec) []byte
 	String() string
 
 	SetCertificateID(uint64)
 	SetTxHash(string)
+	SetValidUntil(time.Time)
+	SetExpired(bool)
+	SetIssuedHeight(int64)
+}
+
+// formatValidUntil returns a human readable string of the time until
+// which a certificate is valid, where a zero time means no expiry.
+func formatValidUntil(validUntil time.Time) string {
+	if validUntil.IsZero() {
+		return "permanent"
+	}
+	return validUntil.String()
 }
 
 // RequestContentType is the type for requestContent
@@ -181,12 +197,15 @@ func NewRequestContent(
 
 // GeneralCertificate defines the type for general certificate.
 type GeneralCertificate struct {
-	CertID          uint64          `json:"certificate_id"`
-	CertType        CertificateType `json:"certificate_type"`
-	ReqContent      RequestContent  `json:"request_content"`
-	CertDescription string          `json:"description"`
-	CertCertifier   sdk.AccAddress  `json:"certifier"`
-	CertTxHash      string          `json:"txhash"`
+	CertID           uint64          `json:"certificate_id"`
+	CertType         CertificateType `json:"certificate_type"`
+	ReqContent       RequestContent  `json:"request_content"`
+	CertDescription  string          `json:"description"`
+	CertCertifier    sdk.AccAddress  `json:"certifier"`
+	CertTxHash       string          `json:"txhash"`
+	CertValidUntil   time.Time       `json:"valid_until"`
+	CertExpired      bool            `json:"expired"`
+	CertIssuedHeight int64           `json:"issued_height"`
 }
 
 // NewGeneralCertificate returns a new general certificate.
@@ -249,6 +268,17 @@ func (c *GeneralCertificate) TxHash() string {
 	return c.CertTxHash
 }
 
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *GeneralCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *GeneralCertificate) Expired() bool {
+	return c.CertExpired
+}
+
 // Bytes returns a byte array for the certificate.
 func (c *GeneralCertificate) Bytes(cdc *codec.Codec) []byte {
 	return cdc.MustMarshalBinaryLengthPrefixed(c)
@@ -262,8 +292,11 @@ func (c *GeneralCertificate) String() string {
 		"RequestContent:\n%s\n"+
 		"Description: %s\n"+
 		"Certifier: %s\n"+
-		"TxHash: %s\n",
-		strconv.FormatUint(c.CertID, 10), c.CertType.String(), c.ReqContent.RequestContent, c.CertDescription, c.CertCertifier.String(), c.CertTxHash)
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.CertType.String(), c.ReqContent.RequestContent, c.CertDescription, c.CertCertifier.String(), c.CertTxHash,
+		formatValidUntil(c.CertValidUntil), c.CertExpired)
 }
 
 // SetCertificateID provides a method to set an ID for the certificate.
@@ -276,6 +309,26 @@ func (c *GeneralCertificate) SetTxHash(txhash string) {
 	c.CertTxHash = txhash
 }
 
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *GeneralCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *GeneralCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *GeneralCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *GeneralCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
 // CompilationCertificateContent defines type for the compilation certificate content.
 type CompilationCertificateContent struct {
 	Compiler     string `json:"compiler"`
@@ -316,6 +369,9 @@ type CompilationCertificate struct {
 	CertDescription  string                        `json:"description"`
 	CertCertifier    sdk.AccAddress                `json:"certifier"`
 	CertTxHash       string                        `json:"txhash"`
+	CertValidUntil   time.Time                     `json:"valid_until"`
+	CertExpired      bool                          `json:"expired"`
+	CertIssuedHeight int64                         `json:"issued_height"`
 }
 
 // NewCompilationCertificate returns a new compilation certificate
@@ -381,6 +437,17 @@ func (c *CompilationCertificate) TxHash() string {
 	return c.CertTxHash
 }
 
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *CompilationCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *CompilationCertificate) Expired() bool {
+	return c.CertExpired
+}
+
 // Bytes returns a byte array for the certificate.
 func (c *CompilationCertificate) Bytes(cdc *codec.Codec) []byte {
 	return cdc.MustMarshalBinaryLengthPrefixed(c)
@@ -395,9 +462,11 @@ func (c *CompilationCertificate) String() string {
 		"CertificateContent:\n%s\n"+
 		"Description: %s\n"+
 		"Certifier: %s\n"+
-		"TxHash: %s\n",
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
 		strconv.FormatUint(c.CertID, 10), c.ReqContent.RequestContent, c.CertificateContent(),
-		c.Description(), c.CertCertifier.String(), c.CertTxHash)
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
 }
 
 // SetCertificateID provides a method to set an ID for the certificate.
@@ -409,3 +478,852 @@ func (c *CompilationCertificate) SetCertificateID(id uint64) {
 func (c *CompilationCertificate) SetTxHash(txhash string) {
 	c.CertTxHash = txhash
 }
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *CompilationCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *CompilationCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *CompilationCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *CompilationCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// SeverityCounts defines the number of audit findings of each severity.
+type SeverityCounts struct {
+	Critical      uint64 `json:"critical"`
+	Major         uint64 `json:"major"`
+	Medium        uint64 `json:"medium"`
+	Minor         uint64 `json:"minor"`
+	Informational uint64 `json:"informational"`
+}
+
+// NewSeverityCounts returns a new severity counts.
+func NewSeverityCounts(critical, major, medium, minor, informational uint64) SeverityCounts {
+	return SeverityCounts{
+		Critical:      critical,
+		Major:         major,
+		Medium:        medium,
+		Minor:         minor,
+		Informational: informational,
+	}
+}
+
+// String returns string of the severity counts.
+func (s SeverityCounts) String() string {
+	return fmt.Sprintf("Critical: %d, Major: %d, Medium: %d, Minor: %d, Informational: %d",
+		s.Critical, s.Major, s.Medium, s.Minor, s.Informational)
+}
+
+// AuditingCertificateContent defines type for the auditing certificate content.
+type AuditingCertificateContent struct {
+	ReportHash     string         `json:"report_hash"`
+	Scope          string         `json:"scope"`
+	SeverityCounts SeverityCounts `json:"severity_counts"`
+	AuditorFirm    string         `json:"auditor_firm"`
+}
+
+// NewAuditingCertificateContent returns a new auditing certificate content.
+func NewAuditingCertificateContent(reportHash, scope string, severityCounts SeverityCounts, auditorFirm string) AuditingCertificateContent {
+	return AuditingCertificateContent{
+		ReportHash:     reportHash,
+		Scope:          scope,
+		SeverityCounts: severityCounts,
+		AuditorFirm:    auditorFirm,
+	}
+}
+
+// String returns string of the auditing certificate content.
+func (c AuditingCertificateContent) String() string {
+	return fmt.Sprintf("Auditing certificate content:\n"+
+		"Report Hash: %s\n"+
+		"Scope: %s\n"+
+		"Severity Counts: %s\n"+
+		"Auditor Firm: %s",
+		c.ReportHash, c.Scope, c.SeverityCounts, c.AuditorFirm)
+}
+
+// ProofCertificateContent defines type for the proof certificate content.
+type ProofCertificateContent struct {
+	SpecHash   string   `json:"spec_hash"`
+	Prover     string   `json:"prover"`
+	Properties []string `json:"properties"`
+}
+
+// NewProofCertificateContent returns a new proof certificate content.
+func NewProofCertificateContent(specHash, prover string, properties []string) ProofCertificateContent {
+	return ProofCertificateContent{SpecHash: specHash, Prover: prover, Properties: properties}
+}
+
+// String returns string of the proof certificate content.
+func (c ProofCertificateContent) String() string {
+	return fmt.Sprintf("Proof certificate content:\n"+
+		"Spec Hash: %s\n"+
+		"Prover: %s\n"+
+		"Properties: %s",
+		c.SpecHash, c.Prover, strings.Join(c.Properties, ", "))
+}
+
+// OracleOperatorCertificateContent defines type for the oracle operator certificate content.
+type OracleOperatorCertificateContent struct {
+	Name        string   `json:"name"`
+	DataSources []string `json:"data_sources"`
+}
+
+// NewOracleOperatorCertificateContent returns a new oracle operator certificate content.
+func NewOracleOperatorCertificateContent(name string, dataSources []string) OracleOperatorCertificateContent {
+	return OracleOperatorCertificateContent{Name: name, DataSources: dataSources}
+}
+
+// String returns string of the oracle operator certificate content.
+func (c OracleOperatorCertificateContent) String() string {
+	return fmt.Sprintf("Oracle operator certificate content:\n"+
+		"Name: %s\n"+
+		"Data Sources: %s",
+		c.Name, strings.Join(c.DataSources, ", "))
+}
+
+// AuditingCertificate defines type for the auditing certificate.
+type AuditingCertificate struct {
+	CertID           uint64                     `json:"certificate_id"`
+	CertType         CertificateType            `json:"certificate_type"`
+	ReqContent       RequestContent             `json:"request_content"`
+	CertContent      AuditingCertificateContent `json:"certificate_content"`
+	CertDescription  string                     `json:"description"`
+	CertCertifier    sdk.AccAddress             `json:"certifier"`
+	CertTxHash       string                     `json:"txhash"`
+	CertValidUntil   time.Time                  `json:"valid_until"`
+	CertExpired      bool                       `json:"expired"`
+	CertIssuedHeight int64                      `json:"issued_height"`
+}
+
+// NewAuditingCertificate returns a new auditing certificate.
+func NewAuditingCertificate(
+	reqContTypeStr, reqContStr string,
+	content AuditingCertificateContent,
+	description string,
+	certifier sdk.AccAddress,
+) (*AuditingCertificate, error) {
+	reqContent, err := NewRequestContent(reqContTypeStr, reqContStr)
+	if err != nil {
+		return nil, err
+	}
+	return &AuditingCertificate{
+		CertType:        CertificateTypeAuditing,
+		ReqContent:      reqContent,
+		CertContent:     content,
+		CertDescription: description,
+		CertCertifier:   certifier,
+	}, nil
+}
+
+// ID returns ID of the certificate.
+func (c *AuditingCertificate) ID() uint64 {
+	return c.CertID
+}
+
+// Type returns the certificate type.
+func (c *AuditingCertificate) Type() CertificateType {
+	return c.CertType
+}
+
+// Certifier returns certifier account address of the certificate.
+func (c *AuditingCertificate) Certifier() sdk.AccAddress {
+	return c.CertCertifier
+}
+
+// RequestContent returns request content of the certificate.
+func (c *AuditingCertificate) RequestContent() RequestContent {
+	return c.ReqContent
+}
+
+// CertificateContent returns certificate content of the certificate.
+func (c *AuditingCertificate) CertificateContent() string {
+	return c.CertContent.String()
+}
+
+// FormattedCertificateContent returns formatted certificate content of the certificate.
+func (c *AuditingCertificate) FormattedCertificateContent() []KVPair {
+	return []KVPair{
+		NewKVPair("reportHash", c.CertContent.ReportHash),
+		NewKVPair("scope", c.CertContent.Scope),
+		NewKVPair("critical", strconv.FormatUint(c.CertContent.SeverityCounts.Critical, 10)),
+		NewKVPair("major", strconv.FormatUint(c.CertContent.SeverityCounts.Major, 10)),
+		NewKVPair("medium", strconv.FormatUint(c.CertContent.SeverityCounts.Medium, 10)),
+		NewKVPair("minor", strconv.FormatUint(c.CertContent.SeverityCounts.Minor, 10)),
+		NewKVPair("informational", strconv.FormatUint(c.CertContent.SeverityCounts.Informational, 10)),
+		NewKVPair("auditorFirm", c.CertContent.AuditorFirm),
+	}
+}
+
+// Description returns description of the certificate.
+func (c *AuditingCertificate) Description() string {
+	return c.CertDescription
+}
+
+// TxHash returns the hash of the tx when the certificate is issued.
+func (c *AuditingCertificate) TxHash() string {
+	return c.CertTxHash
+}
+
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *AuditingCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *AuditingCertificate) Expired() bool {
+	return c.CertExpired
+}
+
+// Bytes returns a byte array for the certificate.
+func (c *AuditingCertificate) Bytes(cdc *codec.Codec) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(c)
+}
+
+// String returns a human readable string representation of the certificate.
+func (c *AuditingCertificate) String() string {
+	return fmt.Sprintf("Auditing certificate\n"+
+		"Certificate ID: %s\n"+
+		"Certificate type: auditing\n"+
+		"RequestContent:\n%s\n"+
+		"CertificateContent:\n%s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.ReqContent.RequestContent, c.CertificateContent(),
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
+}
+
+// SetCertificateID provides a method to set an ID for the certificate.
+func (c *AuditingCertificate) SetCertificateID(id uint64) {
+	c.CertID = id
+}
+
+// SetTxHash provides a method to set txhash of the certificate.
+func (c *AuditingCertificate) SetTxHash(txhash string) {
+	c.CertTxHash = txhash
+}
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *AuditingCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *AuditingCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *AuditingCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *AuditingCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// ProofCertificate defines type for the proof certificate.
+type ProofCertificate struct {
+	CertID           uint64                  `json:"certificate_id"`
+	CertType         CertificateType         `json:"certificate_type"`
+	ReqContent       RequestContent          `json:"request_content"`
+	CertContent      ProofCertificateContent `json:"certificate_content"`
+	CertDescription  string                  `json:"description"`
+	CertCertifier    sdk.AccAddress          `json:"certifier"`
+	CertTxHash       string                  `json:"txhash"`
+	CertValidUntil   time.Time               `json:"valid_until"`
+	CertExpired      bool                    `json:"expired"`
+	CertIssuedHeight int64                   `json:"issued_height"`
+}
+
+// NewProofCertificate returns a new proof certificate.
+func NewProofCertificate(
+	reqContTypeStr, reqContStr string,
+	content ProofCertificateContent,
+	description string,
+	certifier sdk.AccAddress,
+) (*ProofCertificate, error) {
+	reqContent, err := NewRequestContent(reqContTypeStr, reqContStr)
+	if err != nil {
+		return nil, err
+	}
+	return &ProofCertificate{
+		CertType:        CertificateTypeProof,
+		ReqContent:      reqContent,
+		CertContent:     content,
+		CertDescription: description,
+		CertCertifier:   certifier,
+	}, nil
+}
+
+// ID returns ID of the certificate.
+func (c *ProofCertificate) ID() uint64 {
+	return c.CertID
+}
+
+// Type returns the certificate type.
+func (c *ProofCertificate) Type() CertificateType {
+	return c.CertType
+}
+
+// Certifier returns certifier account address of the certificate.
+func (c *ProofCertificate) Certifier() sdk.AccAddress {
+	return c.CertCertifier
+}
+
+// RequestContent returns request content of the certificate.
+func (c *ProofCertificate) RequestContent() RequestContent {
+	return c.ReqContent
+}
+
+// CertificateContent returns certificate content of the certificate.
+func (c *ProofCertificate) CertificateContent() string {
+	return c.CertContent.String()
+}
+
+// FormattedCertificateContent returns formatted certificate content of the certificate.
+func (c *ProofCertificate) FormattedCertificateContent() []KVPair {
+	return []KVPair{
+		NewKVPair("specHash", c.CertContent.SpecHash),
+		NewKVPair("prover", c.CertContent.Prover),
+		NewKVPair("properties", strings.Join(c.CertContent.Properties, ",")),
+	}
+}
+
+// Description returns description of the certificate.
+func (c *ProofCertificate) Description() string {
+	return c.CertDescription
+}
+
+// TxHash returns the hash of the tx when the certificate is issued.
+func (c *ProofCertificate) TxHash() string {
+	return c.CertTxHash
+}
+
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *ProofCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *ProofCertificate) Expired() bool {
+	return c.CertExpired
+}
+
+// Bytes returns a byte array for the certificate.
+func (c *ProofCertificate) Bytes(cdc *codec.Codec) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(c)
+}
+
+// String returns a human readable string representation of the certificate.
+func (c *ProofCertificate) String() string {
+	return fmt.Sprintf("Proof certificate\n"+
+		"Certificate ID: %s\n"+
+		"Certificate type: proof\n"+
+		"RequestContent:\n%s\n"+
+		"CertificateContent:\n%s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.ReqContent.RequestContent, c.CertificateContent(),
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
+}
+
+// SetCertificateID provides a method to set an ID for the certificate.
+func (c *ProofCertificate) SetCertificateID(id uint64) {
+	c.CertID = id
+}
+
+// SetTxHash provides a method to set txhash of the certificate.
+func (c *ProofCertificate) SetTxHash(txhash string) {
+	c.CertTxHash = txhash
+}
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *ProofCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *ProofCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *ProofCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *ProofCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// OracleOperatorCertificate defines type for the oracle operator certificate.
+type OracleOperatorCertificate struct {
+	CertID           uint64                           `json:"certificate_id"`
+	CertType         CertificateType                  `json:"certificate_type"`
+	ReqContent       RequestContent                   `json:"request_content"`
+	CertContent      OracleOperatorCertificateContent `json:"certificate_content"`
+	CertDescription  string                           `json:"description"`
+	CertCertifier    sdk.AccAddress                   `json:"certifier"`
+	CertTxHash       string                           `json:"txhash"`
+	CertValidUntil   time.Time                        `json:"valid_until"`
+	CertExpired      bool                             `json:"expired"`
+	CertIssuedHeight int64                            `json:"issued_height"`
+}
+
+// NewOracleOperatorCertificate returns a new oracle operator certificate.
+func NewOracleOperatorCertificate(
+	operator sdk.AccAddress,
+	content OracleOperatorCertificateContent,
+	description string,
+	certifier sdk.AccAddress,
+) *OracleOperatorCertificate {
+	return &OracleOperatorCertificate{
+		CertType:        CertificateTypeOracleOperator,
+		ReqContent:      RequestContent{RequestContentType: RequestContentTypeAddress, RequestContent: operator.String()},
+		CertContent:     content,
+		CertDescription: description,
+		CertCertifier:   certifier,
+	}
+}
+
+// ID returns ID of the certificate.
+func (c *OracleOperatorCertificate) ID() uint64 {
+	return c.CertID
+}
+
+// Type returns the certificate type.
+func (c *OracleOperatorCertificate) Type() CertificateType {
+	return c.CertType
+}
+
+// Certifier returns certifier account address of the certificate.
+func (c *OracleOperatorCertificate) Certifier() sdk.AccAddress {
+	return c.CertCertifier
+}
+
+// RequestContent returns request content of the certificate.
+func (c *OracleOperatorCertificate) RequestContent() RequestContent {
+	return c.ReqContent
+}
+
+// CertificateContent returns certificate content of the certificate.
+func (c *OracleOperatorCertificate) CertificateContent() string {
+	return c.CertContent.String()
+}
+
+// FormattedCertificateContent returns formatted certificate content of the certificate.
+func (c *OracleOperatorCertificate) FormattedCertificateContent() []KVPair {
+	return []KVPair{
+		NewKVPair("name", c.CertContent.Name),
+		NewKVPair("dataSources", strings.Join(c.CertContent.DataSources, ",")),
+	}
+}
+
+// Description returns description of the certificate.
+func (c *OracleOperatorCertificate) Description() string {
+	return c.CertDescription
+}
+
+// TxHash returns the hash of the tx when the certificate is issued.
+func (c *OracleOperatorCertificate) TxHash() string {
+	return c.CertTxHash
+}
+
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *OracleOperatorCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *OracleOperatorCertificate) Expired() bool {
+	return c.CertExpired
+}
+
+// Bytes returns a byte array for the certificate.
+func (c *OracleOperatorCertificate) Bytes(cdc *codec.Codec) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(c)
+}
+
+// String returns a human readable string representation of the certificate.
+func (c *OracleOperatorCertificate) String() string {
+	return fmt.Sprintf("Oracle operator certificate\n"+
+		"Certificate ID: %s\n"+
+		"Certificate type: oracleoperator\n"+
+		"RequestContent:\n%s\n"+
+		"CertificateContent:\n%s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.ReqContent.RequestContent, c.CertificateContent(),
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
+}
+
+// SetCertificateID provides a method to set an ID for the certificate.
+func (c *OracleOperatorCertificate) SetCertificateID(id uint64) {
+	c.CertID = id
+}
+
+// SetTxHash provides a method to set txhash of the certificate.
+func (c *OracleOperatorCertificate) SetTxHash(txhash string) {
+	c.CertTxHash = txhash
+}
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *OracleOperatorCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *OracleOperatorCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *OracleOperatorCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *OracleOperatorCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// IdentityCertificate defines type for the identity certificate.
+type IdentityCertificate struct {
+	CertID           uint64                     `json:"certificate_id"`
+	CertType         CertificateType            `json:"certificate_type"`
+	ReqContent       RequestContent             `json:"request_content"`
+	CertContent      IdentityCertificateContent `json:"certificate_content"`
+	CertDescription  string                     `json:"description"`
+	CertCertifier    sdk.AccAddress             `json:"certifier"`
+	CertTxHash       string                     `json:"txhash"`
+	CertValidUntil   time.Time                  `json:"valid_until"`
+	CertExpired      bool                       `json:"expired"`
+	CertIssuedHeight int64                      `json:"issued_height"`
+}
+
+// NewIdentityCertificate returns a new identity certificate.
+func NewIdentityCertificate(
+	identity sdk.AccAddress,
+	content IdentityCertificateContent,
+	description string,
+	certifier sdk.AccAddress,
+) *IdentityCertificate {
+	return &IdentityCertificate{
+		CertType:        CertificateTypeIdentity,
+		ReqContent:      RequestContent{RequestContentType: RequestContentTypeAddress, RequestContent: identity.String()},
+		CertContent:     content,
+		CertDescription: description,
+		CertCertifier:   certifier,
+	}
+}
+
+// ID returns ID of the certificate.
+func (c *IdentityCertificate) ID() uint64 {
+	return c.CertID
+}
+
+// Type returns the certificate type.
+func (c *IdentityCertificate) Type() CertificateType {
+	return c.CertType
+}
+
+// Certifier returns certifier account address of the certificate.
+func (c *IdentityCertificate) Certifier() sdk.AccAddress {
+	return c.CertCertifier
+}
+
+// RequestContent returns request content of the certificate.
+func (c *IdentityCertificate) RequestContent() RequestContent {
+	return c.ReqContent
+}
+
+// CertificateContent returns certificate content of the certificate.
+func (c *IdentityCertificate) CertificateContent() string {
+	return c.CertContent.String()
+}
+
+// FormattedCertificateContent returns formatted certificate content of the certificate.
+func (c *IdentityCertificate) FormattedCertificateContent() []KVPair {
+	pairs := make([]KVPair, 0, len(c.CertContent.Claims))
+	for _, claim := range c.CertContent.Claims {
+		pairs = append(pairs, NewKVPair(claim.Attribute, claim.Hash))
+	}
+	return pairs
+}
+
+// Description returns description of the certificate.
+func (c *IdentityCertificate) Description() string {
+	return c.CertDescription
+}
+
+// TxHash returns the hash of the tx when the certificate is issued.
+func (c *IdentityCertificate) TxHash() string {
+	return c.CertTxHash
+}
+
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *IdentityCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *IdentityCertificate) Expired() bool {
+	return c.CertExpired
+}
+
+// Bytes returns a byte array for the certificate.
+func (c *IdentityCertificate) Bytes(cdc *codec.Codec) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(c)
+}
+
+// String returns a human readable string representation of the certificate.
+func (c *IdentityCertificate) String() string {
+	return fmt.Sprintf("Identity certificate\n"+
+		"Certificate ID: %s\n"+
+		"Certificate type: identity\n"+
+		"RequestContent:\n%s\n"+
+		"CertificateContent:\n%s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.ReqContent.RequestContent, c.CertificateContent(),
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
+}
+
+// SetCertificateID provides a method to set an ID for the certificate.
+func (c *IdentityCertificate) SetCertificateID(id uint64) {
+	c.CertID = id
+}
+
+// SetTxHash provides a method to set txhash of the certificate.
+func (c *IdentityCertificate) SetTxHash(txhash string) {
+	c.CertTxHash = txhash
+}
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *IdentityCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *IdentityCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *IdentityCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *IdentityCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// QuorumCertificate defines type for the certificate co-signed by multiple certifiers.
+// It becomes valid once the number of signers reaches the threshold before the deadline.
+type QuorumCertificate struct {
+	CertID           uint64           `json:"certificate_id"`
+	CertType         CertificateType  `json:"certificate_type"`
+	ReqContent       RequestContent   `json:"request_content"`
+	CertDescription  string           `json:"description"`
+	CertCertifier    sdk.AccAddress   `json:"certifier"`
+	CertTxHash       string           `json:"txhash"`
+	CertValidUntil   time.Time        `json:"valid_until"`
+	CertExpired      bool             `json:"expired"`
+	CertIssuedHeight int64            `json:"issued_height"`
+	Threshold        uint64           `json:"threshold"`
+	Signers          []sdk.AccAddress `json:"signers"`
+	Deadline         time.Time        `json:"deadline"`
+}
+
+// NewQuorumCertificate returns a new quorum certificate signed by its proposing certifier.
+func NewQuorumCertificate(
+	certTypeStr, reqContTypeStr, reqContStr, description string, certifier sdk.AccAddress, threshold uint64, deadline time.Time,
+) (*QuorumCertificate, error) {
+	certType := CertificateTypeFromString(certTypeStr)
+	if certType == CertificateTypeNil {
+		return nil, ErrInvalidCertificateType
+	}
+	reqContent, err := NewRequestContent(reqContTypeStr, reqContStr)
+	if err != nil {
+		return nil, err
+	}
+	return &QuorumCertificate{
+		CertType:        certType,
+		ReqContent:      reqContent,
+		CertDescription: description,
+		CertCertifier:   certifier,
+		Threshold:       threshold,
+		Signers:         []sdk.AccAddress{certifier},
+		Deadline:        deadline,
+	}, nil
+}
+
+// ID returns ID of the certificate.
+func (c *QuorumCertificate) ID() uint64 {
+	return c.CertID
+}
+
+// Type returns the certificate type.
+func (c *QuorumCertificate) Type() CertificateType {
+	return c.CertType
+}
+
+// Certifier returns account address of the certifier who proposed the certificate.
+func (c *QuorumCertificate) Certifier() sdk.AccAddress {
+	return c.CertCertifier
+}
+
+// RequestContent returns request content of the certificate.
+func (c *QuorumCertificate) RequestContent() RequestContent {
+	return c.ReqContent
+}
+
+// CertificateContent returns certificate content of the certificate.
+func (c *QuorumCertificate) CertificateContent() string {
+	signers := make([]string, len(c.Signers))
+	for i, signer := range c.Signers {
+		signers[i] = signer.String()
+	}
+	return fmt.Sprintf("Quorum certificate content:\n"+
+		"Threshold: %d\n"+
+		"Signers: %s\n"+
+		"Deadline: %s",
+		c.Threshold, strings.Join(signers, ", "), c.Deadline)
+}
+
+// FormattedCertificateContent returns formatted certificate content of the certificate.
+func (c *QuorumCertificate) FormattedCertificateContent() []KVPair {
+	signers := make([]string, len(c.Signers))
+	for i, signer := range c.Signers {
+		signers[i] = signer.String()
+	}
+	return []KVPair{
+		NewKVPair("threshold", strconv.FormatUint(c.Threshold, 10)),
+		NewKVPair("signers", strings.Join(signers, ",")),
+		NewKVPair("deadline", c.Deadline.String()),
+		NewKVPair("quorumReached", strconv.FormatBool(c.QuorumReached())),
+	}
+}
+
+// Description returns description of the certificate.
+func (c *QuorumCertificate) Description() string {
+	return c.CertDescription
+}
+
+// TxHash returns the hash of the tx when the certificate is proposed.
+func (c *QuorumCertificate) TxHash() string {
+	return c.CertTxHash
+}
+
+// ValidUntil returns the time until which the certificate is valid.
+// A zero time means that the certificate does not expire.
+func (c *QuorumCertificate) ValidUntil() time.Time {
+	return c.CertValidUntil
+}
+
+// Expired returns whether the certificate has expired.
+func (c *QuorumCertificate) Expired() bool {
+	return c.CertExpired
+}
+
+// HasSigned returns whether the given certifier has signed the certificate.
+func (c *QuorumCertificate) HasSigned(certifier sdk.AccAddress) bool {
+	for _, signer := range c.Signers {
+		if signer.Equals(certifier) {
+			return true
+		}
+	}
+	return false
+}
+
+// QuorumReached returns whether the number of signers has reached the threshold.
+func (c *QuorumCertificate) QuorumReached() bool {
+	return uint64(len(c.Signers)) >= c.Threshold
+}
+
+// Bytes returns a byte array for the certificate.
+func (c *QuorumCertificate) Bytes(cdc *codec.Codec) []byte {
+	return cdc.MustMarshalBinaryLengthPrefixed(c)
+}
+
+// String returns a human readable string representation of the certificate.
+func (c *QuorumCertificate) String() string {
+	return fmt.Sprintf("Quorum certificate\n"+
+		"Certificate ID: %s\n"+
+		"Certificate type: %s\n"+
+		"RequestContent:\n%s\n"+
+		"CertificateContent:\n%s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"TxHash: %s\n"+
+		"Valid until: %s\n"+
+		"Expired: %t\n",
+		strconv.FormatUint(c.CertID, 10), c.CertType.String(), c.ReqContent.RequestContent, c.CertificateContent(),
+		c.Description(), c.CertCertifier.String(), c.CertTxHash, formatValidUntil(c.CertValidUntil), c.CertExpired)
+}
+
+// SetCertificateID provides a method to set an ID for the certificate.
+func (c *QuorumCertificate) SetCertificateID(id uint64) {
+	c.CertID = id
+}
+
+// SetTxHash provides a method to set txhash of the certificate.
+func (c *QuorumCertificate) SetTxHash(txhash string) {
+	c.CertTxHash = txhash
+}
+
+// SetValidUntil provides a method to set the time until which the certificate is valid.
+func (c *QuorumCertificate) SetValidUntil(validUntil time.Time) {
+	c.CertValidUntil = validUntil
+}
+
+// SetExpired provides a method to set whether the certificate has expired.
+func (c *QuorumCertificate) SetExpired(expired bool) {
+	c.CertExpired = expired
+}
+
+// IssuedHeight returns the block height at which the certificate was issued.
+func (c *QuorumCertificate) IssuedHeight() int64 {
+	return c.CertIssuedHeight
+}
+
+// SetIssuedHeight provides a method to set the block height at which the certificate was issued.
+func (c *QuorumCertificate) SetIssuedHeight(height int64) {
+	c.CertIssuedHeight = height
+}
+
+// AddSigner adds a certifier to the signers of the certificate.
+func (c *QuorumCertificate) AddSigner(certifier sdk.AccAddress) {
+	c.Signers = append(c.Signers, certifier)
+}
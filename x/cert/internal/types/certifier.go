@@ -9,10 +9,12 @@ import (
 
 // Certifier is a type for certifier.
 type Certifier struct {
-	Address     sdk.AccAddress `json:"certifier"`
-	Alias       string         `json:"alias"`
-	Proposer    sdk.AccAddress `json:"proposer"`
-	Description string         `json:"description"`
+	Address             sdk.AccAddress `json:"certifier"`
+	Alias               string         `json:"alias"`
+	Proposer            sdk.AccAddress `json:"proposer"`
+	Description         string         `json:"description"`
+	Bond                sdk.Coins      `json:"bond"`
+	RevokedCertificates uint64         `json:"revoked_certificates"`
 }
 
 // NewCertifier returns a new certifier.
@@ -35,8 +37,10 @@ func (c Certifier) String() string {
 	return fmt.Sprintf(`Certifier
   Address: %s
   Proposer: %s
-  Description: %s`,
-		c.Address, c.Proposer, c.Description)
+  Description: %s
+  Bond: %s
+  Revoked Certificates: %d`,
+		c.Address, c.Proposer, c.Description, c.Bond, c.RevokedCertificates)
 }
 
 // Certifiers is a collection of certifier objects.
@@ -48,3 +52,33 @@ func (c Certifiers) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// CertifierReputation summarizes the certificates issued and revoked for a certifier.
+type CertifierReputation struct {
+	Certifier sdk.AccAddress `json:"certifier"`
+	Issued    uint64         `json:"issued"`
+	Revoked   uint64         `json:"revoked"`
+	Score     int64          `json:"score"`
+}
+
+// NewCertifierReputation returns the reputation of a certifier with the
+// given number of issued and revoked certificates. Each revoked certificate
+// is deducted from the score in addition to no longer counting as issued.
+func NewCertifierReputation(certifier sdk.AccAddress, active, revoked uint64) CertifierReputation {
+	return CertifierReputation{
+		Certifier: certifier,
+		Issued:    active + revoked,
+		Revoked:   revoked,
+		Score:     int64(active) - int64(revoked),
+	}
+}
+
+// String returns a human readable string representation of a certifier reputation.
+func (r CertifierReputation) String() string {
+	return fmt.Sprintf(`Certifier Reputation
+  Certifier: %s
+  Issued: %d
+  Revoked: %d
+  Score: %d`,
+		r.Certifier, r.Issued, r.Revoked, r.Score)
+}
@@ -22,9 +22,25 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCertifyPlatform{}, "cert/CertifyPlatform", nil)
 	cdc.RegisterConcrete(MsgCertifyGeneral{}, "cert/CertifyGeneral", nil)
 	cdc.RegisterConcrete(MsgCertifyCompilation{}, "cert/CertifyCompilation", nil)
+	cdc.RegisterConcrete(MsgCertifyAuditing{}, "cert/CertifyAuditing", nil)
+	cdc.RegisterConcrete(MsgCertifyProof{}, "cert/CertifyProof", nil)
+	cdc.RegisterConcrete(MsgCertifyOracleOperator{}, "cert/CertifyOracleOperator", nil)
+	cdc.RegisterConcrete(MsgCertifyIdentity{}, "cert/CertifyIdentity", nil)
 	cdc.RegisterConcrete(CertifierUpdateProposal{}, "cert/CertifierUpdateProposal", nil)
+	cdc.RegisterConcrete(CertifierSlashProposal{}, "cert/CertifierSlashProposal", nil)
 	cdc.RegisterConcrete(MsgRevokeCertificate{}, "cert/RevokeCertificate", nil)
+	cdc.RegisterConcrete(MsgRenewCertificate{}, "cert/RenewCertificate", nil)
+	cdc.RegisterConcrete(MsgPublishLibrary{}, "cert/PublishLibrary", nil)
+	cdc.RegisterConcrete(MsgInvalidateLibrary{}, "cert/InvalidateLibrary", nil)
+	cdc.RegisterConcrete(MsgProposeQuorumCertificate{}, "cert/ProposeQuorumCertificate", nil)
+	cdc.RegisterConcrete(MsgSignQuorumCertificate{}, "cert/SignQuorumCertificate", nil)
+	cdc.RegisterConcrete(MsgDepositCertifierBond{}, "cert/DepositCertifierBond", nil)
 	cdc.RegisterInterface((*Certificate)(nil), nil)
 	cdc.RegisterConcrete(&GeneralCertificate{}, "cert/GeneralCertificate", nil)
 	cdc.RegisterConcrete(&CompilationCertificate{}, "cert/CompilationCertificate", nil)
+	cdc.RegisterConcrete(&AuditingCertificate{}, "cert/AuditingCertificate", nil)
+	cdc.RegisterConcrete(&ProofCertificate{}, "cert/ProofCertificate", nil)
+	cdc.RegisterConcrete(&OracleOperatorCertificate{}, "cert/OracleOperatorCertificate", nil)
+	cdc.RegisterConcrete(&QuorumCertificate{}, "cert/QuorumCertificate", nil)
+	cdc.RegisterConcrete(&IdentityCertificate{}, "cert/IdentityCertificate", nil)
 }
@@ -15,6 +15,10 @@ var (
 	ErrAddOrRemove            = sdkerrors.Register(ModuleName, 107, "must be `add` or `remove`")
 	ErrInvalidCertifierAlias  = sdkerrors.Register(ModuleName, 108, "invalid certifier alias`")
 	ErrOnlyOneCertifier       = sdkerrors.Register(ModuleName, 109, "cannot remove only certifier")
+	ErrInvalidCertifierParams = sdkerrors.Register(ModuleName, 110, "invalid certifier params")
+	ErrInvalidSlashAmount     = sdkerrors.Register(ModuleName, 111, "slash amount must be positive and not exceed the certifier bond")
+	ErrInvalidClaimProposal   = sdkerrors.Register(ModuleName, 112, "linked proposal is not a passed shield claim proposal")
+	ErrInsufficientBond       = sdkerrors.Register(ModuleName, 113, "certifier bond is below the minimum bond")
 )
 
 // [2xx] Validator
@@ -24,6 +28,9 @@ var (
 	ErrValidatorUncertified = sdkerrors.Register(ModuleName, 203, "validator has not been certified")
 	ErrTombstonedValidator  = sdkerrors.Register(ModuleName, 204, "validator has already been tombstoned")
 	ErrMissingValidator     = sdkerrors.Register(ModuleName, 205, "validator missing from staking store")
+	ErrInvalidHardwareType  = sdkerrors.Register(ModuleName, 206, "invalid platform hardware type")
+	ErrInvalidQuoteHash     = sdkerrors.Register(ModuleName, 207, "invalid remote attestation quote hash")
+	ErrPlatformValidUntil   = sdkerrors.Register(ModuleName, 208, "platform attestation must be valid after the current block time")
 )
 
 // [3xx] Certificate
@@ -37,6 +44,23 @@ var (
 	ErrInvalidRequestContentType = sdkerrors.Register(ModuleName, 307, "invalid request content type")
 	ErrUnqualifiedRevoker        = sdkerrors.Register(ModuleName, 308, "only certifiers can revoke this certificate")
 	ErrDuplicateCertificate      = sdkerrors.Register(ModuleName, 309, "certificate of the same type and content already exists")
+	ErrInvalidValidUntil         = sdkerrors.Register(ModuleName, 310, "certificate validity must end after the current block time")
+	ErrReportHash                = sdkerrors.Register(ModuleName, 311, "invalid audit report hash")
+	ErrSpecHash                  = sdkerrors.Register(ModuleName, 312, "invalid specification hash")
+	ErrProver                    = sdkerrors.Register(ModuleName, 313, "invalid prover")
+	ErrOracleOperatorName        = sdkerrors.Register(ModuleName, 314, "invalid oracle operator name")
+	ErrInvalidThreshold          = sdkerrors.Register(ModuleName, 315, "quorum threshold must be between 1 and the number of certifiers")
+	ErrInvalidQuorumWindow       = sdkerrors.Register(ModuleName, 316, "quorum signing window must be positive")
+	ErrNotQuorumCertificate      = sdkerrors.Register(ModuleName, 317, "certificate is not a quorum certificate")
+	ErrQuorumWindowClosed        = sdkerrors.Register(ModuleName, 318, "quorum signing window has closed")
+	ErrAlreadySigned             = sdkerrors.Register(ModuleName, 319, "certifier has already signed the certificate")
+	ErrInvalidHeightRange        = sdkerrors.Register(ModuleName, 320, "invalid certificate issuance height range")
+	ErrInvalidCertificateBundle  = sdkerrors.Register(ModuleName, 321, "invalid certificate bundle")
+	ErrInvalidRevocationReason   = sdkerrors.Register(ModuleName, 322, "invalid revocation reason")
+	ErrRevocationNotExists       = sdkerrors.Register(ModuleName, 323, "certificate has not been revoked")
+	ErrInvalidIdentityClaim      = sdkerrors.Register(ModuleName, 324, "invalid identity claim")
+	ErrIdentityNotCertified      = sdkerrors.Register(ModuleName, 325, "address is not a certified identity")
+	ErrIdentityDisclosure        = sdkerrors.Register(ModuleName, 326, "identity disclosure does not match the certificate")
 )
 
 // [4xx] Library
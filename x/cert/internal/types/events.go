@@ -1,7 +1,20 @@
 package types
 
 const (
-	EventTypeCertifyCompilation = "certify_compilation"
-	EventTypeCertify            = "certify"
-	EventTypeRevokeCertificate  = "revoke_certificate"
+	EventTypeCertifyCompilation    = "certify_compilation"
+	EventTypeCertify               = "certify"
+	EventTypeCertifyAuditing       = "certify_auditing"
+	EventTypeCertifyProof          = "certify_proof"
+	EventTypeCertifyOracleOperator = "certify_oracle_operator"
+	EventTypeCertifyIdentity       = "certify_identity"
+	EventTypeRevokeCertificate     = "revoke_certificate"
+	EventTypeRenewCertificate      = "renew_certificate"
+	EventTypeExpireCertificate     = "expire_certificate"
+	EventTypePublishLibrary        = "publish_library"
+	EventTypeInvalidateLibrary     = "invalidate_library"
+	EventTypeProposeQuorumCert     = "propose_quorum_certificate"
+	EventTypeSignQuorumCert        = "sign_quorum_certificate"
+	EventTypeQuorumCertFailed      = "quorum_certificate_failed"
+	EventTypeSlashCertifier        = "slash_certifier"
+	EventTypeDepositCertifierBond  = "deposit_certifier_bond"
 )
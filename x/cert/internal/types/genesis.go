@@ -14,16 +14,21 @@ import (
 type Platform struct {
 	Validator   crypto.PubKey
 	Description string
+	Certifier   sdk.AccAddress
+	Attestation PlatformAttestation
+	Height      int64
 }
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	Certifiers        []Certifier   `json:"certifiers"`
-	Validators        []Validator   `json:"validators"`
-	Platforms         []Platform    `json:"platforms"`
-	Certificates      []Certificate `json:"certificates"`
-	Libraries         []Library     `json:"libraries"`
-	NextCertificateID uint64        `json:"next_certificate_id" yaml:"next_certificate_id"`
+	Certifiers        []Certifier     `json:"certifiers"`
+	Validators        []Validator     `json:"validators"`
+	Platforms         []Platform      `json:"platforms"`
+	Certificates      []Certificate   `json:"certificates"`
+	Libraries         []Library       `json:"libraries"`
+	NextCertificateID uint64          `json:"next_certificate_id" yaml:"next_certificate_id"`
+	CertifierParams   CertifierParams `json:"certifier_params" yaml:"certifier_params"`
+	Revocations       []Revocation    `json:"revocations"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -35,6 +40,7 @@ func NewGenesisState(constantFee sdk.Coin, startingCertificateID uint64) Genesis
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		NextCertificateID: uint64(1),
+		CertifierParams:   DefaultCertifierParams(),
 	}
 }
 
@@ -48,6 +54,19 @@ func ValidateGenesis(bz json.RawMessage) error {
 	if data.NextCertificateID < 1 {
 		return fmt.Errorf("failed to validate %s genesis state: NextCertificateID must be positive ", ModuleName)
 	}
+	if err := validateCertifierParams(data.CertifierParams); err != nil {
+		return fmt.Errorf("failed to validate %s genesis state: %w", ModuleName, err)
+	}
+	for _, platform := range data.Platforms {
+		if platform.Validator == nil || platform.Attestation.ValidateBasic() != nil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid platform", ModuleName)
+		}
+	}
+	for _, revocation := range data.Revocations {
+		if revocation.Certificate == nil || RevocationReasonFromString(revocation.Reason.String()) == RevocationReasonNil {
+			return fmt.Errorf("failed to validate %s genesis state: invalid revocation", ModuleName)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Identity claim attributes
+const (
+	IdentityAttributeJurisdiction = "jurisdiction"
+	IdentityAttributeEntityType   = "entity_type"
+)
+
+// IdentityClaimHash returns the hex-encoded SHA-256 hash committing to the value
+// of an identity attribute. The salt keeps values with few possibilities, such as
+// a jurisdiction, from being recovered by hashing every candidate.
+func IdentityClaimHash(attribute, value, salt string) string {
+	hash := sha256.Sum256([]byte(attribute + "\x00" + value + "\x00" + salt))
+	return hex.EncodeToString(hash[:])
+}
+
+// IdentityClaim is a hashed attribute of a certified identity.
+type IdentityClaim struct {
+	Attribute string `json:"attribute"`
+	Hash      string `json:"hash"`
+}
+
+// NewIdentityClaim returns a new identity claim.
+func NewIdentityClaim(attribute, hash string) IdentityClaim {
+	return IdentityClaim{Attribute: attribute, Hash: strings.ToLower(hash)}
+}
+
+// ValidateBasic runs stateless checks on the claim.
+func (c IdentityClaim) ValidateBasic() error {
+	if !isIdentityAttribute(c.Attribute) {
+		return sdkerrors.Wrapf(ErrInvalidIdentityClaim, "invalid attribute %q", c.Attribute)
+	}
+	if bz, err := hex.DecodeString(c.Hash); err != nil || len(bz) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidIdentityClaim, "invalid hash of attribute %s", c.Attribute)
+	}
+	return nil
+}
+
+// isIdentityAttribute returns whether a string is a valid attribute name,
+// which consists of lowercase letters, digits and underscores.
+func isIdentityAttribute(attribute string) bool {
+	if attribute == "" {
+		return false
+	}
+	for _, r := range attribute {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// IdentityDisclosure reveals the value of an identity attribute together with
+// the salt of its claim hash.
+type IdentityDisclosure struct {
+	Attribute string `json:"attribute"`
+	Value     string `json:"value"`
+	Salt      string `json:"salt"`
+}
+
+// NewIdentityDisclosure returns a new identity disclosure.
+func NewIdentityDisclosure(attribute, value, salt string) IdentityDisclosure {
+	return IdentityDisclosure{Attribute: attribute, Value: value, Salt: salt}
+}
+
+// IdentityDisclosureFromString parses an <attribute>=<value>:<salt> disclosure, where
+// the salt follows the last colon so that values may contain colons.
+func IdentityDisclosureFromString(s string) (IdentityDisclosure, error) {
+	pair := strings.SplitN(s, "=", 2)
+	if len(pair) != 2 || !strings.Contains(pair[1], ":") {
+		return IdentityDisclosure{}, sdkerrors.Wrapf(ErrIdentityDisclosure, "expecting <attribute>=<value>:<salt>, got %q", s)
+	}
+	i := strings.LastIndex(pair[1], ":")
+	return NewIdentityDisclosure(pair[0], pair[1][:i], pair[1][i+1:]), nil
+}
+
+// IdentityCertificateContent defines type for the identity certificate content.
+type IdentityCertificateContent struct {
+	Claims []IdentityClaim `json:"claims"`
+}
+
+// NewIdentityCertificateContent returns a new identity certificate content.
+func NewIdentityCertificateContent(claims []IdentityClaim) IdentityCertificateContent {
+	return IdentityCertificateContent{Claims: claims}
+}
+
+// ValidateBasic runs stateless checks on the claims of the content.
+func (c IdentityCertificateContent) ValidateBasic() error {
+	attributes := make(map[string]bool)
+	for _, claim := range c.Claims {
+		if err := claim.ValidateBasic(); err != nil {
+			return err
+		}
+		if attributes[claim.Attribute] {
+			return sdkerrors.Wrapf(ErrInvalidIdentityClaim, "duplicate attribute %s", claim.Attribute)
+		}
+		attributes[claim.Attribute] = true
+	}
+	return nil
+}
+
+// Claim returns the claim of an attribute.
+func (c IdentityCertificateContent) Claim(attribute string) (IdentityClaim, bool) {
+	for _, claim := range c.Claims {
+		if claim.Attribute == attribute {
+			return claim, true
+		}
+	}
+	return IdentityClaim{}, false
+}
+
+// Verify checks that the disclosed attribute values are the preimages of the claim hashes.
+func (c IdentityCertificateContent) Verify(disclosures []IdentityDisclosure) error {
+	for _, disclosure := range disclosures {
+		claim, found := c.Claim(disclosure.Attribute)
+		if !found {
+			return sdkerrors.Wrapf(ErrIdentityDisclosure, "no claim of attribute %s", disclosure.Attribute)
+		}
+		if IdentityClaimHash(disclosure.Attribute, disclosure.Value, disclosure.Salt) != claim.Hash {
+			return sdkerrors.Wrapf(ErrIdentityDisclosure, "value of attribute %s does not match its claim", disclosure.Attribute)
+		}
+	}
+	return nil
+}
+
+// String returns string of the identity certificate content.
+func (c IdentityCertificateContent) String() string {
+	claims := make([]string, 0, len(c.Claims))
+	for _, claim := range c.Claims {
+		claims = append(claims, fmt.Sprintf("%s: %s", claim.Attribute, claim.Hash))
+	}
+	return fmt.Sprintf("Identity certificate content:\n"+
+		"Claims: %s",
+		strings.Join(claims, ", "))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -38,8 +39,24 @@ var (
 
 	nextCertificateIDKeyPrefix = []byte{0x8}
 	
+	// CertifierCertIDsStoreKeyPrefix is the prefix of the certificate certifier index kv-store keys.
 	CertifierCertIDsStoreKeyPrefix = []byte{0x9}
 	ContentCertIDStoreKeyPrefix    = []byte{0xA}
+
+	// CertificateExpirationQueueKeyPrefix is the prefix of the certificate expiration queue kv-store keys.
+	CertificateExpirationQueueKeyPrefix = []byte{0xB}
+
+	// QuorumDeadlineQueueKeyPrefix is the prefix of the quorum certificate signing deadline queue kv-store keys.
+	QuorumDeadlineQueueKeyPrefix = []byte{0xC}
+
+	// CertificateTypeIndexKeyPrefix is the prefix of the certificate type index kv-store keys.
+	CertificateTypeIndexKeyPrefix = []byte{0xD}
+
+	// IssuedHeightIndexKeyPrefix is the prefix of the certificate issuance height index kv-store keys.
+	IssuedHeightIndexKeyPrefix = []byte{0xF}
+
+	// RevocationStoreKeyPrefix is the prefix of the certificate revocation registry kv-store keys.
+	RevocationStoreKeyPrefix = []byte{0x10}
 )
 
 // CertifierStoreKey returns the kv-store key for the certifier registration.
@@ -79,15 +96,75 @@ func CertificateStoreKey(id uint64) []byte {
 	return concat(certificateStoreKeyPrefix, bz)
 }
 
-func CertifierCertIDsKey(certifier sdk.AccAddress) []byte {
-	return concat(CertifierCertIDsStoreKeyPrefix, certifier)
-}
-
 func ContentCertIDKey(certType CertificateType, reqContentType RequestContentType, reqContent string) []byte {
 	contentHash := sha256.Sum224(concat(reqContentType.Bytes(), []byte(reqContent)))
 	return concat(ContentCertIDStoreKeyPrefix, certType.Bytes(), contentHash[:])
 }
 
+// CertificateExpirationQueueKey returns the kv-store key for the certificates expiring at the given time.
+func CertificateExpirationQueueKey(validUntil time.Time) []byte {
+	return concat(CertificateExpirationQueueKeyPrefix, sdk.FormatTimeBytes(validUntil))
+}
+
+// QuorumDeadlineQueueKey returns the kv-store key for the quorum certificates whose signing window closes at the given time.
+func QuorumDeadlineQueueKey(deadline time.Time) []byte {
+	return concat(QuorumDeadlineQueueKeyPrefix, sdk.FormatTimeBytes(deadline))
+}
+
+// CertificateTypeIndexPrefix returns the kv-store key prefix for the index of certificates of the given type.
+func CertificateTypeIndexPrefix(certType CertificateType) []byte {
+	return concat(CertificateTypeIndexKeyPrefix, certType.Bytes())
+}
+
+// CertificateTypeIndexKey returns the kv-store key for the certificate type index entry of a certificate.
+func CertificateTypeIndexKey(certType CertificateType, id uint64) []byte {
+	return concat(CertificateTypeIndexPrefix(certType), indexIDBytes(id))
+}
+
+// CertifierIndexPrefix returns the kv-store key prefix for the index of certificates issued by the given certifier.
+func CertifierIndexPrefix(certifier sdk.AccAddress) []byte {
+	return concat(CertifierCertIDsStoreKeyPrefix, certifier.Bytes())
+}
+
+// CertifierIndexKey returns the kv-store key for the certifier index entry of a certificate.
+func CertifierIndexKey(certifier sdk.AccAddress, id uint64) []byte {
+	return concat(CertifierIndexPrefix(certifier), indexIDBytes(id))
+}
+
+// IssuedHeightIndexPrefix returns the kv-store key prefix for the index of certificates issued at the given height.
+func IssuedHeightIndexPrefix(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return concat(IssuedHeightIndexKeyPrefix, bz)
+}
+
+// IssuedHeightIndexKey returns the kv-store key for the issuance height index entry of a certificate.
+func IssuedHeightIndexKey(height int64, id uint64) []byte {
+	return concat(IssuedHeightIndexPrefix(height), indexIDBytes(id))
+}
+
+// CertificateIDFromIndexKey returns the certificate ID of a certificate index entry key.
+func CertificateIDFromIndexKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// indexIDBytes encodes a certificate ID in big endian, so that index entries are ordered by ID.
+func indexIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// RevocationStoreKey returns the kv-store key for the revocation of a given certificate (ID).
+func RevocationStoreKey(id uint64) []byte {
+	return concat(RevocationStoreKeyPrefix, indexIDBytes(id))
+}
+
+// RevocationsStoreKey returns the kv-store key for accessing all revocations.
+func RevocationsStoreKey() []byte {
+	return RevocationStoreKeyPrefix
+}
+
 // NextCertificateIDKey gets the key for the next certificate ID.
 func NextCertificateIDKey() []byte {
 	return nextCertificateIDKeyPrefix
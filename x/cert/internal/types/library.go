@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Library is a type for certified libraries.
 type Library struct {
@@ -8,5 +12,10 @@ type Library struct {
 	Publisher sdk.AccAddress
 }
 
+// String implements fmt.Stringer.
+func (l Library) String() string {
+	return fmt.Sprintf("Address: %s\nPublisher: %s", l.Address, l.Publisher)
+}
+
 // Libraries is a collection of Library objects.
 type Libraries []Library
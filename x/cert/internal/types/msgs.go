@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -260,11 +261,12 @@ type MsgCertifyGeneral struct {
 	RequestContent     string         `json:"request_content" yaml:"request_content"`
 	Description        string         `json:"description" yaml:"description"`
 	Certifier          sdk.AccAddress `json:"certifier" yaml:"certiifer"`
+	ValidUntil         time.Time      `json:"valid_until" yaml:"valid_until"`
 }
 
 // NewMsgCertifyGeneral returns a new general certification message.
 func NewMsgCertifyGeneral(
-	certificateType, requestContentType, requestContent, description string, certifier sdk.AccAddress,
+	certificateType, requestContentType, requestContent, description string, certifier sdk.AccAddress, validUntil time.Time,
 ) MsgCertifyGeneral {
 	return MsgCertifyGeneral{
 		CertificateType:    certificateType,
@@ -272,6 +274,7 @@ func NewMsgCertifyGeneral(
 		RequestContent:     requestContent,
 		Description:        description,
 		Certifier:          certifier,
+		ValidUntil:         validUntil,
 	}
 }
 
@@ -310,14 +313,16 @@ func (m MsgCertifyGeneral) GetSigners() []sdk.AccAddress {
 type MsgRevokeCertificate struct {
 	Revoker     sdk.AccAddress `json:"revoker" yaml:"revoker"`
 	ID          uint64         `json:"id" yaml:"id"`
+	Reason      string         `json:"reason" yaml:"reason"`
 	Description string         `json:"description" yaml:"description"`
 }
 
 // NewMsgRevokeCertificate creates a new instance of MsgRevokeCertificate.
-func NewMsgRevokeCertificate(revoker sdk.AccAddress, id uint64, description string) MsgRevokeCertificate {
+func NewMsgRevokeCertificate(revoker sdk.AccAddress, id uint64, reason, description string) MsgRevokeCertificate {
 	return MsgRevokeCertificate{
 		Revoker:     revoker,
 		ID:          id,
+		Reason:      reason,
 		Description: description,
 	}
 }
@@ -327,6 +332,9 @@ func (m MsgRevokeCertificate) ValidateBasic() error {
 	if m.Revoker.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Revoker.String())
 	}
+	if RevocationReasonFromString(m.Reason) == RevocationReasonNil {
+		return sdkerrors.Wrap(ErrInvalidRevocationReason, m.Reason)
+	}
 	return nil
 }
 
@@ -350,6 +358,50 @@ func (m MsgRevokeCertificate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Revoker}
 }
 
+// MsgRenewCertificate is the message for renewing a certificate.
+type MsgRenewCertificate struct {
+	Renewer    sdk.AccAddress `json:"renewer" yaml:"renewer"`
+	ID         uint64         `json:"id" yaml:"id"`
+	ValidUntil time.Time      `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgRenewCertificate creates a new instance of MsgRenewCertificate.
+func NewMsgRenewCertificate(renewer sdk.AccAddress, id uint64, validUntil time.Time) MsgRenewCertificate {
+	return MsgRenewCertificate{
+		Renewer:    renewer,
+		ID:         id,
+		ValidUntil: validUntil,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgRenewCertificate) ValidateBasic() error {
+	if m.Renewer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Renewer.String())
+	}
+	return nil
+}
+
+// Route returns the module name.
+func (m MsgRenewCertificate) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgRenewCertificate) Type() string { return "renew_certificate" }
+
+// GetSignBytes encodes the message for signing.
+func (m MsgRenewCertificate) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgRenewCertificate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Renewer}
+}
+
 // MsgCertifyCompilation is the message for certifying a compilation.
 type MsgCertifyCompilation struct {
 	SourceCodeHash string         `json:"sourcecodehash" yaml:"sourcecodehash"`
@@ -357,16 +409,20 @@ type MsgCertifyCompilation struct {
 	BytecodeHash   string         `json:"bytecodehash" yaml:"bytecodehash"`
 	Description    string         `json:"description" yaml:"description"`
 	Certifier      sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	ValidUntil     time.Time      `json:"valid_until" yaml:"valid_until"`
 }
 
 // NewMsgCertifyCompilation returns a compilation certificate message.
-func NewMsgCertifyCompilation(sourceCodeHash, compiler, bytecodeHash, description string, certifier sdk.AccAddress) MsgCertifyCompilation {
+func NewMsgCertifyCompilation(
+	sourceCodeHash, compiler, bytecodeHash, description string, certifier sdk.AccAddress, validUntil time.Time,
+) MsgCertifyCompilation {
 	return MsgCertifyCompilation{
 		SourceCodeHash: sourceCodeHash,
 		Compiler:       compiler,
 		BytecodeHash:   bytecodeHash,
 		Description:    description,
 		Certifier:      certifier,
+		ValidUntil:     validUntil,
 	}
 }
 
@@ -404,26 +460,484 @@ func (m MsgCertifyCompilation) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Certifier}
 }
 
+// MsgCertifyAuditing is the message for issuing an auditing certificate.
+type MsgCertifyAuditing struct {
+	RequestContentType string         `json:"request_content_type" yaml:"request_content_type"`
+	RequestContent     string         `json:"request_content" yaml:"request_content"`
+	ReportHash         string         `json:"report_hash" yaml:"report_hash"`
+	Scope              string         `json:"scope" yaml:"scope"`
+	SeverityCounts     SeverityCounts `json:"severity_counts" yaml:"severity_counts"`
+	AuditorFirm        string         `json:"auditor_firm" yaml:"auditor_firm"`
+	Description        string         `json:"description" yaml:"description"`
+	Certifier          sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	ValidUntil         time.Time      `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgCertifyAuditing returns a new auditing certification message.
+func NewMsgCertifyAuditing(
+	requestContentType, requestContent, reportHash, scope string, severityCounts SeverityCounts, auditorFirm,
+	description string, certifier sdk.AccAddress, validUntil time.Time,
+) MsgCertifyAuditing {
+	return MsgCertifyAuditing{
+		RequestContentType: requestContentType,
+		RequestContent:     requestContent,
+		ReportHash:         reportHash,
+		Scope:              scope,
+		SeverityCounts:     severityCounts,
+		AuditorFirm:        auditorFirm,
+		Description:        description,
+		Certifier:          certifier,
+		ValidUntil:         validUntil,
+	}
+}
+
+// Route returns the module name.
+func (m MsgCertifyAuditing) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgCertifyAuditing) Type() string { return "certify_auditing" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCertifyAuditing) ValidateBasic() error {
+	if requestContentType := RequestContentTypeFromString(m.RequestContentType); requestContentType == RequestContentTypeNil {
+		return ErrInvalidRequestContentType
+	}
+	if m.ReportHash == "" {
+		return sdkerrors.Wrap(ErrReportHash, "<empty>")
+	}
+	if m.Certifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCertifyAuditing) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCertifyAuditing) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Certifier}
+}
+
+// MsgCertifyProof is the message for issuing a proof certificate.
+type MsgCertifyProof struct {
+	RequestContentType string         `json:"request_content_type" yaml:"request_content_type"`
+	RequestContent     string         `json:"request_content" yaml:"request_content"`
+	SpecHash           string         `json:"spec_hash" yaml:"spec_hash"`
+	Prover             string         `json:"prover" yaml:"prover"`
+	Properties         []string       `json:"properties" yaml:"properties"`
+	Description        string         `json:"description" yaml:"description"`
+	Certifier          sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	ValidUntil         time.Time      `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgCertifyProof returns a new proof certification message.
+func NewMsgCertifyProof(
+	requestContentType, requestContent, specHash, prover string, properties []string,
+	description string, certifier sdk.AccAddress, validUntil time.Time,
+) MsgCertifyProof {
+	return MsgCertifyProof{
+		RequestContentType: requestContentType,
+		RequestContent:     requestContent,
+		SpecHash:           specHash,
+		Prover:             prover,
+		Properties:         properties,
+		Description:        description,
+		Certifier:          certifier,
+		ValidUntil:         validUntil,
+	}
+}
+
+// Route returns the module name.
+func (m MsgCertifyProof) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgCertifyProof) Type() string { return "certify_proof" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCertifyProof) ValidateBasic() error {
+	if requestContentType := RequestContentTypeFromString(m.RequestContentType); requestContentType == RequestContentTypeNil {
+		return ErrInvalidRequestContentType
+	}
+	if m.SpecHash == "" {
+		return sdkerrors.Wrap(ErrSpecHash, "<empty>")
+	}
+	if m.Prover == "" {
+		return sdkerrors.Wrap(ErrProver, "<empty>")
+	}
+	if m.Certifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCertifyProof) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCertifyProof) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Certifier}
+}
+
+// MsgCertifyOracleOperator is the message for issuing an oracle operator certificate.
+type MsgCertifyOracleOperator struct {
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	Name        string         `json:"name" yaml:"name"`
+	DataSources []string       `json:"data_sources" yaml:"data_sources"`
+	Description string         `json:"description" yaml:"description"`
+	Certifier   sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	ValidUntil  time.Time      `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgCertifyOracleOperator returns a new oracle operator certification message.
+func NewMsgCertifyOracleOperator(
+	operator sdk.AccAddress, name string, dataSources []string, description string, certifier sdk.AccAddress, validUntil time.Time,
+) MsgCertifyOracleOperator {
+	return MsgCertifyOracleOperator{
+		Operator:    operator,
+		Name:        name,
+		DataSources: dataSources,
+		Description: description,
+		Certifier:   certifier,
+		ValidUntil:  validUntil,
+	}
+}
+
+// Route returns the module name.
+func (m MsgCertifyOracleOperator) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgCertifyOracleOperator) Type() string { return "certify_oracle_operator" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCertifyOracleOperator) ValidateBasic() error {
+	if m.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Operator.String())
+	}
+	if m.Name == "" {
+		return sdkerrors.Wrap(ErrOracleOperatorName, "<empty>")
+	}
+	if m.Certifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCertifyOracleOperator) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCertifyOracleOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Certifier}
+}
+
+// MsgCertifyIdentity is the message for issuing an identity certificate with hashed attribute claims.
+type MsgCertifyIdentity struct {
+	Identity    sdk.AccAddress  `json:"identity" yaml:"identity"`
+	Claims      []IdentityClaim `json:"claims" yaml:"claims"`
+	Description string          `json:"description" yaml:"description"`
+	Certifier   sdk.AccAddress  `json:"certifier" yaml:"certifier"`
+	ValidUntil  time.Time       `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgCertifyIdentity returns a new identity certification message.
+func NewMsgCertifyIdentity(
+	identity sdk.AccAddress, claims []IdentityClaim, description string, certifier sdk.AccAddress, validUntil time.Time,
+) MsgCertifyIdentity {
+	return MsgCertifyIdentity{
+		Identity:    identity,
+		Claims:      claims,
+		Description: description,
+		Certifier:   certifier,
+		ValidUntil:  validUntil,
+	}
+}
+
+// Route returns the module name.
+func (m MsgCertifyIdentity) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgCertifyIdentity) Type() string { return "certify_identity" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCertifyIdentity) ValidateBasic() error {
+	if m.Identity.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Identity.String())
+	}
+	if m.Certifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier.String())
+	}
+	return NewIdentityCertificateContent(m.Claims).ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCertifyIdentity) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCertifyIdentity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Certifier}
+}
+
+// MsgProposeQuorumCertificate is the message for proposing a certificate
+// that must be co-signed by a threshold of certifiers within a time window.
+type MsgProposeQuorumCertificate struct {
+	CertificateType    string         `json:"certificate_type" yaml:"certificate_type"`
+	RequestContentType string         `json:"request_content_type" yaml:"request_content_type"`
+	RequestContent     string         `json:"request_content" yaml:"request_content"`
+	Description        string         `json:"description" yaml:"description"`
+	Proposer           sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Threshold          uint64         `json:"threshold" yaml:"threshold"`
+	Window             time.Duration  `json:"window" yaml:"window"`
+	ValidUntil         time.Time      `json:"valid_until" yaml:"valid_until"`
+}
+
+// NewMsgProposeQuorumCertificate returns a new quorum certificate proposal message.
+func NewMsgProposeQuorumCertificate(
+	certificateType, requestContentType, requestContent, description string, proposer sdk.AccAddress,
+	threshold uint64, window time.Duration, validUntil time.Time,
+) MsgProposeQuorumCertificate {
+	return MsgProposeQuorumCertificate{
+		CertificateType:    certificateType,
+		RequestContentType: requestContentType,
+		RequestContent:     requestContent,
+		Description:        description,
+		Proposer:           proposer,
+		Threshold:          threshold,
+		Window:             window,
+		ValidUntil:         validUntil,
+	}
+}
+
+// Route returns the module name.
+func (m MsgProposeQuorumCertificate) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgProposeQuorumCertificate) Type() string { return "propose_quorum_certificate" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgProposeQuorumCertificate) ValidateBasic() error {
+	if certificateType := CertificateTypeFromString(m.CertificateType); certificateType == CertificateTypeNil {
+		return ErrInvalidCertificateType
+	}
+	if requestContentType := RequestContentTypeFromString(m.RequestContentType); requestContentType == RequestContentTypeNil {
+		return ErrInvalidRequestContentType
+	}
+	if m.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Proposer.String())
+	}
+	if m.Threshold == 0 {
+		return ErrInvalidThreshold
+	}
+	if m.Window <= 0 {
+		return ErrInvalidQuorumWindow
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgProposeQuorumCertificate) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgProposeQuorumCertificate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Proposer}
+}
+
+// MsgSignQuorumCertificate is the message for co-signing a quorum certificate.
+type MsgSignQuorumCertificate struct {
+	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
+	ID     uint64         `json:"id" yaml:"id"`
+}
+
+// NewMsgSignQuorumCertificate creates a new instance of MsgSignQuorumCertificate.
+func NewMsgSignQuorumCertificate(signer sdk.AccAddress, id uint64) MsgSignQuorumCertificate {
+	return MsgSignQuorumCertificate{
+		Signer: signer,
+		ID:     id,
+	}
+}
+
+// Route returns the module name.
+func (m MsgSignQuorumCertificate) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgSignQuorumCertificate) Type() string { return "sign_quorum_certificate" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgSignQuorumCertificate) ValidateBasic() error {
+	if m.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgSignQuorumCertificate) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgSignQuorumCertificate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+// MsgPublishLibrary is the message for publishing a certificate library.
+type MsgPublishLibrary struct {
+	Publisher sdk.AccAddress `json:"publisher" yaml:"publisher"`
+	Library   sdk.AccAddress `json:"library" yaml:"library"`
+}
+
+// NewMsgPublishLibrary creates a new instance of MsgPublishLibrary.
+func NewMsgPublishLibrary(publisher, library sdk.AccAddress) MsgPublishLibrary {
+	return MsgPublishLibrary{
+		Publisher: publisher,
+		Library:   library,
+	}
+}
+
+// Route returns the module name.
+func (m MsgPublishLibrary) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgPublishLibrary) Type() string { return "publish_library" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgPublishLibrary) ValidateBasic() error {
+	if m.Publisher.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Publisher.String())
+	}
+	if m.Library.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Library.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgPublishLibrary) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgPublishLibrary) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Publisher}
+}
+
+// MsgInvalidateLibrary is the message for invalidating a certificate library.
+type MsgInvalidateLibrary struct {
+	Invalidator sdk.AccAddress `json:"invalidator" yaml:"invalidator"`
+	Library     sdk.AccAddress `json:"library" yaml:"library"`
+}
+
+// NewMsgInvalidateLibrary creates a new instance of MsgInvalidateLibrary.
+func NewMsgInvalidateLibrary(invalidator, library sdk.AccAddress) MsgInvalidateLibrary {
+	return MsgInvalidateLibrary{
+		Invalidator: invalidator,
+		Library:     library,
+	}
+}
+
+// Route returns the module name.
+func (m MsgInvalidateLibrary) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgInvalidateLibrary) Type() string { return "invalidate_library" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgInvalidateLibrary) ValidateBasic() error {
+	if m.Invalidator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Invalidator.String())
+	}
+	if m.Library.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Library.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgInvalidateLibrary) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgInvalidateLibrary) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Invalidator}
+}
+
 // MsgCertifyPlatform is the message for certifying a validator's host platform.
 type MsgCertifyPlatform struct {
-	Certifier sdk.AccAddress `json:"certifier" yaml:"certifier"`
-	Validator crypto.PubKey  `json:"validator" yaml:"validator"`
-	Platform  string         `json:"platform" yaml:"platform"`
+	Certifier    sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	Validator    crypto.PubKey  `json:"validator" yaml:"validator"`
+	Platform     string         `json:"platform" yaml:"platform"`
+	HardwareType string         `json:"hardware_type" yaml:"hardware_type"`
+	OS           string         `json:"os" yaml:"os"`
+	QuoteHash    string         `json:"quote_hash" yaml:"quote_hash"`
+	ValidUntil   time.Time      `json:"valid_until" yaml:"valid_until"`
 }
 
 type msgCertifyPlatformPretty struct {
-	Certifier sdk.AccAddress `json:"certifier" yaml:"certifier"`
-	Validator string         `json:"validator" yaml:"validator"`
-	Platform  string         `json:"platform" yaml:"platform"`
+	Certifier    sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	Validator    string         `json:"validator" yaml:"validator"`
+	Platform     string         `json:"platform" yaml:"platform"`
+	HardwareType string         `json:"hardware_type" yaml:"hardware_type"`
+	OS           string         `json:"os" yaml:"os"`
+	QuoteHash    string         `json:"quote_hash" yaml:"quote_hash"`
+	ValidUntil   time.Time      `json:"valid_until" yaml:"valid_until"`
 }
 
 // NewMsgCertifyPlatform returns a new validator host platform certification
 // message.
-func NewMsgCertifyPlatform(certifier sdk.AccAddress, validator crypto.PubKey, platform string) MsgCertifyPlatform {
+func NewMsgCertifyPlatform(
+	certifier sdk.AccAddress, validator crypto.PubKey, platform, hardwareType, os, quoteHash string, validUntil time.Time,
+) MsgCertifyPlatform {
 	return MsgCertifyPlatform{
-		Certifier: certifier,
-		Validator: validator,
-		Platform:  platform,
+		Certifier:    certifier,
+		Validator:    validator,
+		Platform:     platform,
+		HardwareType: hardwareType,
+		OS:           os,
+		QuoteHash:    quoteHash,
+		ValidUntil:   validUntil,
 	}
 }
 
@@ -438,7 +952,15 @@ func (m MsgCertifyPlatform) ValidateBasic() error {
 	if m.Validator == nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "<empty>")
 	}
-	return nil
+	if HardwareTypeFromString(m.HardwareType) == HardwareTypeNil {
+		return ErrInvalidHardwareType
+	}
+	return m.Attestation().ValidateBasic()
+}
+
+// Attestation returns the platform attestation carried by the message.
+func (m MsgCertifyPlatform) Attestation() PlatformAttestation {
+	return NewPlatformAttestation(HardwareTypeFromString(m.HardwareType), m.OS, m.QuoteHash, m.ValidUntil)
 }
 
 // GetSignBytes encodes the message for signing.
@@ -458,13 +980,21 @@ func (m MsgCertifyPlatform) GetSigners() []sdk.AccAddress {
 // MarshalYAML implements a custom marshal yaml function due to consensus pubkey.
 func (m MsgCertifyPlatform) MarshalYAML() (interface{}, error) {
 	d, err := yaml.Marshal(struct {
-		Certifier sdk.AccAddress
-		Validator string
-		Platform  string
+		Certifier    sdk.AccAddress
+		Validator    string
+		Platform     string
+		HardwareType string
+		OS           string
+		QuoteHash    string
+		ValidUntil   time.Time
 	}{
-		Certifier: m.Certifier,
-		Validator: sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, m.Validator),
-		Platform:  m.Platform,
+		Certifier:    m.Certifier,
+		Validator:    sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, m.Validator),
+		Platform:     m.Platform,
+		HardwareType: m.HardwareType,
+		OS:           m.OS,
+		QuoteHash:    m.QuoteHash,
+		ValidUntil:   m.ValidUntil,
 	})
 	if err != nil {
 		return nil, err
@@ -483,13 +1013,21 @@ func (m MsgCertifyPlatform) MarshalJSON() ([]byte, error) {
 		}
 	}
 	return json.Marshal(struct {
-		Certifier sdk.AccAddress
-		Validator string
-		Platform  string
+		Certifier    sdk.AccAddress
+		Validator    string
+		Platform     string
+		HardwareType string
+		OS           string
+		QuoteHash    string
+		ValidUntil   time.Time
 	}{
 		m.Certifier,
 		pk,
 		m.Platform,
+		m.HardwareType,
+		m.OS,
+		m.QuoteHash,
+		m.ValidUntil,
 	})
 }
 
@@ -508,5 +1046,54 @@ func (m *MsgCertifyPlatform) UnmarshalJSON(bz []byte) error {
 	}
 	m.Certifier = alias.Certifier
 	m.Platform = alias.Platform
+	m.HardwareType = alias.HardwareType
+	m.OS = alias.OS
+	m.QuoteHash = alias.QuoteHash
+	m.ValidUntil = alias.ValidUntil
 	return nil
 }
+
+// MsgDepositCertifierBond is the message for a certifier to deposit its bond.
+type MsgDepositCertifierBond struct {
+	Certifier sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositCertifierBond creates a new instance of MsgDepositCertifierBond.
+func NewMsgDepositCertifierBond(certifier sdk.AccAddress, amount sdk.Coins) MsgDepositCertifierBond {
+	return MsgDepositCertifierBond{
+		Certifier: certifier,
+		Amount:    amount,
+	}
+}
+
+// Route returns the module name.
+func (m MsgDepositCertifierBond) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (m MsgDepositCertifierBond) Type() string { return "deposit_certifier_bond" }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgDepositCertifierBond) ValidateBasic() error {
+	if m.Certifier.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Certifier.String())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgDepositCertifierBond) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgDepositCertifierBond) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Certifier}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/certikfoundation/shentu/common"
+)
+
+var (
+	ParamsStoreKeyCertifierParams = []byte("certifierparams")
+)
+
+// Default parameters
+var (
+	DefaultMinBond = sdk.NewCoins(sdk.NewInt64Coin(common.MicroCTKDenom, 1000000000))
+)
+
+// ParamKeyTable is the key declaration for parameters.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		params.NewParamSetPair(ParamsStoreKeyCertifierParams, CertifierParams{}, validateCertifierParams),
+	)
+}
+
+// CertifierParams defines the parameters for certifier accountability.
+type CertifierParams struct {
+	MinBond sdk.Coins `json:"min_bond" yaml:"min_bond"`
+}
+
+// NewCertifierParams returns a CertifierParams object.
+func NewCertifierParams(minBond sdk.Coins) CertifierParams {
+	return CertifierParams{
+		MinBond: minBond,
+	}
+}
+
+// DefaultCertifierParams generates default set for CertifierParams.
+func DefaultCertifierParams() CertifierParams {
+	return NewCertifierParams(DefaultMinBond)
+}
+
+// String implements the Stringer interface.
+func (p CertifierParams) String() string {
+	return fmt.Sprintf(`Certifier Params:
+  Minimum Bond: %s`, p.MinBond)
+}
+
+func validateCertifierParams(i interface{}) error {
+	certifierParams, ok := i.(CertifierParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !certifierParams.MinBond.IsValid() && !certifierParams.MinBond.Empty() {
+		return ErrInvalidCertifierParams
+	}
+	return nil
+}
+
+type ParamSubspace interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Set(ctx sdk.Context, key []byte, param interface{})
+	WithKeyTable(table subspace.KeyTable) subspace.Subspace
+}
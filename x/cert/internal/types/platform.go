@@ -0,0 +1,117 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HardwareType is the type for the hardware of a validator host platform.
+// A larger value is a more trusted kind of hardware.
+type HardwareType byte
+
+// Hardware types
+const (
+	HardwareTypeUnspecified HardwareType = iota
+	HardwareTypeVirtualMachine
+	HardwareTypeBareMetal
+	HardwareTypeHSM
+	HardwareTypeTEE
+	HardwareTypeNil HardwareType = 0xFF
+)
+
+// String returns the string for a hardware type.
+func (h HardwareType) String() string {
+	switch h {
+	case HardwareTypeUnspecified:
+		return "Unspecified"
+	case HardwareTypeVirtualMachine:
+		return "VirtualMachine"
+	case HardwareTypeBareMetal:
+		return "BareMetal"
+	case HardwareTypeHSM:
+		return "HSM"
+	case HardwareTypeTEE:
+		return "TEE"
+	default:
+		return "UnknownHardwareType"
+	}
+}
+
+// HardwareTypeFromString returns the hardware type given a string.
+// An empty string is an unspecified hardware type.
+func HardwareTypeFromString(s string) HardwareType {
+	switch strings.ToUpper(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s)) {
+	case "", "UNSPECIFIED":
+		return HardwareTypeUnspecified
+	case "VIRTUALMACHINE", "VM":
+		return HardwareTypeVirtualMachine
+	case "BAREMETAL":
+		return HardwareTypeBareMetal
+	case "HSM":
+		return HardwareTypeHSM
+	case "TEE":
+		return HardwareTypeTEE
+	default:
+		return HardwareTypeNil
+	}
+}
+
+// PlatformAttestation is the structured attestation of a validator host platform.
+type PlatformAttestation struct {
+	HardwareType HardwareType `json:"hardware_type"`
+	OS           string       `json:"os"`
+	QuoteHash    string       `json:"quote_hash"`
+	ValidUntil   time.Time    `json:"valid_until"`
+}
+
+// NewPlatformAttestation returns a new platform attestation.
+func NewPlatformAttestation(hardwareType HardwareType, os, quoteHash string, validUntil time.Time) PlatformAttestation {
+	return PlatformAttestation{
+		HardwareType: hardwareType,
+		OS:           os,
+		QuoteHash:    strings.ToLower(quoteHash),
+		ValidUntil:   validUntil,
+	}
+}
+
+// ValidateBasic runs stateless checks on the attestation.
+func (a PlatformAttestation) ValidateBasic() error {
+	if a.HardwareType > HardwareTypeTEE {
+		return ErrInvalidHardwareType
+	}
+	if a.QuoteHash != "" {
+		if bz, err := hex.DecodeString(a.QuoteHash); err != nil || len(bz) != 32 {
+			return ErrInvalidQuoteHash
+		}
+	}
+	return nil
+}
+
+// IsCurrent returns whether the attestation is valid at the given time.
+// An attestation without a validity end never expires.
+func (a PlatformAttestation) IsCurrent(now time.Time) bool {
+	return a.ValidUntil.IsZero() || a.ValidUntil.After(now)
+}
+
+// String implements fmt.Stringer.
+func (p Platform) String() string {
+	validator := ""
+	if p.Validator != nil {
+		validator = sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, p.Validator)
+	}
+	return fmt.Sprintf("Platform\n"+
+		"Validator: %s\n"+
+		"Description: %s\n"+
+		"Certifier: %s\n"+
+		"Hardware type: %s\n"+
+		"OS: %s\n"+
+		"Quote hash: %s\n"+
+		"Valid until: %s\n"+
+		"Height: %d\n",
+		validator, p.Description, p.Certifier, p.Attestation.HardwareType, p.Attestation.OS,
+		p.Attestation.QuoteHash, p.Attestation.ValidUntil, p.Height)
+}
@@ -12,14 +12,22 @@ import (
 const (
 	// ProposalTypeCertifierUpdate defines the type for a CertifierUpdateProposal
 	ProposalTypeCertifierUpdate = "CertifierUpdate"
+
+	// ProposalTypeCertifierSlash defines the type for a CertifierSlashProposal
+	ProposalTypeCertifierSlash = "CertifierSlash"
 )
 
-// Assert CertifierUpdateProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = CertifierUpdateProposal{}
+// Assert CertifierUpdateProposal and CertifierSlashProposal implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CertifierUpdateProposal{}
+	_ govtypes.Content = CertifierSlashProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCertifierUpdate)
 	govtypes.RegisterProposalTypeCodec(CertifierUpdateProposal{}, "cosmos-sdk/CertifierUpdateProposal")
+	govtypes.RegisterProposalType(ProposalTypeCertifierSlash)
+	govtypes.RegisterProposalTypeCodec(CertifierSlashProposal{}, "cosmos-sdk/CertifierSlashProposal")
 }
 
 // CertifierUpdateProposal adds or removes a certifier
@@ -87,6 +95,72 @@ func (cup CertifierUpdateProposal) String() string {
 	return b.String()
 }
 
+// CertifierSlashProposal slashes the bond of a certifier, for example
+// after a contract it certified was exploited.
+type CertifierSlashProposal struct {
+	Title           string         `json:"title" yaml:"title"`
+	Description     string         `json:"description" yaml:"description"`
+	Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Certifier       sdk.AccAddress `json:"certifier" yaml:"certifier"`
+	Amount          sdk.Coins      `json:"amount" yaml:"amount"`
+	ClaimProposalID uint64         `json:"claim_proposal_id" yaml:"claim_proposal_id"`
+}
+
+// NewCertifierSlashProposal creates a new certifier slash proposal. A
+// non-zero claimProposalID links the proposal to a passed shield claim.
+func NewCertifierSlashProposal(title, description string, proposer, certifier sdk.AccAddress,
+	amount sdk.Coins, claimProposalID uint64) CertifierSlashProposal {
+	return CertifierSlashProposal{
+		Title:           title,
+		Description:     description,
+		Proposer:        proposer,
+		Certifier:       certifier,
+		Amount:          amount,
+		ClaimProposalID: claimProposalID,
+	}
+}
+
+// GetTitle returns the title of a certifier slash proposal.
+func (csp CertifierSlashProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a certifier slash proposal.
+func (csp CertifierSlashProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a certifier slash proposal.
+func (csp CertifierSlashProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a certifier slash proposal.
+func (csp CertifierSlashProposal) ProposalType() string { return ProposalTypeCertifierSlash }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp CertifierSlashProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+	if csp.Certifier.Empty() {
+		return ErrEmptyCertifier
+	}
+	if !csp.Amount.IsValid() || csp.Amount.Empty() {
+		return ErrInvalidSlashAmount
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CertifierSlashProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Certifier Slash Proposal:
+  Title:             %s
+  Description:       %s
+  Certifier:         %s
+  Amount:            %s
+  Claim Proposal ID: %d
+`, csp.Title, csp.Description, csp.Certifier, csp.Amount, csp.ClaimProposalID))
+	return b.String()
+}
+
 type AddOrRemove bool
 
 const (
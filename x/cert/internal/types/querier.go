@@ -1,7 +1,9 @@
 package types
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +27,9 @@ const (
 	// QueryPlatform is the query endpoint for validator host platform.
 	QueryPlatform = "platform"
 
+	// QueryPlatforms is the query endpoint for validators ranked by certified host platform.
+	QueryPlatforms = "platforms"
+
 	// QueryCertificate is the query endpoint for a certificate.
 	QueryCertificate = "certificate"
 
@@ -33,15 +38,43 @@ const (
 
 	// QueryCertificates is the query endpoint for certificates.
 	QueryCertificates = "certificates"
+
+	// QueryLibrary is the query endpoint for a certificate library.
+	QueryLibrary = "library"
+
+	// QueryLibraries is the query endpoint for all certificate libraries.
+	QueryLibraries = "libraries"
+
+	// QueryCertifierReputation is the query endpoint for the reputation of a certifier.
+	QueryCertifierReputation = "reputation"
+
+	// QueryCertifierParams is the query endpoint for the certifier parameters.
+	QueryCertifierParams = "params"
+
+	// QueryRevocation is the query endpoint for the revocation of a certificate.
+	QueryRevocation = "revocation"
+
+	// QueryRevocationList is the query endpoint for the list of revoked certificates.
+	QueryRevocationList = "revocations"
+
+	// QueryVerifyIdentity is the query endpoint for verifying disclosed identity attributes.
+	QueryVerifyIdentity = "verifyidentity"
 )
 
 // QueryCertificatesParams is the type for parameters of querying certificates.
+// Certificates are returned from the newest to the oldest. A non-zero Cursor
+// selects the certificates with IDs below it and takes precedence over Page.
+// A zero MaxHeight leaves the issuance height range open-ended.
 type QueryCertificatesParams struct {
-	Page        int
-	Limit       int
-	Certifier   sdk.AccAddress
-	ContentType string
-	Content     string
+	Page            int
+	Limit           int
+	Certifier       sdk.AccAddress
+	ContentType     string
+	Content         string
+	CertificateType string
+	MinHeight       int64
+	MaxHeight       int64
+	Cursor          uint64
 }
 
 // QueryResCertifiers is the query result payload for all certifiers.
@@ -77,22 +110,140 @@ func (q QueryResValidators) String() string {
 }
 
 // NewQueryCertificatesParams creates a new instance of QueryCertificatesParams.
-func NewQueryCertificatesParams(page, limit int, certifier sdk.AccAddress, contentType, content string) QueryCertificatesParams {
+func NewQueryCertificatesParams(
+	page, limit int, certifier sdk.AccAddress, contentType, content, certificateType string,
+	minHeight, maxHeight int64, cursor uint64,
+) QueryCertificatesParams {
 	return QueryCertificatesParams{
-		Page:        page,
-		Limit:       limit,
-		Certifier:   certifier,
-		ContentType: contentType,
-		Content:     content,
+		Page:            page,
+		Limit:           limit,
+		Certifier:       certifier,
+		ContentType:     contentType,
+		Content:         content,
+		CertificateType: certificateType,
+		MinHeight:       minHeight,
+		MaxHeight:       maxHeight,
+		Cursor:          cursor,
+	}
+}
+
+// QueryRevocationListParams is the type for parameters of querying the revocation list.
+// A positive SinceHeight only lists the certificates revoked after that height.
+type QueryRevocationListParams struct {
+	SinceHeight int64
+}
+
+// NewQueryRevocationListParams creates a new instance of QueryRevocationListParams.
+func NewQueryRevocationListParams(sinceHeight int64) QueryRevocationListParams {
+	return QueryRevocationListParams{
+		SinceHeight: sinceHeight,
+	}
+}
+
+// QueryVerifyIdentityParams is the type for parameters of verifying disclosed identity attributes.
+type QueryVerifyIdentityParams struct {
+	Identity    sdk.AccAddress
+	Disclosures []IdentityDisclosure
+}
+
+// NewQueryVerifyIdentityParams creates a new instance of QueryVerifyIdentityParams.
+func NewQueryVerifyIdentityParams(identity sdk.AccAddress, disclosures []IdentityDisclosure) QueryVerifyIdentityParams {
+	return QueryVerifyIdentityParams{
+		Identity:    identity,
+		Disclosures: disclosures,
+	}
+}
+
+// QueryResVerifyIdentity is the query result payload for a successful identity verification.
+type QueryResVerifyIdentity struct {
+	Identity      sdk.AccAddress `json:"identity"`
+	CertificateID uint64         `json:"certificate_id"`
+	Certifier     sdk.AccAddress `json:"certifier"`
+	Verified      []string       `json:"verified"`
+}
+
+// String implements fmt.Stringer.
+func (q QueryResVerifyIdentity) String() string {
+	return fmt.Sprintf("Identity: %s\n"+
+		"Certificate ID: %d\n"+
+		"Certifier: %s\n"+
+		"Verified attributes: %s\n",
+		q.Identity, q.CertificateID, q.Certifier, strings.Join(q.Verified, ", "))
+}
+
+// QueryPlatformsParams is the type for parameters of querying the platform ranking.
+// CurrentOnly leaves out expired attestations and MinHardwareType leaves out
+// platforms with a less trusted kind of hardware.
+type QueryPlatformsParams struct {
+	CurrentOnly     bool
+	MinHardwareType string
+}
+
+// NewQueryPlatformsParams creates a new instance of QueryPlatformsParams.
+func NewQueryPlatformsParams(currentOnly bool, minHardwareType string) QueryPlatformsParams {
+	return QueryPlatformsParams{
+		CurrentOnly:     currentOnly,
+		MinHardwareType: minHardwareType,
 	}
 }
 
 // QueryResPlatform is the query result payload for a validator host platform query.
 type QueryResPlatform struct {
-	Platform string `json:"platform"`
+	Validator    string         `json:"validator"`
+	Platform     string         `json:"platform"`
+	Certifier    sdk.AccAddress `json:"certifier"`
+	HardwareType string         `json:"hardware_type"`
+	OS           string         `json:"os"`
+	QuoteHash    string         `json:"quote_hash"`
+	ValidUntil   time.Time      `json:"valid_until"`
+	Height       int64          `json:"height"`
+	Current      bool           `json:"current"`
+}
+
+// NewQueryResPlatform returns the query result of a platform as of the given time.
+func NewQueryResPlatform(platform Platform, now time.Time) QueryResPlatform {
+	return QueryResPlatform{
+		Validator:    sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, platform.Validator),
+		Platform:     platform.Description,
+		Certifier:    platform.Certifier,
+		HardwareType: platform.Attestation.HardwareType.String(),
+		OS:           platform.Attestation.OS,
+		QuoteHash:    platform.Attestation.QuoteHash,
+		ValidUntil:   platform.Attestation.ValidUntil,
+		Height:       platform.Height,
+		Current:      platform.Attestation.IsCurrent(now),
+	}
 }
 
 // String implements fmt.Stringer.
 func (q QueryResPlatform) String() string {
-	return q.Platform
+	return fmt.Sprintf("Validator: %s\n"+
+		"Platform: %s\n"+
+		"Certifier: %s\n"+
+		"Hardware type: %s\n"+
+		"OS: %s\n"+
+		"Quote hash: %s\n"+
+		"Valid until: %s\n"+
+		"Height: %d\n"+
+		"Current: %t\n",
+		q.Validator, q.Platform, q.Certifier, q.HardwareType, q.OS, q.QuoteHash, q.ValidUntil, q.Height, q.Current)
+}
+
+// QueryResPlatforms is the query result payload for the platform ranking query.
+type QueryResPlatforms struct {
+	Platforms []QueryResPlatform `json:"platforms"`
+}
+
+// String implements fmt.Stringer.
+func (q QueryResPlatforms) String() string {
+	platforms := make([]string, 0, len(q.Platforms))
+	for _, platform := range q.Platforms {
+		platforms = append(platforms, platform.String())
+	}
+	return strings.Join(platforms, "\n")
+}
+
+// QueryResLibraries is the query result payload for all certificate libraries.
+type QueryResLibraries struct {
+	Libraries Libraries `json:"libraries"`
 }
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RevocationReason is the type for the reason of a certificate revocation.
+type RevocationReason byte
+
+// Revocation reasons
+const (
+	RevocationReasonUnspecified RevocationReason = iota
+	RevocationReasonKeyCompromise
+	RevocationReasonSuperseded
+	RevocationReasonVulnerabilityFound
+	RevocationReasonCessationOfOperation
+	RevocationReasonPrivilegeWithdrawn
+	RevocationReasonNil RevocationReason = 0xFF
+)
+
+// String returns the string for a revocation reason.
+func (r RevocationReason) String() string {
+	switch r {
+	case RevocationReasonUnspecified:
+		return "Unspecified"
+	case RevocationReasonKeyCompromise:
+		return "KeyCompromise"
+	case RevocationReasonSuperseded:
+		return "Superseded"
+	case RevocationReasonVulnerabilityFound:
+		return "VulnerabilityFound"
+	case RevocationReasonCessationOfOperation:
+		return "CessationOfOperation"
+	case RevocationReasonPrivilegeWithdrawn:
+		return "PrivilegeWithdrawn"
+	default:
+		return "UnknownRevocationReason"
+	}
+}
+
+// CRLReasonCode returns the X.509 CRL reason code (RFC 5280) of a revocation reason.
+// Reasons without a CRL counterpart map to unspecified.
+func (r RevocationReason) CRLReasonCode() int {
+	switch r {
+	case RevocationReasonKeyCompromise:
+		return 1
+	case RevocationReasonSuperseded:
+		return 4
+	case RevocationReasonCessationOfOperation:
+		return 5
+	case RevocationReasonPrivilegeWithdrawn:
+		return 9
+	default:
+		return 0
+	}
+}
+
+// RevocationReasonFromString returns the revocation reason given a string.
+// An empty string is an unspecified reason.
+func RevocationReasonFromString(s string) RevocationReason {
+	switch strings.ToUpper(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s)) {
+	case "", "UNSPECIFIED":
+		return RevocationReasonUnspecified
+	case "KEYCOMPROMISE":
+		return RevocationReasonKeyCompromise
+	case "SUPERSEDED":
+		return RevocationReasonSuperseded
+	case "VULNERABILITYFOUND":
+		return RevocationReasonVulnerabilityFound
+	case "CESSATIONOFOPERATION":
+		return RevocationReasonCessationOfOperation
+	case "PRIVILEGEWITHDRAWN":
+		return RevocationReasonPrivilegeWithdrawn
+	default:
+		return RevocationReasonNil
+	}
+}
+
+// Revocation is the registry entry of a revoked certificate.
+type Revocation struct {
+	Certificate Certificate      `json:"certificate"`
+	Revoker     sdk.AccAddress   `json:"revoker"`
+	Reason      RevocationReason `json:"reason"`
+	Description string           `json:"description"`
+	Height      int64            `json:"height"`
+	Time        time.Time        `json:"time"`
+}
+
+// NewRevocation returns a new revocation.
+func NewRevocation(
+	certificate Certificate, revoker sdk.AccAddress, reason RevocationReason, description string, height int64, time time.Time,
+) Revocation {
+	return Revocation{
+		Certificate: certificate,
+		Revoker:     revoker,
+		Reason:      reason,
+		Description: description,
+		Height:      height,
+		Time:        time,
+	}
+}
+
+// String returns a human readable string representation of the revocation.
+func (r Revocation) String() string {
+	return fmt.Sprintf("Revocation\n"+
+		"Certificate ID: %s\n"+
+		"Revoker: %s\n"+
+		"Reason: %s\n"+
+		"Description: %s\n"+
+		"Height: %d\n"+
+		"Time: %s\n",
+		strconv.FormatUint(r.Certificate.ID(), 10), r.Revoker, r.Reason, r.Description, r.Height, r.Time)
+}
+
+// Revocations is a collection of revocations.
+type Revocations []Revocation
+
+// RevocationListEntry is a CRL-style entry of a revoked certificate.
+type RevocationListEntry struct {
+	SerialNumber   uint64    `json:"serial_number"`
+	RevocationDate time.Time `json:"revocation_date"`
+	ReasonCode     int       `json:"reason_code"`
+	Reason         string    `json:"reason"`
+}
+
+// NewRevocationListEntry returns the revocation list entry of a revocation.
+func NewRevocationListEntry(revocation Revocation) RevocationListEntry {
+	return RevocationListEntry{
+		SerialNumber:   revocation.Certificate.ID(),
+		RevocationDate: revocation.Time,
+		ReasonCode:     revocation.Reason.CRLReasonCode(),
+		Reason:         revocation.Reason.String(),
+	}
+}
+
+// RevocationList is a CRL-style list of revoked certificates as of a block.
+type RevocationList struct {
+	ThisUpdate          time.Time             `json:"this_update"`
+	Height              int64                 `json:"height"`
+	RevokedCertificates []RevocationListEntry `json:"revoked_certificates"`
+}
+
+// String implements fmt.Stringer.
+func (l RevocationList) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Revocation list\nThis update: %s\nHeight: %d\n", l.ThisUpdate, l.Height)
+	for _, entry := range l.RevokedCertificates {
+		fmt.Fprintf(&b, "%d\t%s\t%d (%s)\n", entry.SerialNumber, entry.RevocationDate, entry.ReasonCode, entry.Reason)
+	}
+	return b.String()
+}
@@ -1,6 +1,7 @@
 package cert_test
 
 import (
+	"fmt"
 	"math/rand"
 	"reflect"
 	"testing"
@@ -9,10 +10,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cert"
+	"github.com/certikfoundation/shentu/x/cert/internal/keeper"
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
 )
 
@@ -48,7 +52,7 @@ func Test_IssueAndRevokeCertificate(t *testing.T) {
 		contentCertID, found := app.CertKeeper.GetContentCertID(ctx, types.CertificateTypeCompilation, cert.RequestContent())
 		require.True(t, found && contentCertID == id)
 		
-		err = app.CertKeeper.RevokeCertificate(ctx, cert, certifier)
+		err = app.CertKeeper.RevokeCertificate(ctx, cert, certifier, types.RevocationReasonUnspecified, "")
 		require.NoError(t, err)
 
 		_, err = app.CertKeeper.GetCertificateByID(ctx, id)
@@ -62,6 +66,210 @@ func Test_IssueAndRevokeCertificate(t *testing.T) {
 	})
 }
 
+func Test_RevocationRegistry(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+	handler := cert.NewHandler(app.CertKeeper)
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		id, err := app.CertKeeper.IssueCertificate(ctx, types.NewCompilationCertificate(types.CertificateTypeCompilation,
+			fmt.Sprintf("content%d", i), "compiler1", "bytecodehash1", "description", addrs[0]))
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	// Unknown reasons and revokers who are not certifiers are rejected.
+	require.Error(t, types.NewMsgRevokeCertificate(addrs[0], ids[0], "unknown", "").ValidateBasic())
+	_, err := handler(ctx, types.NewMsgRevokeCertificate(addrs[1], ids[0], "superseded", ""))
+	require.Equal(t, types.ErrUnqualifiedRevoker, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	res, err := handler(ctx, types.NewMsgRevokeCertificate(addrs[0], ids[0], "superseded", "replaced by a new audit"))
+	require.NoError(t, err)
+	var attributes = map[string]string{}
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeRevokeCertificate {
+			for _, attribute := range event.Attributes {
+				attributes[string(attribute.Key)] = string(attribute.Value)
+			}
+		}
+	}
+	require.Equal(t, fmt.Sprint(ids[0]), attributes["certificate_id"])
+	require.Equal(t, "Superseded", attributes["reason"])
+	require.Equal(t, "4", attributes["reason_code"])
+
+	ctx = ctx.WithBlockHeight(20)
+	_, err = handler(ctx, types.NewMsgRevokeCertificate(addrs[0], ids[1], "key-compromise", ""))
+	require.NoError(t, err)
+
+	// The revocation keeps a snapshot of the deleted certificate.
+	_, err = app.CertKeeper.GetCertificateByID(ctx, ids[0])
+	require.Error(t, err)
+	revocation, err := app.CertKeeper.GetRevocation(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, ids[0], revocation.Certificate.ID())
+	require.Equal(t, "content0", revocation.Certificate.RequestContent().RequestContent)
+	require.Equal(t, addrs[0], revocation.Revoker)
+	require.Equal(t, types.RevocationReasonSuperseded, revocation.Reason)
+	require.Equal(t, "replaced by a new audit", revocation.Description)
+	require.Equal(t, int64(10), revocation.Height)
+
+	_, err = app.CertKeeper.GetRevocation(ctx, ids[2])
+	require.Equal(t, types.ErrRevocationNotExists, err)
+
+	// The revocation list can be restricted to the revocations after a height.
+	list := app.CertKeeper.GetRevocationList(ctx, 0)
+	require.Len(t, list.RevokedCertificates, 2)
+	require.Equal(t, ids[1], list.RevokedCertificates[1].SerialNumber)
+	require.Equal(t, 1, list.RevokedCertificates[1].ReasonCode)
+
+	list = app.CertKeeper.GetRevocationList(ctx, 10)
+	require.Len(t, list.RevokedCertificates, 1)
+	require.Equal(t, ids[1], list.RevokedCertificates[0].SerialNumber)
+
+	// The registry is preserved through genesis export and import.
+	genesis := cert.ExportGenesis(ctx, app.CertKeeper)
+	require.Len(t, genesis.Revocations, 2)
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	cert.InitGenesis(ctx2, app2.CertKeeper, genesis)
+	require.Equal(t, genesis.Revocations, []types.Revocation(app2.CertKeeper.GetAllRevocations(ctx2)))
+}
+
+func Test_PlatformAttestation(t *testing.T) {
+	now := time.Now().UTC()
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now}).WithBlockHeight(5)
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+	handler := cert.NewHandler(app.CertKeeper)
+	quoteHash := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	vm := ed25519.GenPrivKey().PubKey()
+	tee := ed25519.GenPrivKey().PubKey()
+	hsm := ed25519.GenPrivKey().PubKey()
+
+	// Unknown hardware types, malformed quote hashes, non-certifiers and expired attestations are rejected.
+	require.Error(t, types.NewMsgCertifyPlatform(addrs[0], vm, "platform", "mainframe", "", "", time.Time{}).ValidateBasic())
+	require.Error(t, types.NewMsgCertifyPlatform(addrs[0], vm, "platform", "tee", "", "0123", time.Time{}).ValidateBasic())
+	_, err := handler(ctx, types.NewMsgCertifyPlatform(addrs[1], vm, "platform", "vm", "", "", time.Time{}))
+	require.Equal(t, types.ErrRejectedValidator, err)
+	_, err = handler(ctx, types.NewMsgCertifyPlatform(addrs[0], vm, "platform", "vm", "", "", now.Add(-time.Hour)))
+	require.Equal(t, types.ErrPlatformValidUntil, err)
+
+	_, err = handler(ctx, types.NewMsgCertifyPlatform(addrs[0], vm, "cloud", "vm", "linux", "", time.Time{}))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCertifyPlatform(addrs[0], tee, "enclave", "tee", "linux", quoteHash, now.Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCertifyPlatform(addrs[0], hsm, "signer", "hsm", "linux", "", now.Add(time.Hour)))
+	require.NoError(t, err)
+
+	platform, found := app.CertKeeper.GetPlatform(ctx, tee)
+	require.True(t, found)
+	require.Equal(t, "enclave", platform.Description)
+	require.Equal(t, addrs[0], platform.Certifier)
+	require.Equal(t, types.HardwareTypeTEE, platform.Attestation.HardwareType)
+	require.Equal(t, quoteHash, platform.Attestation.QuoteHash)
+	require.Equal(t, int64(5), platform.Height)
+	_, found = app.CertKeeper.GetPlatform(ctx, ed25519.GenPrivKey().PubKey())
+	require.False(t, found)
+
+	validators := func(platforms []types.Platform) (pubkeys []string) {
+		for _, platform := range platforms {
+			pubkeys = append(pubkeys, platform.Validator.Address().String())
+		}
+		return pubkeys
+	}
+	require.Equal(t, validators([]types.Platform{{Validator: tee}, {Validator: hsm}, {Validator: vm}}),
+		validators(app.CertKeeper.GetPlatformRanking(ctx, false, types.HardwareTypeUnspecified)))
+
+	// Expired attestations rank below current ones and no longer satisfy the staking policy.
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	require.True(t, app.CertKeeper.HasCurrentPlatformCertificate(ctx, vm))
+	require.False(t, app.CertKeeper.HasCurrentPlatformCertificate(ctx, tee))
+	require.Equal(t, validators([]types.Platform{{Validator: vm}, {Validator: tee}, {Validator: hsm}}),
+		validators(app.CertKeeper.GetPlatformRanking(ctx, false, types.HardwareTypeUnspecified)))
+	require.Equal(t, validators([]types.Platform{{Validator: vm}}),
+		validators(app.CertKeeper.GetPlatformRanking(ctx, true, types.HardwareTypeUnspecified)))
+	require.Equal(t, validators([]types.Platform{{Validator: tee}, {Validator: hsm}}),
+		validators(app.CertKeeper.GetPlatformRanking(ctx, false, types.HardwareTypeHSM)))
+
+	// The attestations are preserved through genesis export and import.
+	genesis := cert.ExportGenesis(ctx, app.CertKeeper)
+	require.Len(t, genesis.Platforms, 3)
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, abci.Header{Time: now})
+	cert.InitGenesis(ctx2, app2.CertKeeper, genesis)
+	require.Equal(t, genesis.Platforms, app2.CertKeeper.GetAllPlatforms(ctx2))
+}
+
+func Test_IdentityCertificate(t *testing.T) {
+	now := time.Now().UTC()
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(10000))
+	app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+	handler := cert.NewHandler(app.CertKeeper)
+
+	jurisdiction := types.NewIdentityClaim(types.IdentityAttributeJurisdiction,
+		types.IdentityClaimHash(types.IdentityAttributeJurisdiction, "CH", "salt1"))
+	entityType := types.NewIdentityClaim(types.IdentityAttributeEntityType,
+		types.IdentityClaimHash(types.IdentityAttributeEntityType, "corporation", "salt2"))
+
+	// Malformed and duplicate claims are rejected.
+	require.Error(t, types.NewMsgCertifyIdentity(addrs[1], []types.IdentityClaim{types.NewIdentityClaim("jurisdiction", "0123")},
+		"", addrs[0], time.Time{}).ValidateBasic())
+	require.Error(t, types.NewMsgCertifyIdentity(addrs[1], []types.IdentityClaim{jurisdiction, jurisdiction},
+		"", addrs[0], time.Time{}).ValidateBasic())
+	require.Error(t, types.NewMsgCertifyIdentity(addrs[1], []types.IdentityClaim{types.NewIdentityClaim("Entity Type", entityType.Hash)},
+		"", addrs[0], time.Time{}).ValidateBasic())
+
+	_, err := handler(ctx, types.NewMsgCertifyIdentity(addrs[1], []types.IdentityClaim{jurisdiction, entityType},
+		"kyc", addrs[0], now.Add(time.Hour)))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgCertifyGeneral("identity", "address", addrs[2].String(), "", addrs[0], time.Time{}))
+	require.NoError(t, err)
+
+	// Identity certificates are recognized wherever identity certification is required.
+	require.True(t, app.CertKeeper.IsCertified(ctx, "address", addrs[1].String(), "identity"))
+	require.ElementsMatch(t, []sdk.AccAddress{addrs[1], addrs[2]}, app.CertKeeper.GetCertifiedIdentities(ctx))
+	require.NoError(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[1], types.IdentityAttributeJurisdiction))
+	require.NoError(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[2]))
+	require.Error(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[2], types.IdentityAttributeJurisdiction))
+	require.Error(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[1], "residence"))
+	require.Error(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[3]))
+
+	// Disclosed values are verified against the claim hashes, one attribute at a time.
+	disclosure, err := types.IdentityDisclosureFromString("jurisdiction=CH:salt1")
+	require.NoError(t, err)
+	_, err = app.CertKeeper.VerifyIdentityDisclosures(ctx, addrs[1], []types.IdentityDisclosure{disclosure})
+	require.NoError(t, err)
+	_, err = app.CertKeeper.VerifyIdentityDisclosures(ctx, addrs[1], []types.IdentityDisclosure{
+		types.NewIdentityDisclosure(types.IdentityAttributeJurisdiction, "US", "salt1"),
+	})
+	require.Error(t, err)
+	_, err = types.IdentityDisclosureFromString("jurisdiction=CH")
+	require.Error(t, err)
+
+	querier := keeper.NewQuerier(app.CertKeeper)
+	params := types.NewQueryVerifyIdentityParams(addrs[1], []types.IdentityDisclosure{
+		disclosure, types.NewIdentityDisclosure(types.IdentityAttributeEntityType, "corporation", "salt2"),
+	})
+	bz, err := app.Codec().MarshalJSON(params)
+	require.NoError(t, err)
+	res, err := querier(ctx, []string{types.QueryVerifyIdentity}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+	var verified types.QueryResVerifyIdentity
+	require.NoError(t, app.Codec().UnmarshalJSON(res, &verified))
+	require.Equal(t, []string{types.IdentityAttributeJurisdiction, types.IdentityAttributeEntityType}, verified.Verified)
+
+	// Expired identity certificates no longer satisfy the requirement.
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	require.Error(t, app.CertKeeper.RequireCertifiedIdentity(ctx, addrs[1]))
+}
+
 func Test_IterationByCertifier(t *testing.T) {
 	t.Run("Testing certifier-based iteration", func(t *testing.T) {
 		app := simapp.Setup(false)
@@ -131,19 +339,111 @@ func Test_CertificateQueries(t *testing.T) {
 		certs := app.CertKeeper.GetCertificatesByContent(ctx, contentToFind)
 		require.Equal(t, count1, len(certs))
 
-		queryParams := types.NewQueryCertificatesParams(1, 100, nil, "sourcecodehash", content1)
+		queryParams := types.NewQueryCertificatesParams(1, 100, nil, "sourcecodehash", content1, "", 0, 0, 0)
 		_, certs_filtered, err := app.CertKeeper.GetCertificatesFiltered(ctx, queryParams)
 		require.NoError(t, err)
 		reflect.DeepEqual(certs, certs_filtered)
 
 		// Queries by certifier
-		queryParams = types.NewQueryCertificatesParams(1, 100, addrs[0], "", "")
+		queryParams = types.NewQueryCertificatesParams(1, 100, addrs[0], "", "", "", 0, 0, 0)
 		_, certs, err = app.CertKeeper.GetCertificatesFiltered(ctx, queryParams)
 		require.NoError(t, err)
 		require.Equal(t, count2, len(certs))
 	})
 }
 
+func Test_CertificateIndexQueries(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+	for _, addr := range addrs {
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addr, "", addr, ""))
+	}
+
+	// Certificates 1 to 10 are issued at heights 1 to 10, alternating between
+	// the two certifiers and between compilation and auditing certificates.
+	var ids []uint64
+	for i := 0; i < 10; i++ {
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		certType := types.CertificateTypeCompilation
+		if i%2 == 1 {
+			certType = types.CertificateTypeAuditing
+		}
+		cert := types.NewCompilationCertificate(certType, fmt.Sprintf("content%d", i), "compiler1", "bytecodehash1", "", addrs[i%2])
+		id, err := app.CertKeeper.IssueCertificate(ctx, cert)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	query := func(params types.QueryCertificatesParams) (uint64, []uint64) {
+		total, certs, err := app.CertKeeper.GetCertificatesFiltered(ctx, params)
+		require.NoError(t, err)
+		var res []uint64
+		for _, cert := range certs {
+			res = append(res, cert.ID())
+		}
+		return total, res
+	}
+
+	// All certificates, newest first.
+	total, res := query(types.NewQueryCertificatesParams(1, 3, nil, "", "", "", 0, 0, 0))
+	require.Equal(t, uint64(10), total)
+	require.Equal(t, []uint64{ids[9], ids[8], ids[7]}, res)
+
+	total, res = query(types.NewQueryCertificatesParams(4, 3, nil, "", "", "", 0, 0, 0))
+	require.Equal(t, uint64(10), total)
+	require.Equal(t, []uint64{ids[0]}, res)
+
+	// Cursor-based pagination walks through the same certificates.
+	total, res = query(types.NewQueryCertificatesParams(0, 3, nil, "", "", "", 0, 0, ids[7]))
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, []uint64{ids[6], ids[5], ids[4]}, res)
+
+	// Combined certificate type, certifier and height range filters.
+	_, res = query(types.NewQueryCertificatesParams(1, 100, nil, "", "", "auditing", 0, 0, 0))
+	require.Equal(t, []uint64{ids[9], ids[7], ids[5], ids[3], ids[1]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(1, 100, addrs[0], "", "", "compilation", 3, 7, 0))
+	require.Equal(t, []uint64{ids[6], ids[4], ids[2]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(1, 100, nil, "", "", "", 9, 0, 0))
+	require.Equal(t, []uint64{ids[9], ids[8]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(0, 2, addrs[1], "", "", "", 0, 0, ids[5]))
+	require.Equal(t, []uint64{ids[3], ids[1]}, res)
+
+	// Certifier and request content filters.
+	_, res = query(types.NewQueryCertificatesParams(1, 100, addrs[0], "sourcecodehash", "content4", "", 0, 0, 0))
+	require.Equal(t, []uint64{ids[4]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(1, 100, addrs[1], "sourcecodehash", "content4", "", 0, 0, 0))
+	require.Empty(t, res)
+
+	// Revoked certificates are removed from the indexes.
+	cert, err := app.CertKeeper.GetCertificateByID(ctx, ids[9])
+	require.NoError(t, err)
+	require.NoError(t, app.CertKeeper.RevokeCertificate(ctx, cert, addrs[0], types.RevocationReasonUnspecified, ""))
+	_, res = query(types.NewQueryCertificatesParams(1, 100, addrs[1], "", "", "auditing", 9, 10, 0))
+	require.Empty(t, res)
+
+	// A revoked certificate can still serve as a cursor, and the cursor
+	// combines with the height range.
+	_, res = query(types.NewQueryCertificatesParams(0, 2, nil, "", "", "", 0, 0, ids[9]))
+	require.Equal(t, []uint64{ids[8], ids[7]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(0, 100, nil, "", "", "", 3, 5, ids[8]))
+	require.Equal(t, []uint64{ids[4], ids[3], ids[2]}, res)
+
+	_, res = query(types.NewQueryCertificatesParams(0, 100, nil, "", "", "", 6, 0, ids[4]))
+	require.Empty(t, res)
+
+	_, _, err = app.CertKeeper.GetCertificatesFiltered(ctx, types.NewQueryCertificatesParams(0, 100, nil, "", "", "", 0, 0, 100))
+	require.Equal(t, types.ErrCertificateNotExists, err)
+
+	_, _, err = app.CertKeeper.GetCertificatesFiltered(ctx, types.NewQueryCertificatesParams(1, 100, nil, "", "", "", 5, 3, 0))
+	require.Equal(t, types.ErrInvalidHeightRange, err)
+}
+
 func Test_IsCertified(t *testing.T) {
 	t.Run("Testing the function IsCertified", func(t *testing.T) {
 		app := simapp.Setup(false)
@@ -169,3 +469,350 @@ func Test_IsCertified(t *testing.T) {
 		require.Equal(t, true, isCertified)
 	})
 }
+
+func Test_ExpireAndRenewCertificate(t *testing.T) {
+	t.Run("Testing certificate expiry and renewal", func(t *testing.T) {
+		app := simapp.Setup(false)
+		now := time.Now().UTC()
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+		addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000))
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+
+		certType := "auditing"
+		contentTypeStr := "address"
+		contentStr := "certik1k4gj07sgy6x3k6ms31aztgu9aajjkaw3ktsydag"
+
+		cert, err := types.NewGeneralCertificate(certType, contentTypeStr, contentStr,
+			"Audited by CertiK", addrs[0])
+		require.NoError(t, err)
+		cert.SetValidUntil(now.Add(-time.Hour))
+		_, err = app.CertKeeper.IssueCertificate(ctx, cert)
+		require.Equal(t, types.ErrInvalidValidUntil, err)
+
+		cert.SetValidUntil(now.Add(time.Hour))
+		id, err := app.CertKeeper.IssueCertificate(ctx, cert)
+		require.NoError(t, err)
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+		require.True(t, app.CertKeeper.IsContentCertified(ctx, contentStr))
+
+		// The certificate stays valid until its validity period ends.
+		ctx = ctx.WithBlockTime(now.Add(time.Minute))
+		app.CertKeeper.ExpireCertificates(ctx)
+		stored, err := app.CertKeeper.GetCertificateByID(ctx, id)
+		require.NoError(t, err)
+		require.False(t, stored.Expired())
+
+		ctx = ctx.WithBlockTime(now.Add(time.Hour))
+		require.False(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+		app.CertKeeper.ExpireCertificates(ctx)
+		stored, err = app.CertKeeper.GetCertificateByID(ctx, id)
+		require.NoError(t, err)
+		require.True(t, stored.Expired())
+		require.False(t, app.CertKeeper.IsContentCertified(ctx, contentStr))
+
+		// Expired certificates must be renewed rather than issued again.
+		_, err = app.CertKeeper.IssueCertificate(ctx, cert)
+		require.Equal(t, types.ErrDuplicateCertificate, err)
+
+		err = app.CertKeeper.RenewCertificate(ctx, stored, addrs[0], now.Add(2*time.Hour))
+		require.NoError(t, err)
+		stored, err = app.CertKeeper.GetCertificateByID(ctx, id)
+		require.NoError(t, err)
+		require.False(t, stored.Expired())
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+
+		// Renewing permanently removes the certificate from the expiration queue.
+		err = app.CertKeeper.RenewCertificate(ctx, stored, addrs[0], time.Time{})
+		require.NoError(t, err)
+		ctx = ctx.WithBlockTime(now.Add(3 * time.Hour))
+		app.CertKeeper.ExpireCertificates(ctx)
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+		require.Empty(t, app.CertKeeper.GetCertificateExpirationQueueTimeSlice(ctx, now.Add(2*time.Hour)))
+	})
+}
+
+func Test_StructuredCertificates(t *testing.T) {
+	t.Run("Testing auditing, proof and oracle operator certificates", func(t *testing.T) {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+		addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(10000))
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+
+		contract := "certik1k4gj07sgy6x3k6ms31aztgu9aajjkaw3ktsydag"
+		auditing, err := types.NewAuditingCertificate("address", contract,
+			types.NewAuditingCertificateContent("reporthash", "token", types.NewSeverityCounts(0, 1, 2, 3, 4), "CertiK"),
+			"Audited by CertiK", addrs[0])
+		require.NoError(t, err)
+		auditingID, err := app.CertKeeper.IssueCertificate(ctx, auditing)
+		require.NoError(t, err)
+
+		proof, err := types.NewProofCertificate("address", contract,
+			types.NewProofCertificateContent("spechash", "prover", []string{"no-overflow", "no-reentrancy"}),
+			"Verified by CertiK", addrs[0])
+		require.NoError(t, err)
+		proofID, err := app.CertKeeper.IssueCertificate(ctx, proof)
+		require.NoError(t, err)
+
+		operator := types.NewOracleOperatorCertificate(addrs[1],
+			types.NewOracleOperatorCertificateContent("operator", []string{"https://example.com"}), "", addrs[0])
+		_, err = app.CertKeeper.IssueCertificate(ctx, operator)
+		require.NoError(t, err)
+		require.True(t, app.CertKeeper.IsCertified(ctx, "address", addrs[1].String(), "oracleoperator"))
+
+		stored, err := app.CertKeeper.GetCertificateByID(ctx, auditingID)
+		require.NoError(t, err)
+		storedAuditing, ok := stored.(*types.AuditingCertificate)
+		require.True(t, ok)
+		require.Equal(t, uint64(1), storedAuditing.CertContent.SeverityCounts.Major)
+		require.Contains(t, stored.FormattedCertificateContent(), types.NewKVPair("minor", "3"))
+
+		stored, err = app.CertKeeper.GetCertificateByID(ctx, proofID)
+		require.NoError(t, err)
+		require.Contains(t, stored.FormattedCertificateContent(), types.NewKVPair("properties", "no-overflow,no-reentrancy"))
+
+		// Typed and general certificates share the same content index.
+		general, err := types.NewGeneralCertificate("auditing", "address", contract, "", addrs[0])
+		require.NoError(t, err)
+		_, err = app.CertKeeper.IssueCertificate(ctx, general)
+		require.Equal(t, types.ErrDuplicateCertificate, err)
+	})
+}
+
+func Test_PublishAndInvalidateLibrary(t *testing.T) {
+	t.Run("Testing publishing and invalidating a certificate library", func(t *testing.T) {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+		addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(10000))
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[1], "", addrs[0], ""))
+		library := addrs[3]
+
+		require.Equal(t, types.ErrUnqualifiedCertifier, app.CertKeeper.PublishLibrary(ctx, library, addrs[2]))
+		require.NoError(t, app.CertKeeper.PublishLibrary(ctx, library, addrs[0]))
+		require.Equal(t, types.ErrLibraryAlreadyExists, app.CertKeeper.PublishLibrary(ctx, library, addrs[1]))
+
+		stored, err := app.CertKeeper.GetLibrary(ctx, library)
+		require.NoError(t, err)
+		require.Equal(t, addrs[0], stored.Publisher)
+		require.Len(t, app.CertKeeper.GetAllLibraries(ctx), 1)
+
+		// Only the publisher can invalidate a library while it is still a certifier.
+		require.Equal(t, types.ErrUnqualifiedCertifier, app.CertKeeper.InvalidateLibrary(ctx, library, addrs[1]))
+		require.NoError(t, app.CertKeeper.InvalidateLibrary(ctx, library, addrs[0]))
+		require.False(t, app.CertKeeper.IsLibrary(ctx, library))
+		_, err = app.CertKeeper.GetLibrary(ctx, library)
+		require.Equal(t, types.ErrLibraryNotExists, err)
+	})
+}
+
+func Test_QuorumCertificate(t *testing.T) {
+	t.Run("Testing quorum certificate signing and failure", func(t *testing.T) {
+		app := simapp.Setup(false)
+		now := time.Now().UTC()
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+		addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(10000))
+		for _, addr := range addrs[:3] {
+			app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addr, "", addr, ""))
+		}
+		numCertifiers := uint64(len(app.CertKeeper.GetAllCertifiers(ctx)))
+
+		certType := "auditing"
+		contentTypeStr := "address"
+		contentStr := "certik1k4gj07sgy6x3k6ms31aztgu9aajjkaw3ktsydag"
+
+		cert, err := types.NewQuorumCertificate(certType, contentTypeStr, contentStr,
+			"Audited by the security council", addrs[0], numCertifiers+1, now.Add(time.Hour))
+		require.NoError(t, err)
+		_, err = app.CertKeeper.ProposeQuorumCertificate(ctx, cert)
+		require.Equal(t, types.ErrInvalidThreshold, err)
+
+		cert.Threshold = 2
+		id, err := app.CertKeeper.ProposeQuorumCertificate(ctx, cert)
+		require.NoError(t, err)
+		require.False(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+		require.False(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contentStr, certType))
+
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[0])
+		require.Equal(t, types.ErrAlreadySigned, err)
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[3])
+		require.Equal(t, types.ErrUnqualifiedCertifier, err)
+
+		reached, err := app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[1])
+		require.NoError(t, err)
+		require.True(t, reached)
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contentStr, certType))
+		require.True(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contentStr, certType))
+		require.Empty(t, app.CertKeeper.GetQuorumDeadlineQueueTimeSlice(ctx, now.Add(time.Hour)))
+
+		// A reached quorum certificate survives its signing deadline.
+		ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+		app.CertKeeper.FailQuorumCertificates(ctx)
+		require.True(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contentStr, certType))
+
+		// Non-quorum certificates do not satisfy IsQuorumCertified.
+		otherContent := "certik1yrq26ly4c3tmg7gayu37s4x7rvq6vvgq3ezqwp"
+		general, err := types.NewGeneralCertificate(certType, contentTypeStr, otherContent, "", addrs[0])
+		require.NoError(t, err)
+		generalID, err := app.CertKeeper.IssueCertificate(ctx, general)
+		require.NoError(t, err)
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, otherContent, certType))
+		require.False(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, otherContent, certType))
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, generalID, addrs[1])
+		require.Equal(t, types.ErrNotQuorumCertificate, err)
+
+		// A quorum certificate that misses its deadline is removed.
+		pendingContent := "certik1mzk5kfkssq7mnrm2sttl3umh9dqwnkg7tr6sqk"
+		pending, err := types.NewQuorumCertificate(certType, contentTypeStr, pendingContent, "", addrs[0], 3, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		pendingID, err := app.CertKeeper.ProposeQuorumCertificate(ctx, pending)
+		require.NoError(t, err)
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, pendingID, addrs[1])
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, pendingID, addrs[2])
+		require.Equal(t, types.ErrQuorumWindowClosed, err)
+		app.CertKeeper.FailQuorumCertificates(ctx)
+		_, err = app.CertKeeper.GetCertificateByID(ctx, pendingID)
+		require.Error(t, err)
+		require.False(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, pendingContent, certType))
+
+		// The request content is free to be certified again.
+		pending, err = types.NewQuorumCertificate(certType, contentTypeStr, pendingContent, "", addrs[0], 1, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		_, err = app.CertKeeper.ProposeQuorumCertificate(ctx, pending)
+		require.NoError(t, err)
+		require.True(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, pendingContent, certType))
+
+		// Pending quorum certificates do not occupy their request content.
+		contestedContent := "certik1tq8msv3dc4k8tz5f5hjn6k7xf8wc8hcfn9w4ly"
+		contested, err := types.NewQuorumCertificate(certType, contentTypeStr, contestedContent, "", addrs[0], 2, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		contestedID, err := app.CertKeeper.ProposeQuorumCertificate(ctx, contested)
+		require.NoError(t, err)
+		general, err = types.NewGeneralCertificate(certType, contentTypeStr, contestedContent, "", addrs[2])
+		require.NoError(t, err)
+		_, err = app.CertKeeper.IssueCertificate(ctx, general)
+		require.NoError(t, err)
+		_, err = app.CertKeeper.SignQuorumCertificate(ctx, contestedID, addrs[1])
+		require.Equal(t, types.ErrDuplicateCertificate, err)
+		require.True(t, app.CertKeeper.IsCertified(ctx, contentTypeStr, contestedContent, certType))
+		require.False(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contestedContent, certType))
+	})
+
+	t.Run("Testing quorum signatures of removed certifiers", func(t *testing.T) {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+		addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(10000))
+		for _, addr := range addrs {
+			app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addr, "", addr, ""))
+		}
+
+		certType := "auditing"
+		contentTypeStr := "address"
+		contentStr := "certik1k4gj07sgy6x3k6ms31aztgu9aajjkaw3ktsydag"
+		cert, err := types.NewQuorumCertificate(certType, contentTypeStr, contentStr, "", addrs[0], 3, ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		id, err := app.CertKeeper.ProposeQuorumCertificate(ctx, cert)
+		require.NoError(t, err)
+		reached, err := app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[1])
+		require.NoError(t, err)
+		require.False(t, reached)
+
+		// The signature of a removed certifier no longer counts towards the quorum.
+		proposal := types.NewCertifierUpdateProposal("title", "description", addrs[1], "", addrs[0], types.Remove)
+		require.NoError(t, keeper.HandleCertifierUpdateProposal(ctx, app.CertKeeper, proposal))
+		reached, err = app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[2])
+		require.NoError(t, err)
+		require.False(t, reached)
+		require.False(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contentStr, certType))
+
+		reached, err = app.CertKeeper.SignQuorumCertificate(ctx, id, addrs[3])
+		require.NoError(t, err)
+		require.True(t, reached)
+		require.True(t, app.CertKeeper.IsQuorumCertified(ctx, contentTypeStr, contentStr, certType))
+	})
+}
+
+func Test_CertifierBondAndSlash(t *testing.T) {
+	t.Run("Testing certifier bond, reputation and slashing", func(t *testing.T) {
+		app := simapp.Setup(false)
+		ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+		addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(10000))
+		app.CertKeeper.SetCertifier(ctx, types.NewCertifier(addrs[0], "", addrs[0], ""))
+
+		denom := app.StakingKeeper.BondDenom(ctx)
+		bond := sdk.NewCoins(sdk.NewInt64Coin(denom, 4000))
+		app.CertKeeper.SetCertifierParams(ctx, types.NewCertifierParams(bond))
+		handler := cert.NewHandler(app.CertKeeper)
+
+		// Only certifiers can deposit a bond.
+		_, err := handler(ctx, types.NewMsgDepositCertifierBond(addrs[1], bond))
+		require.Error(t, err)
+
+		// Accepting a certifier does not take a bond from its account.
+		proposal := types.NewCertifierUpdateProposal("title", "description", addrs[1], "", addrs[0], types.Add)
+		require.NoError(t, keeper.HandleCertifierUpdateProposal(ctx, app.CertKeeper, proposal))
+		certifier, err := app.CertKeeper.GetCertifier(ctx, addrs[1])
+		require.NoError(t, err)
+		require.True(t, certifier.Bond.IsZero())
+		require.Equal(t, sdk.NewInt(10000), app.AccountKeeper.GetAccount(ctx, addrs[1]).GetCoins().AmountOf(denom))
+
+		// The first deposit must reach the minimum bond, and later deposits top it up.
+		_, err = handler(ctx, types.NewMsgDepositCertifierBond(addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 3000))))
+		require.Error(t, err)
+		_, err = handler(ctx, types.NewMsgDepositCertifierBond(addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 20000))))
+		require.Error(t, err)
+		_, err = handler(ctx, types.NewMsgDepositCertifierBond(addrs[1], bond))
+		require.NoError(t, err)
+		_, err = handler(ctx, types.NewMsgDepositCertifierBond(addrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+		require.NoError(t, err)
+		certifier, err = app.CertKeeper.GetCertifier(ctx, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 5000)), certifier.Bond)
+		require.Equal(t, sdk.NewInt(5000), app.AccountKeeper.GetAccount(ctx, addrs[1]).GetCoins().AmountOf(denom))
+
+		// Revoked certificates count against the issuing certifier.
+		contentTypeStr := "address"
+		for i := 0; i < 3; i++ {
+			cert, err := types.NewGeneralCertificate("auditing", contentTypeStr, sdk.AccAddress(randomString(20)).String(), "", addrs[1])
+			require.NoError(t, err)
+			_, err = app.CertKeeper.IssueCertificate(ctx, cert)
+			require.NoError(t, err)
+		}
+		certificates := app.CertKeeper.GetCertificatesByCertifier(ctx, addrs[1])
+		require.NoError(t, app.CertKeeper.RevokeCertificate(ctx, certificates[0], addrs[0], types.RevocationReasonUnspecified, ""))
+		reputation, err := app.CertKeeper.GetCertifierReputation(ctx, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), reputation.Issued)
+		require.Equal(t, uint64(1), reputation.Revoked)
+		require.Equal(t, int64(1), reputation.Score)
+
+		// Slashing burns at most the bonded amount.
+		supplyBefore := app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+		slashProposal := types.NewCertifierSlashProposal("title", "description", addrs[0], addrs[1],
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 1500)), 0)
+		require.NoError(t, keeper.HandleCertifierSlashProposal(ctx, app.CertKeeper, slashProposal))
+		certifier, err = app.CertKeeper.GetCertifier(ctx, addrs[1])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 3500)), certifier.Bond)
+		require.Equal(t, supplyBefore.SubRaw(1500), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom))
+
+		slashProposal.Amount = sdk.NewCoins(sdk.NewInt64Coin(denom, 1000000))
+		require.NoError(t, keeper.HandleCertifierSlashProposal(ctx, app.CertKeeper, slashProposal))
+		certifier, err = app.CertKeeper.GetCertifier(ctx, addrs[1])
+		require.NoError(t, err)
+		require.True(t, certifier.Bond.IsZero())
+		require.Equal(t, types.ErrInvalidSlashAmount, keeper.HandleCertifierSlashProposal(ctx, app.CertKeeper, slashProposal))
+
+		// Removing a certifier returns its remaining bond.
+		require.NoError(t, keeper.HandleCertifierUpdateProposal(ctx, app.CertKeeper,
+			types.NewCertifierUpdateProposal("title", "description", addrs[2], "", addrs[0], types.Add)))
+		_, err = handler(ctx, types.NewMsgDepositCertifierBond(addrs[2], bond))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt(6000), app.AccountKeeper.GetAccount(ctx, addrs[2]).GetCoins().AmountOf(denom))
+		require.NoError(t, keeper.HandleCertifierUpdateProposal(ctx, app.CertKeeper,
+			types.NewCertifierUpdateProposal("title", "description", addrs[2], "", addrs[0], types.Remove)))
+		require.Equal(t, sdk.NewInt(10000), app.AccountKeeper.GetAccount(ctx, addrs[2]).GetCoins().AmountOf(denom))
+	})
+}
@@ -56,12 +56,24 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idB)
 		return fmt.Sprintf("%v\n%v", idA, idB)
 
-	case bytes.Equal(kvA.Key[:1], types.CertifierCertIDsStoreKeyPrefix):
+	case bytes.Equal(kvA.Key[:1], types.CertificateExpirationQueueKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.QuorumDeadlineQueueKeyPrefix):
 		var idsA, idsB []uint64
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &idsA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &idsB)
 		return fmt.Sprintf("%v\n%v", idsA, idsB)
 
+	case bytes.Equal(kvA.Key[:1], types.CertificateTypeIndexKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.CertifierCertIDsStoreKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.IssuedHeightIndexKeyPrefix):
+		return fmt.Sprintf("%v\n%v", types.CertificateIDFromIndexKey(kvA.Key), types.CertificateIDFromIndexKey(kvB.Key))
+
+	case bytes.Equal(kvA.Key[:1], types.RevocationsStoreKey()):
+		var revocationA, revocationB types.Revocation
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &revocationA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &revocationB)
+		return fmt.Sprintf("%v\n%v", revocationA, revocationB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -44,6 +44,8 @@ func TestDecodeStore(t *testing.T) {
 	platform := types.Platform{
 		Validator:   RandomAccount().PubKey,
 		Description: "This is a test case.",
+		Certifier:   certifier.Address,
+		Attestation: types.NewPlatformAttestation(types.HardwareTypeTEE, "linux", "", time.Time{}),
 	}
 
 	library := types.Library{
@@ -58,12 +60,24 @@ func TestDecodeStore(t *testing.T) {
 		Description: "this is a test case.",
 	}
 
+	certificate := types.NewCompilationCertificate(types.CertificateTypeCompilation, "sourcecodehash", "compiler", "bytecodehash", "", certifier.Address)
+	certificate.SetCertificateID(3)
+	revocation := types.NewRevocation(certificate, certifier.Address, types.RevocationReasonSuperseded, "", 10, time.Now().UTC())
+
+	expiringIDs := []uint64{1, 2}
+	validUntil := time.Now().UTC()
+
 	KVPairs := kv.Pairs{
 		kv.Pair{Key: types.CertifierStoreKey(certifier.Address), Value: cdc.MustMarshalBinaryLengthPrefixed(&certifier)},
 		kv.Pair{Key: types.ValidatorStoreKey(validator.PubKey), Value: cdc.MustMarshalBinaryLengthPrefixed(&validator)},
 		kv.Pair{Key: types.PlatformStoreKey(platform.Validator), Value: cdc.MustMarshalBinaryLengthPrefixed(&platform)},
 		kv.Pair{Key: types.LibraryStoreKey(library.Address), Value: cdc.MustMarshalBinaryLengthPrefixed(&library)},
 		kv.Pair{Key: types.CertifierAliasStoreKey(aliasCertifier.Alias), Value: cdc.MustMarshalBinaryLengthPrefixed(&aliasCertifier)},
+		kv.Pair{Key: types.CertificateExpirationQueueKey(validUntil), Value: cdc.MustMarshalBinaryLengthPrefixed(expiringIDs)},
+		kv.Pair{Key: types.QuorumDeadlineQueueKey(validUntil), Value: cdc.MustMarshalBinaryLengthPrefixed(expiringIDs)},
+		kv.Pair{Key: types.CertifierIndexKey(certifier.Address, 3), Value: []byte{}},
+		kv.Pair{Key: types.RevocationStoreKey(3), Value: cdc.MustMarshalBinaryLengthPrefixed(revocation)},
+		kv.Pair{Key: []byte{0x30}, Value: []byte{0x30}},
 	}
 
 	tests := []struct {
@@ -75,6 +89,10 @@ func TestDecodeStore(t *testing.T) {
 		{"Platform", fmt.Sprintf("%v\n%v", platform, platform)},
 		{"Library", fmt.Sprintf("%v\n%v", library, library)},
 		{"Alias certifier", fmt.Sprintf("%v\n%v", aliasCertifier, aliasCertifier)},
+		{"Expiration queue", fmt.Sprintf("%v\n%v", expiringIDs, expiringIDs)},
+		{"Quorum deadline queue", fmt.Sprintf("%v\n%v", expiringIDs, expiringIDs)},
+		{"Certifier index", fmt.Sprintf("%v\n%v", 3, 3)},
+		{"Revocation", fmt.Sprintf("%v\n%v", revocation, revocation)},
 		{"other", ""},
 	}
 
@@ -1,7 +1,9 @@
 package simulation
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
 )
@@ -17,5 +19,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		}
 	}
 
+	minBond := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(simState.Rand, 1, 1e3))))
+	gs.CertifierParams = types.NewCertifierParams(minBond)
+
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
 }
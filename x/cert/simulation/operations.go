@@ -1,7 +1,10 @@
 package simulation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,15 +18,21 @@ import (
 )
 
 const (
-	OpWeightMsgCertifyValidator = "op_weight_msg_certify_validator"
-	OpWeightMsgCertifyPlatform  = "op_weight_msg_certify_platform"
-	OpWeightMsgCertifyAuditing  = "op_weight_msg_certify_auditing"
-	OpWeightMsgCertifyProof     = "op_weight_msg_certify_proof"
+	OpWeightMsgCertifyValidator  = "op_weight_msg_certify_validator"
+	OpWeightMsgCertifyPlatform   = "op_weight_msg_certify_platform"
+	OpWeightMsgCertifyAuditing   = "op_weight_msg_certify_auditing"
+	OpWeightMsgCertifyProof      = "op_weight_msg_certify_proof"
+	OpWeightMsgPublishLibrary    = "op_weight_msg_publish_library"
+	OpWeightMsgProposeQuorum     = "op_weight_msg_propose_quorum_certificate"
+	OpWeightMsgRevokeCertificate = "op_weight_msg_revoke_certificate"
+	OpWeightMsgDepositBond       = "op_weight_msg_deposit_certifier_bond"
 )
 
 // Default simulation operation weights for messages.
 const (
-	DefaultWeightMsgCertify int = 20
+	DefaultWeightMsgCertify           int = 20
+	DefaultWeightMsgRevokeCertificate int = 5
+	DefaultWeightMsgDepositBond       int = 5
 )
 
 // WeightedOperations creates an operation (with weight) for each type of message generators.
@@ -59,15 +68,52 @@ func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, ak typ
 			weightMsgCertifyIdentity = DefaultWeightMsgCertify
 		})
 
+	var weightMsgPublishLibrary int
+	appParams.GetOrGenerate(cdc, OpWeightMsgPublishLibrary, &weightMsgPublishLibrary, nil,
+		func(_ *rand.Rand) {
+			weightMsgPublishLibrary = simappparams.DefaultWeightMsgSend
+		})
+
+	var weightMsgProposeQuorum int
+	appParams.GetOrGenerate(cdc, OpWeightMsgProposeQuorum, &weightMsgProposeQuorum, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeQuorum = simappparams.DefaultWeightMsgSend
+		})
+
+	var weightMsgRevokeCertificate int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeCertificate, &weightMsgRevokeCertificate, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeCertificate = DefaultWeightMsgRevokeCertificate
+		})
+
+	var weightMsgDepositBond int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDepositBond, &weightMsgDepositBond, nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositBond = DefaultWeightMsgDepositBond
+		})
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(weightMsgCertifyValidator, SimulateMsgCertifyValidator(ak, k)),
 		simulation.NewWeightedOperation(weightMsgCertifyPlatform, SimulateMsgCertifyPlatform(ak, k)),
 		simulation.NewWeightedOperation(weightMsgCertifyAuditing, SimulateMsgCertifyAuditing(ak, k)),
 		simulation.NewWeightedOperation(weightMsgCertifyProof, SimulateMsgCertifyProof(ak, k)),
 		simulation.NewWeightedOperation(weightMsgCertifyIdentity, SimulateMsgCertifyIdentity(ak, k)),
+		simulation.NewWeightedOperation(weightMsgPublishLibrary, SimulateMsgPublishLibrary(ak, k)),
+		simulation.NewWeightedOperation(weightMsgProposeQuorum, SimulateMsgProposeQuorumCertificate(ak, k)),
+		simulation.NewWeightedOperation(weightMsgRevokeCertificate, SimulateMsgRevokeCertificate(ak, k)),
+		simulation.NewWeightedOperation(weightMsgDepositBond, SimulateMsgDepositCertifierBond(ak, k)),
 	}
 }
 
+// randomValidUntil returns either a zero time for a permanent certificate
+// or a random time up to 30 days after the current block time.
+func randomValidUntil(r *rand.Rand, ctx sdk.Context) time.Time {
+	if r.Intn(2) == 0 {
+		return time.Time{}
+	}
+	return ctx.BlockTime().Add(time.Duration(simulation.RandIntBetween(r, 1, 30*24)) * time.Hour)
+}
+
 // SimulateMsgCertifyValidator generates a MsgCertifyValidator object which fields contain
 // a randomly chosen existing certifier and randomized validator's PubKey.
 func SimulateMsgCertifyValidator(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -111,7 +157,8 @@ func SimulateMsgCertifyValidator(ak types.AccountKeeper, k keeper.Keeper) simula
 }
 
 // SimulateMsgCertifyPlatform generates a MsgCertifyPlatform object which fields contain
-// a randomly chosen existing certifier, a randomized validator's PubKey and a random string description.
+// a randomly chosen existing certifier, a randomized validator's PubKey, a random string description
+// and a random platform attestation.
 func SimulateMsgCertifyPlatform(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		simulation.OperationMsg, []simulation.FutureOperation, error) {
@@ -126,8 +173,12 @@ func SimulateMsgCertifyPlatform(ak types.AccountKeeper, k keeper.Keeper) simulat
 		}
 		validator := simulation.RandomAccounts(r, 1)[0]
 		platform := simulation.RandStringOfLength(r, 10)
+		hardwareType := types.HardwareType(simulation.RandIntBetween(r, int(types.HardwareTypeVirtualMachine), int(types.HardwareTypeTEE)+1))
+		quoteHash := make([]byte, sha256.Size)
+		r.Read(quoteHash)
 
-		msg := types.NewMsgCertifyPlatform(certifier.Address, validator.PubKey, platform)
+		msg := types.NewMsgCertifyPlatform(certifier.Address, validator.PubKey, platform, hardwareType.String(),
+			simulation.RandStringOfLength(r, 10), hex.EncodeToString(quoteHash), randomValidUntil(r, ctx))
 
 		account := ak.GetAccount(ctx, certifier.Address)
 		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
@@ -170,7 +221,11 @@ func SimulateMsgCertifyAuditing(ak types.AccountKeeper, k keeper.Keeper) simulat
 		contract := simulation.RandomAccounts(r, 1)[0]
 		description := simulation.RandStringOfLength(r, 10)
 
-		msg := types.NewMsgCertifyGeneral("auditing", "address", contract.Address.String(), description, certifier.Address)
+		severityCounts := types.NewSeverityCounts(uint64(r.Intn(3)), uint64(r.Intn(5)), uint64(r.Intn(10)),
+			uint64(r.Intn(10)), uint64(r.Intn(20)))
+		msg := types.NewMsgCertifyAuditing("address", contract.Address.String(), simulation.RandStringOfLength(r, 64),
+			simulation.RandStringOfLength(r, 10), severityCounts, simulation.RandStringOfLength(r, 10), description,
+			certifier.Address, randomValidUntil(r, ctx))
 		if k.IsCertified(ctx, "address", contract.Address.String(), "auditing") {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -199,6 +254,62 @@ func SimulateMsgCertifyAuditing(ak types.AccountKeeper, k keeper.Keeper) simulat
 	}
 }
 
+// SimulateMsgRevokeCertificate generates a MsgRevokeCertificate object which fields contain
+// a randomly chosen auditing or proof certificate, a randomly chosen certifier and a random reason.
+func SimulateMsgRevokeCertificate(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account,
+		chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		// Other certificate types are relied upon by the simulations of other modules.
+		var certificates []types.Certificate
+		k.IterateAllCertificate(ctx, func(certificate types.Certificate) bool {
+			if certificate.Type() == types.CertificateTypeAuditing || certificate.Type() == types.CertificateTypeProof {
+				certificates = append(certificates, certificate)
+			}
+			return false
+		})
+		if len(certificates) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		certificate := certificates[r.Intn(len(certificates))]
+
+		certifiers := k.GetAllCertifiers(ctx)
+		certifier := certifiers[r.Intn(len(certifiers))]
+		var certifierAcc simulation.Account
+		for _, acc := range accs {
+			if acc.Address.Equals(certifier.Address) {
+				certifierAcc = acc
+				break
+			}
+		}
+
+		reason := types.RevocationReason(r.Intn(int(types.RevocationReasonPrivilegeWithdrawn) + 1))
+		msg := types.NewMsgRevokeCertificate(certifier.Address, certificate.ID(), reason.String(),
+			simulation.RandStringOfLength(r, 10))
+
+		account := ak.GetAccount(ctx, certifier.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			certifierAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgCertifyProof generates a MsgCertifyProof object which fields contain
 // a randomly chosen existing certifer, a random contract and a random string description.
 func SimulateMsgCertifyProof(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -216,7 +327,12 @@ func SimulateMsgCertifyProof(ak types.AccountKeeper, k keeper.Keeper) simulation
 		contract := simulation.RandomAccounts(r, 1)[0]
 		description := simulation.RandStringOfLength(r, 10)
 
-		msg := types.NewMsgCertifyGeneral("proof", "address", contract.Address.String(), description, certifier.Address)
+		properties := make([]string, simulation.RandIntBetween(r, 1, 4))
+		for i := range properties {
+			properties[i] = simulation.RandStringOfLength(r, 10)
+		}
+		msg := types.NewMsgCertifyProof("address", contract.Address.String(), simulation.RandStringOfLength(r, 64),
+			simulation.RandStringOfLength(r, 10), properties, description, certifier.Address, randomValidUntil(r, ctx))
 		if k.IsCertified(ctx, "address", contract.Address.String(), "proof") {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -245,7 +361,8 @@ func SimulateMsgCertifyProof(ak types.AccountKeeper, k keeper.Keeper) simulation
 	}
 }
 
-// SimulateMsgCertifyIdentity generates a MsgCertifyGeneral object to certify a random account address.
+// SimulateMsgCertifyIdentity generates a MsgCertifyIdentity object to certify a random account address
+// with hashed jurisdiction and entity type claims.
 func SimulateMsgCertifyIdentity(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		simulation.OperationMsg, []simulation.FutureOperation, error) {
@@ -265,7 +382,13 @@ func SimulateMsgCertifyIdentity(ak types.AccountKeeper, k keeper.Keeper) simulat
 		}
 		identityAcc := ak.GetAccount(ctx, delAddr)
 
-		msg := types.NewMsgCertifyGeneral("identity", "address", identityAcc.GetAddress().String(), "", certifier.Address)
+		claims := []types.IdentityClaim{
+			types.NewIdentityClaim(types.IdentityAttributeJurisdiction, types.IdentityClaimHash(
+				types.IdentityAttributeJurisdiction, simulation.RandStringOfLength(r, 2), simulation.RandStringOfLength(r, 16))),
+			types.NewIdentityClaim(types.IdentityAttributeEntityType, types.IdentityClaimHash(
+				types.IdentityAttributeEntityType, simulation.RandStringOfLength(r, 10), simulation.RandStringOfLength(r, 16))),
+		}
+		msg := types.NewMsgCertifyIdentity(identityAcc.GetAddress(), claims, "", certifier.Address, time.Time{})
 		if k.IsCertified(ctx, "address", identityAcc.GetAddress().String(), "identity") {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -293,3 +416,254 @@ func SimulateMsgCertifyIdentity(ak types.AccountKeeper, k keeper.Keeper) simulat
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgPublishLibrary generates a MsgPublishLibrary object which fields contain
+// a randomly chosen existing certifier and a random library address, and schedules
+// the invalidation of the library.
+func SimulateMsgPublishLibrary(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		certifiers := k.GetAllCertifiers(ctx)
+		certifier := certifiers[r.Intn(len(certifiers))]
+		var certifierAcc simulation.Account
+		for _, acc := range accs {
+			if acc.Address.Equals(certifier.Address) {
+				certifierAcc = acc
+				break
+			}
+		}
+		library := simulation.RandomAccounts(r, 1)[0].Address
+
+		msg := types.NewMsgPublishLibrary(certifier.Address, library)
+
+		account := ak.GetAccount(ctx, certifier.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			certifierAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		futureOperations := []simulation.FutureOperation{
+			{
+				BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 1, 20),
+				Op:          SimulateMsgInvalidateLibrary(ak, k, certifierAcc, library),
+			},
+		}
+		return simulation.NewOperationMsg(msg, true, ""), futureOperations, nil
+	}
+}
+
+// SimulateMsgInvalidateLibrary generates a MsgInvalidateLibrary object for a library
+// previously published by the given certifier.
+func SimulateMsgInvalidateLibrary(ak types.AccountKeeper, k keeper.Keeper, certifierAcc simulation.Account,
+	library sdk.AccAddress) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !k.IsLibrary(ctx, library) || !k.IsCertifier(ctx, certifierAcc.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgInvalidateLibrary(certifierAcc.Address, library)
+
+		account := ak.GetAccount(ctx, certifierAcc.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			certifierAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgProposeQuorumCertificate generates a MsgProposeQuorumCertificate object which fields
+// contain a randomly chosen existing certifier and a random threshold, and schedules signatures
+// from the other certifiers.
+func SimulateMsgProposeQuorumCertificate(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		certifiers := k.GetAllCertifiers(ctx)
+		r.Shuffle(len(certifiers), func(i, j int) {
+			certifiers[i], certifiers[j] = certifiers[j], certifiers[i]
+		})
+		certifier := certifiers[0]
+		var certifierAcc simulation.Account
+		for _, acc := range accs {
+			if acc.Address.Equals(certifier.Address) {
+				certifierAcc = acc
+				break
+			}
+		}
+		contract := simulation.RandomAccounts(r, 1)[0]
+		threshold := uint64(simulation.RandIntBetween(r, 1, len(certifiers)+1))
+		window := time.Duration(simulation.RandIntBetween(r, 1, 24)) * time.Hour
+
+		msg := types.NewMsgProposeQuorumCertificate("auditing", "address", contract.Address.String(),
+			simulation.RandStringOfLength(r, 10), certifier.Address, threshold, window, randomValidUntil(r, ctx))
+
+		account := ak.GetAccount(ctx, certifier.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			certifierAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		id := k.GetNextCertificateID(ctx) - 1
+		var futureOperations []simulation.FutureOperation
+		for _, signer := range certifiers[1:] {
+			for _, acc := range accs {
+				if acc.Address.Equals(signer.Address) {
+					futureOperations = append(futureOperations, simulation.FutureOperation{
+						BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 1, 10),
+						Op:          SimulateMsgSignQuorumCertificate(ak, k, acc, id),
+					})
+					break
+				}
+			}
+		}
+		return simulation.NewOperationMsg(msg, true, ""), futureOperations, nil
+	}
+}
+
+// SimulateMsgSignQuorumCertificate generates a MsgSignQuorumCertificate object for a pending
+// quorum certificate signed by the given certifier.
+func SimulateMsgSignQuorumCertificate(ak types.AccountKeeper, k keeper.Keeper, signerAcc simulation.Account,
+	id uint64) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !k.IsCertifier(ctx, signerAcc.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		certificate, err := k.GetCertificateByID(ctx, id)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		quorumCertificate, ok := certificate.(*types.QuorumCertificate)
+		if !ok || quorumCertificate.HasSigned(signerAcc.Address) || !quorumCertificate.Deadline.After(ctx.BlockTime()) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		// The content may have been certified while the quorum certificate was pending.
+		if _, found := k.GetContentCertID(ctx, quorumCertificate.Type(), quorumCertificate.RequestContent()); found && !quorumCertificate.QuorumReached() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSignQuorumCertificate(signerAcc.Address, id)
+
+		account := ak.GetAccount(ctx, signerAcc.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			signerAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgDepositCertifierBond generates a MsgDepositCertifierBond object which tops up
+// the bond of a randomly chosen certifier to at least the minimum bond.
+func SimulateMsgDepositCertifierBond(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		certifiers := k.GetAllCertifiers(ctx)
+		certifier := certifiers[r.Intn(len(certifiers))]
+		var certifierAcc simulation.Account
+		for _, acc := range accs {
+			if acc.Address.Equals(certifier.Address) {
+				certifierAcc = acc
+				break
+			}
+		}
+
+		amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
+		for _, coin := range k.GetCertifierParams(ctx).MinBond {
+			if shortfall := coin.Amount.Sub(certifier.Bond.AmountOf(coin.Denom)); shortfall.IsPositive() {
+				amount = amount.Add(sdk.NewCoin(coin.Denom, shortfall))
+			}
+		}
+
+		account := ak.GetAccount(ctx, certifier.Address)
+		spendable, hasNeg := account.SpendableCoins(ctx.BlockTime()).SafeSub(amount)
+		if hasNeg {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgDepositCertifierBond(certifier.Address, amount)
+
+		fees, err := simulation.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			certifierAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
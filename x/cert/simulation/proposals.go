@@ -12,8 +12,12 @@ import (
 	"github.com/certikfoundation/shentu/x/cert/internal/types"
 )
 
-// OpWeightSubmitCertifierUpdateProposal app params key for certifier update proposal
-const OpWeightSubmitCertifierUpdateProposal = "op_weight_submit_certifier_update_proposal"
+const (
+	// OpWeightSubmitCertifierUpdateProposal app params key for certifier update proposal
+	OpWeightSubmitCertifierUpdateProposal = "op_weight_submit_certifier_update_proposal"
+	// OpWeightSubmitCertifierSlashProposal app params key for certifier slash proposal
+	OpWeightSubmitCertifierSlashProposal = "op_weight_submit_certifier_slash_proposal"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simulation.WeightedProposalContent {
@@ -23,6 +27,11 @@ func ProposalContents(k keeper.Keeper) []simulation.WeightedProposalContent {
 			DefaultWeight:      params.DefaultWeightCertifierUpdateProposal,
 			ContentSimulatorFn: SimulateCertifierUpdateProposalContent(k),
 		},
+		{
+			AppParamsKey:       OpWeightSubmitCertifierSlashProposal,
+			DefaultWeight:      params.DefaultWeightCertifierSlashProposal,
+			ContentSimulatorFn: SimulateCertifierSlashProposalContent(k),
+		},
 	}
 }
 
@@ -62,3 +71,38 @@ func SimulateCertifierUpdateProposalContent(k keeper.Keeper) simulation.ContentS
 		)
 	}
 }
+
+// SimulateCertifierSlashProposalContent generates random certifier slash proposal content
+// for a certifier that has deposited a bond.
+func SimulateCertifierSlashProposalContent(k keeper.Keeper) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		var bonded []types.Certifier
+		for _, certifier := range k.GetAllCertifiers(ctx) {
+			if !certifier.Bond.IsZero() {
+				bonded = append(bonded, certifier)
+			}
+		}
+		if len(bonded) == 0 {
+			return nil
+		}
+		certifier := bonded[r.Intn(len(bonded))]
+
+		var amount sdk.Coins
+		for _, coin := range certifier.Bond {
+			slashed, err := simulation.RandPositiveInt(r, coin.Amount)
+			if err != nil {
+				return nil
+			}
+			amount = amount.Add(sdk.NewCoin(coin.Denom, slashed))
+		}
+
+		return types.NewCertifierSlashProposal(
+			simulation.RandStringOfLength(r, 140),
+			simulation.RandStringOfLength(r, 5000),
+			accs[r.Intn(len(accs))].Address,
+			certifier.Address,
+			amount,
+			0,
+		)
+	}
+}
@@ -6,17 +6,20 @@ import (
 )
 
 const (
-	AttributeTxHash = types.AttributeTxHash
+	AttributeTxHash    = types.AttributeTxHash
+	QueryClaimEvidence = types.QueryClaimEvidence
 )
 
 var (
 	// function aliases
-	NewKeeper           = keeper.NewKeeper
-	ProposalHandler     = types.ProposalHandler
-	DefaultGenesisState = types.DefaultGenesisState
-	ParamKeyTable       = types.ParamKeyTable
+	NewKeeper                 = keeper.NewKeeper
+	ProposalHandler           = types.ProposalHandler
+	DefaultGenesisState       = types.DefaultGenesisState
+	ParamKeyTable             = types.ParamKeyTable
+	NewMsgAcknowledgeEvidence = types.NewMsgAcknowledgeEvidence
 )
 
 type (
-	Keeper = keeper.Keeper
+	Keeper                 = keeper.Keeper
+	MsgAcknowledgeEvidence = types.MsgAcknowledgeEvidence
 )
@@ -43,6 +43,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProposer(queryRoute, cdc),
 		cli.GetCmdQueryDeposit(queryRoute, cdc),
 		GetCmdQueryDeposits(queryRoute, cdc),
+		GetCmdQueryClaimEvidence(queryRoute, cdc),
 		cli.GetCmdQueryTally(queryRoute, cdc))...)
 
 	return govQueryCmd
@@ -301,6 +302,49 @@ $ %[1]s query gov deposits 1
 	}
 }
 
+// GetCmdQueryClaimEvidence implements the query claim evidence command.
+func GetCmdQueryClaimEvidence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-evidence [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query evidence of a shield claim proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the evidence attached to a shield claim proposal and its
+acknowledgements by the pool sponsor and certifiers.
+
+Example:
+$ %[1]s query gov claim-evidence 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s is not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			params := govTypes.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryClaimEvidence), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResClaimEvidence
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -20,6 +20,7 @@ import (
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/certikfoundation/shentu/x/gov/client/utils"
+	"github.com/certikfoundation/shentu/x/gov/internal/types"
 )
 
 // Proposal flags
@@ -74,6 +75,7 @@ $ %[1]s tx gov submit-proposal --title="Test Proposal" --description="My awesome
 	govTxCmd.AddCommand(flags.PostCommands(
 		cli.GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdAcknowledgeEvidence(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -128,3 +130,44 @@ $ %[1]s tx gov vote 1 yes --from mykey
 		},
 	}
 }
+
+// GetCmdAcknowledgeEvidence implements the command for acknowledging the
+// evidence of a shield claim proposal.
+func GetCmdAcknowledgeEvidence(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "acknowledge-evidence [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Acknowledge the evidence of a shield claim proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Acknowledge the evidence attached to a shield claim proposal in
+the certifier voting period. Only the sponsor of the claimed pool and certifiers
+can acknowledge evidence, and a claim cannot pass the certifier voting period
+before its evidence has been acknowledged. You can review the evidence by running
+"%[1]s query gov claim-evidence [proposal-id]".
+
+Example:
+$ %[1]s tx gov acknowledge-evidence 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authUtils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s is not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgAcknowledgeEvidence(proposalID, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authUtils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 
 	"github.com/certikfoundation/shentu/x/gov/internal/types"
@@ -35,6 +36,12 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, phs []govRest.Prop
 	registerTxRoutes(cliCtx, r, phs)
 }
 
+// AcknowledgeEvidenceReq defines the properties of an evidence acknowledgement request's body.
+type AcknowledgeEvidenceReq struct {
+	BaseReq      rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Acknowledger sdk.AccAddress `json:"acknowledger" yaml:"acknowledger"`
+}
+
 type VoteWithPower struct {
 	types.Vote
 	VotingPower sdk.Dec `json:"voting_power" yaml:"voting_power"`
@@ -13,6 +13,8 @@ import (
 	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	govUtils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/certikfoundation/shentu/x/gov/internal/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, phs []govRest.ProposalRESTHandler) {
@@ -24,6 +26,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, phs []govRest.Pr
 	r.HandleFunc("/gov/proposals", proposalHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/deposits", RestProposalID), depositHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), voteHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/acknowledgements", RestProposalID), acknowledgeEvidenceHandlerFn(cliCtx)).Methods("POST")
 }
 
 func proposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -128,3 +131,39 @@ func voteHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func acknowledgeEvidenceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		strProposalID := vars[RestProposalID]
+
+		if strProposalID == "" {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "proposalId is required but not specified")
+			return
+		}
+
+		proposalID, ok := rest.ParseUint64OrReturnBadRequest(w, strProposalID)
+		if !ok {
+			return
+		}
+
+		var req AcknowledgeEvidenceReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgAcknowledgeEvidence(proposalID, req.Acknowledger)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
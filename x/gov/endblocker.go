@@ -17,6 +17,7 @@ func removeInactiveProposals(ctx sdk.Context, k keeper.Keeper) {
 	k.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		k.DeleteProposalByProposalID(ctx, proposal.ProposalID)
 		k.RefundDepositsByProposalID(ctx, proposal.ProposalID)
+		k.DeleteEvidenceAcknowledgements(ctx, proposal.ProposalID)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -41,7 +42,7 @@ func updateVeto(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal) {
 func updateAbstain(ctx sdk.Context, k keeper.Keeper, proposal types.Proposal) {
 	if proposal.ProposalType() == shield.ProposalTypeShieldClaim {
 		c := proposal.Content.(shield.ClaimProposal)
-		k.ShieldKeeper.RestoreShield(ctx, c.PoolID, proposal.ProposerAddress, c.PurchaseID, c.Loss)
+		k.ShieldKeeper.RestoreShield(ctx, c.ProposalID, c.PoolID, proposal.ProposerAddress, c.PurchaseID, c.Loss)
 		k.ShieldKeeper.ClaimEnd(ctx, c.ProposalID, c.PoolID, c.Loss)
 	}
 }
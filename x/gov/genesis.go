@@ -33,6 +33,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper govTypes.SupplyK
 		k.SetVote(ctx, vote)
 	}
 
+	for _, acknowledgement := range data.Acknowledgements {
+		k.SetEvidenceAcknowledgement(ctx, acknowledgement)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
@@ -64,7 +68,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data types.GenesisState) {
 
 	var proposalsDeposits types.Deposits
 	var proposalsVotes types.Votes
-
 	for _, proposal := range proposals {
 		deposits := k.GetDepositsByProposalID(ctx, proposal.ProposalID)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
@@ -72,11 +75,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data types.GenesisState) {
 		votes := k.GetVotes(ctx, proposal.ProposalID)
 		proposalsVotes = append(proposalsVotes, votes...)
 	}
+	acknowledgements := k.GetAllEvidenceAcknowledgements(ctx)
 
 	return types.GenesisState{
 		StartingProposalID: startingProposalID,
 		Deposits:           proposalsDeposits,
 		Votes:              proposalsVotes,
+		Acknowledgements:   acknowledgements,
 		Proposals:          proposals,
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
@@ -32,6 +32,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case gov.MsgVote:
 			return handleMsgVote(ctx, k, msg)
 
+		case types.MsgAcknowledgeEvidence:
+			return handleMsgAcknowledgeEvidence(ctx, k, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gov message type: %T", msg)
 		}
@@ -131,7 +134,7 @@ func updateAfterSubmitProposal(ctx sdk.Context, k keeper.Keeper, proposal types.
 	if proposal.ProposalType() == shield.ProposalTypeShieldClaim {
 		c := proposal.Content.(shield.ClaimProposal)
 		lockPeriod := k.GetVotingParams(ctx).VotingPeriod * 2
-		return k.ShieldKeeper.SecureCollaterals(ctx, c.PoolID, c.Proposer, c.PurchaseID, c.Loss, lockPeriod)
+		return k.ShieldKeeper.SecureCollaterals(ctx, c.ProposalID, c.PoolID, c.Proposer, c.PurchaseID, c.Loss, lockPeriod)
 	}
 	return nil
 }
@@ -143,6 +146,15 @@ func validateProposalByType(ctx sdk.Context, k keeper.Keeper, msg gov.MsgSubmitP
 			return cert.ErrRepeatedAlias
 		}
 
+	case cert.CertifierSlashProposal:
+		if c.ClaimProposalID == 0 {
+			return nil
+		}
+		claimProposal, ok := k.GetProposal(ctx, c.ClaimProposalID)
+		if !ok || claimProposal.ProposalType() != shield.ProposalTypeShieldClaim || claimProposal.Status != types.StatusPassed {
+			return sdkerrors.Wrapf(cert.ErrInvalidClaimProposal, "proposal %d", c.ClaimProposalID)
+		}
+
 	case upgrade.SoftwareUpgradeProposal:
 		return k.UpgradeKeeper.ValidatePlan(ctx, c.Plan)
 
@@ -150,7 +162,10 @@ func validateProposalByType(ctx sdk.Context, k keeper.Keeper, msg gov.MsgSubmitP
 		// check initial deposit >= max(<loss>*ClaimDepositRate, MinimumClaimDeposit)
 		denom := k.BondDenom(ctx)
 		initialDepositAmount := msg.InitialDeposit.AmountOf(denom).ToDec()
-		lossAmount := c.Loss.AmountOf(denom)
+		lossAmount, err := k.ShieldKeeper.ConvertLoss(ctx, c.Loss)
+		if err != nil {
+			return err
+		}
 		lossAmountDec := lossAmount.ToDec()
 		claimProposalParams := k.ShieldKeeper.GetClaimProposalParams(ctx)
 		depositRate := claimProposalParams.DepositRate
@@ -180,6 +195,16 @@ func validateProposalByType(ctx sdk.Context, k keeper.Keeper, msg gov.MsgSubmitP
 		if purchase.ProtectionEndTime.Before(ctx.BlockTime()) {
 			return fmt.Errorf("after protection end time: %s", purchase.ProtectionEndTime)
 		}
+
+		// check the attached evidence
+		if err := c.Attachments.ValidateBasic(); err != nil {
+			return err
+		}
+		for _, id := range c.Attachments.CertificateIDs {
+			if !k.CertKeeper.HasCertificateByID(ctx, id) {
+				return sdkerrors.Wrapf(shield.ErrInvalidEvidence, "certificate %d does not exist", id)
+			}
+		}
 		return nil
 
 	default:
@@ -204,3 +229,20 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg gov.MsgVote) (*sdk.Resu
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgAcknowledgeEvidence(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcknowledgeEvidence) (*sdk.Result, error) {
+	err := k.AcknowledgeEvidence(ctx, msg.ProposalID, msg.Acknowledger)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, govtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Acknowledger.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/certikfoundation/shentu/x/gov/internal/types"
+	"github.com/certikfoundation/shentu/x/shield"
+)
+
+// AcknowledgeEvidence records that the evidence of a shield claim proposal
+// has been reviewed. Only the sponsor of the claimed pool and certifiers
+// can acknowledge evidence, and only during the certifier voting period.
+func (k Keeper) AcknowledgeEvidence(ctx sdk.Context, proposalID uint64, acknowledgerAddr sdk.AccAddress) error {
+	proposal, ok := k.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(govTypes.ErrUnknownProposal, "%v", proposalID)
+	}
+	c, ok := proposal.Content.(shield.ClaimProposal)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is not a shield claim proposal", proposalID)
+	}
+	if proposal.Status != types.StatusCertifierVotingPeriod {
+		return sdkerrors.Wrapf(govTypes.ErrInactiveProposal, "%v", proposalID)
+	}
+	if !k.IsCertifier(ctx, acknowledgerAddr) && !k.isPoolSponsor(ctx, c.PoolID, acknowledgerAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "'%s' is neither a certifier nor the pool sponsor", acknowledgerAddr)
+	}
+
+	txhash := hex.EncodeToString(tmhash.Sum(ctx.TxBytes()))
+	acknowledgement := types.NewEvidenceAcknowledgement(proposalID, acknowledgerAddr, txhash)
+	k.SetEvidenceAcknowledgement(ctx, acknowledgement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcknowledgeEvidence,
+			sdk.NewAttribute(govTypes.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAcknowledger, acknowledgerAddr.String()),
+			sdk.NewAttribute(types.AttributeTxHash, txhash),
+		),
+	)
+	return nil
+}
+
+// isPoolSponsor checks if the address is the sponsor of a shield pool.
+func (k Keeper) isPoolSponsor(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) bool {
+	pool, found := k.ShieldKeeper.GetPool(ctx, poolID)
+	return found && pool.SponsorAddress.Equals(addr)
+}
+
+// SetEvidenceAcknowledgement sets an evidence acknowledgement.
+func (k Keeper) SetEvidenceAcknowledgement(ctx sdk.Context, acknowledgement types.EvidenceAcknowledgement) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(acknowledgement)
+	store.Set(types.EvidenceAcknowledgementKey(acknowledgement.ProposalID, acknowledgement.Acknowledger), bz)
+}
+
+// HasEvidenceAcknowledgement checks if the evidence of a proposal has been
+// acknowledged by anyone.
+func (k Keeper) HasEvidenceAcknowledgement(ctx sdk.Context, proposalID uint64) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EvidenceAcknowledgementsKey(proposalID))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// GetEvidenceAcknowledgements returns all evidence acknowledgements of a proposal.
+func (k Keeper) GetEvidenceAcknowledgements(ctx sdk.Context, proposalID uint64) (acknowledgements types.EvidenceAcknowledgements) {
+	k.iterateEvidenceAcknowledgements(ctx, types.EvidenceAcknowledgementsKey(proposalID), func(acknowledgement types.EvidenceAcknowledgement) bool {
+		acknowledgements = append(acknowledgements, acknowledgement)
+		return false
+	})
+	return
+}
+
+// GetAllEvidenceAcknowledgements returns all evidence acknowledgements from the store.
+func (k Keeper) GetAllEvidenceAcknowledgements(ctx sdk.Context) (acknowledgements types.EvidenceAcknowledgements) {
+	k.iterateEvidenceAcknowledgements(ctx, types.EvidenceAcknowledgementsKeyPrefix, func(acknowledgement types.EvidenceAcknowledgement) bool {
+		acknowledgements = append(acknowledgements, acknowledgement)
+		return false
+	})
+	return
+}
+
+// DeleteEvidenceAcknowledgements deletes all evidence acknowledgements of a proposal.
+func (k Keeper) DeleteEvidenceAcknowledgements(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, acknowledgement := range k.GetEvidenceAcknowledgements(ctx, proposalID) {
+		store.Delete(types.EvidenceAcknowledgementKey(proposalID, acknowledgement.Acknowledger))
+	}
+}
+
+func (k Keeper) iterateEvidenceAcknowledgements(ctx sdk.Context, prefix []byte, cb func(acknowledgement types.EvidenceAcknowledgement) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var acknowledgement types.EvidenceAcknowledgement
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &acknowledgement)
+
+		if cb(acknowledgement) {
+			break
+		}
+	}
+}
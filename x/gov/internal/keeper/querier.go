@@ -9,6 +9,7 @@ import (
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/certikfoundation/shentu/x/gov/internal/types"
+	"github.com/certikfoundation/shentu/x/shield"
 )
 
 // NewQuerier creates a new gov Querier instance.
@@ -39,6 +40,9 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case govTypes.QueryTally:
 			return queryTally(ctx, req, keeper)
 
+		case types.QueryClaimEvidence:
+			return queryClaimEvidence(ctx, req, keeper)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -184,6 +188,35 @@ func queryTally(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	return bz, nil
 }
 
+func queryClaimEvidence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params govTypes.QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, params.ProposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(govTypes.ErrUnknownProposal, "%d", params.ProposalID)
+	}
+	c, ok := proposal.Content.(shield.ClaimProposal)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proposal %d is not a shield claim proposal", params.ProposalID)
+	}
+
+	acknowledgements := keeper.GetEvidenceAcknowledgements(ctx, params.ProposalID)
+	if acknowledgements == nil {
+		acknowledgements = types.EvidenceAcknowledgements{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.NewQueryResClaimEvidence(c, params.ProposalID, acknowledgements))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryVotes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params govTypes.QueryProposalVotesParams
 	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
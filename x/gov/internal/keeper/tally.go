@@ -267,6 +267,12 @@ func SecurityTally(ctx sdk.Context, k Keeper, proposal types.Proposal) (bool, bo
 	}
 	pass := passAndVetoSecurityResult(k, ctx, th)
 
+	// Shield claims cannot pass the certifier round before their evidence
+	// has been acknowledged by the pool sponsor or a certifier.
+	if _, isClaim := proposal.Content.(shield.ClaimProposal); isClaim && !k.HasEvidenceAcknowledgement(ctx, proposal.ProposalID) {
+		pass = false
+	}
+
 	var endVoting bool
 
 	// For CertifierUpdateProposal: If security round didn't pass, continue to
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers the gov types in addition to the ones
+// registered by the cosmos-sdk gov module.
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgAcknowledgeEvidence{}, "gov/MsgAcknowledgeEvidence", nil)
+}
+
+// ModuleCdc is the generic sealed codec to be used throughout module.
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	ModuleCdc = cdc.Seal()
+}
@@ -1,7 +1,10 @@
 package types
 
 const (
-	AttributeKeyDepositor = "depositor"
-	AttributeKeyVoter     = "voter"
-	AttributeTxHash       = "txhash"
+	EventTypeAcknowledgeEvidence = "acknowledge_evidence"
+
+	AttributeKeyDepositor    = "depositor"
+	AttributeKeyVoter        = "voter"
+	AttributeKeyAcknowledger = "acknowledger"
+	AttributeTxHash          = "txhash"
 )
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EvidenceAcknowledgement records that the pool sponsor or a certifier
+// has reviewed the evidence attached to a shield claim proposal.
+type EvidenceAcknowledgement struct {
+	ProposalID   uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Acknowledger sdk.AccAddress `json:"acknowledger" yaml:"acknowledger"`
+	TxHash       string         `json:"txhash" yaml:"txhash"`
+}
+
+// NewEvidenceAcknowledgement creates a new EvidenceAcknowledgement instance.
+func NewEvidenceAcknowledgement(proposalID uint64, acknowledger sdk.AccAddress, txhash string) EvidenceAcknowledgement {
+	return EvidenceAcknowledgement{
+		ProposalID:   proposalID,
+		Acknowledger: acknowledger,
+		TxHash:       txhash,
+	}
+}
+
+// String implements the Stringer interface.
+func (ea EvidenceAcknowledgement) String() string {
+	return fmt.Sprintf("evidence of proposal %d acknowledged by %s", ea.ProposalID, ea.Acknowledger)
+}
+
+// EvidenceAcknowledgements is a collection of EvidenceAcknowledgement objects.
+type EvidenceAcknowledgements []EvidenceAcknowledgement
@@ -20,6 +20,7 @@ type CertKeeper interface {
 	HasCertifierAlias(ctx sdk.Context, alias string) bool
 	IsCertified(ctx sdk.Context, requestContentType string, content string, certType string) bool
 	GetCertifiedIdentities(ctx sdk.Context) []sdk.AccAddress
+	HasCertificateByID(ctx sdk.Context, id uint64) bool
 }
 
 type UpgradeKeeper interface {
@@ -27,10 +28,12 @@ type UpgradeKeeper interface {
 }
 
 type ShieldKeeper interface {
+	GetPool(ctx sdk.Context, id uint64) (shield.Pool, bool)
 	GetPurchaseList(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress) (shield.PurchaseList, bool)
 	GetClaimProposalParams(ctx sdk.Context) shield.ClaimProposalParams
-	SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, purchaseID uint64, loss sdk.Coins, lockPeriod time.Duration) error
-	RestoreShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error
+	ConvertLoss(ctx sdk.Context, loss sdk.Coins) (sdk.Int, error)
+	SecureCollaterals(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, purchaseID uint64, loss sdk.Coins, lockPeriod time.Duration) error
+	RestoreShield(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error
 	ClaimEnd(ctx sdk.Context, id, poolID uint64, loss sdk.Coins)
 }
 
@@ -11,13 +11,14 @@ import (
 
 // GenesisState defines the governance genesis state.
 type GenesisState struct {
-	StartingProposalID uint64                `json:"starting_proposal_id" yaml:"starting_proposal_id"`
-	Deposits           Deposits              `json:"deposits" yaml:"deposits"`
-	Votes              Votes                 `json:"votes" yaml:"votes"`
-	Proposals          Proposals             `json:"proposals" yaml:"proposals"`
-	DepositParams      DepositParams         `json:"deposit_params" yaml:"deposit_params"`
-	VotingParams       govTypes.VotingParams `json:"voting_params" yaml:"voting_params"`
-	TallyParams        TallyParams           `json:"tally_params" yaml:"tally_params"`
+	StartingProposalID uint64                   `json:"starting_proposal_id" yaml:"starting_proposal_id"`
+	Deposits           Deposits                 `json:"deposits" yaml:"deposits"`
+	Votes              Votes                    `json:"votes" yaml:"votes"`
+	Acknowledgements   EvidenceAcknowledgements `json:"evidence_acknowledgements" yaml:"evidence_acknowledgements"`
+	Proposals          Proposals                `json:"proposals" yaml:"proposals"`
+	DepositParams      DepositParams            `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams       govTypes.VotingParams    `json:"voting_params" yaml:"voting_params"`
+	TallyParams        TallyParams              `json:"tally_params" yaml:"tally_params"`
 }
 
 // DefaultGenesisState creates a default GenesisState object.
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Keys for the gov store in addition to the ones defined by the
+// cosmos-sdk gov module.
+var (
+	EvidenceAcknowledgementsKeyPrefix = []byte{0x30}
+)
+
+// EvidenceAcknowledgementsKey gets the first part of the evidence
+// acknowledgements key based on the proposal ID.
+func EvidenceAcknowledgementsKey(proposalID uint64) []byte {
+	return append(EvidenceAcknowledgementsKeyPrefix, govTypes.GetProposalIDBytes(proposalID)...)
+}
+
+// EvidenceAcknowledgementKey gets the key of the evidence acknowledgement
+// of a particular address on a particular proposal.
+func EvidenceAcknowledgementKey(proposalID uint64, acknowledgerAddr sdk.AccAddress) []byte {
+	return append(EvidenceAcknowledgementsKey(proposalID), acknowledgerAddr.Bytes()...)
+}
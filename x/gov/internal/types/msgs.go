@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MsgAcknowledgeEvidence is the message for acknowledging the evidence
+// of a shield claim proposal during the certifier voting period.
+type MsgAcknowledgeEvidence struct {
+	ProposalID   uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Acknowledger sdk.AccAddress `json:"acknowledger" yaml:"acknowledger"`
+}
+
+// NewMsgAcknowledgeEvidence creates a new MsgAcknowledgeEvidence instance.
+func NewMsgAcknowledgeEvidence(proposalID uint64, acknowledger sdk.AccAddress) MsgAcknowledgeEvidence {
+	return MsgAcknowledgeEvidence{
+		ProposalID:   proposalID,
+		Acknowledger: acknowledger,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAcknowledgeEvidence) Route() string { return govTypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAcknowledgeEvidence) Type() string { return EventTypeAcknowledgeEvidence }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAcknowledgeEvidence) ValidateBasic() error {
+	if msg.Acknowledger.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Acknowledger.String())
+	}
+	if msg.ProposalID < govTypes.DefaultStartingProposalID {
+		return sdkerrors.Wrapf(govTypes.ErrUnknownProposal, "%d", msg.ProposalID)
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAcknowledgeEvidence) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAcknowledgeEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Acknowledger}
+}
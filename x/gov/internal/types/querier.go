@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield"
+)
 
 // QueryProposalsParams defines data structure for querying 'custom/gov/proposals'.
 type QueryProposalsParams struct {
@@ -21,3 +25,28 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+// query endpoints supported by the governance Querier in addition to
+// the ones of the cosmos-sdk gov module
+const (
+	QueryClaimEvidence = "claim_evidence"
+)
+
+// QueryResClaimEvidence is the query result payload for the evidence of a
+// shield claim proposal.
+type QueryResClaimEvidence struct {
+	ProposalID       uint64                   `json:"proposal_id" yaml:"proposal_id"`
+	Evidence         string                   `json:"evidence" yaml:"evidence"`
+	Attachments      shield.ClaimEvidence     `json:"attachments" yaml:"attachments"`
+	Acknowledgements EvidenceAcknowledgements `json:"acknowledgements" yaml:"acknowledgements"`
+}
+
+// NewQueryResClaimEvidence creates a new QueryResClaimEvidence instance.
+func NewQueryResClaimEvidence(proposal shield.ClaimProposal, proposalID uint64, acknowledgements EvidenceAcknowledgements) QueryResClaimEvidence {
+	return QueryResClaimEvidence{
+		ProposalID:       proposalID,
+		Evidence:         proposal.Evidence,
+		Attachments:      proposal.Attachments,
+		Acknowledgements: acknowledgements,
+	}
+}
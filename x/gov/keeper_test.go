@@ -17,8 +17,11 @@ import (
 
 	"github.com/certikfoundation/shentu/common"
 	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/cert"
+	shentuGov "github.com/certikfoundation/shentu/x/gov"
 	"github.com/certikfoundation/shentu/x/gov/internal/keeper"
 	"github.com/certikfoundation/shentu/x/gov/internal/types"
+	"github.com/certikfoundation/shentu/x/shield"
 )
 
 func TestKeeper_ProposeAndVote(t *testing.T) {
@@ -253,3 +256,89 @@ func TestKeeper_DepositOperation(t *testing.T) {
 		require.Equal(t, sdk.NewInt(79950*1e6).Int64(), addr3Amount.AmountOf(common.MicroCTKDenom).Int64())
 	})
 }
+
+func TestKeeper_EvidenceAcknowledgementsLifetime(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+
+	tp := gov.TextProposal{Title: "title0", Description: "desc0"}
+	dropped, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0])
+	require.Equal(t, nil, err)
+	ended, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0])
+	require.Equal(t, nil, err)
+	coins700 := sdk.NewCoins(sdk.NewInt64Coin(common.MicroCTKDenom, 700*1e6))
+	votingPeriodActivated, err := app.GovKeeper.AddDeposit(ctx, ended.ProposalID, addrs[1], coins700)
+	require.Equal(t, nil, err)
+	require.True(t, votingPeriodActivated)
+	ended, found := app.GovKeeper.GetProposal(ctx, ended.ProposalID)
+	require.True(t, found)
+
+	app.GovKeeper.SetEvidenceAcknowledgement(ctx, types.NewEvidenceAcknowledgement(dropped.ProposalID, addrs[1], "txhash"))
+	app.GovKeeper.SetEvidenceAcknowledgement(ctx, types.NewEvidenceAcknowledgement(ended.ProposalID, addrs[1], "txhash"))
+	require.Len(t, app.GovKeeper.GetAllEvidenceAcknowledgements(ctx), 2)
+
+	// the acknowledgements of an ended proposal are kept, while those of a
+	// proposal dropped without enough deposits are deleted with it
+	endTime := dropped.DepositEndTime
+	if ended.VotingEndTime.After(endTime) {
+		endTime = ended.VotingEndTime
+	}
+	ctx = ctx.WithBlockTime(endTime.Add(time.Second))
+	shentuGov.EndBlocker(ctx, app.GovKeeper)
+
+	_, found = app.GovKeeper.GetProposal(ctx, dropped.ProposalID)
+	require.False(t, found)
+	require.False(t, app.GovKeeper.HasEvidenceAcknowledgement(ctx, dropped.ProposalID))
+
+	ended, found = app.GovKeeper.GetProposal(ctx, ended.ProposalID)
+	require.True(t, found)
+	require.Equal(t, types.StatusRejected, ended.Status)
+	require.True(t, app.GovKeeper.HasEvidenceAcknowledgement(ctx, ended.ProposalID))
+	require.Len(t, app.GovKeeper.GetAllEvidenceAcknowledgements(ctx), 1)
+}
+
+func TestHandler_CertifierSlashProposalClaimLink(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(80000*1e6))
+	app.CertKeeper.SetCertifier(ctx, cert.NewCertifier(addrs[0], "", addrs[0], ""))
+	handler := shentuGov.NewHandler(app.GovKeeper)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(common.MicroCTKDenom, 1e6))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(common.MicroCTKDenom, 1000))
+
+	tp := gov.TextProposal{Title: "title0", Description: "desc0"}
+	textProposal, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0])
+	require.NoError(t, err)
+	claimProposal, err := app.GovKeeper.SubmitProposal(ctx, tp, addrs[0])
+	require.NoError(t, err)
+	claimProposal.Content = shield.ClaimProposal{ProposalID: claimProposal.ProposalID, Proposer: addrs[1]}
+	app.GovKeeper.SetProposal(ctx, claimProposal)
+
+	for _, tc := range []struct {
+		name            string
+		claimProposalID uint64
+		status          types.ProposalStatus
+		valid           bool
+	}{
+		{"no linked proposal", 0, types.StatusNil, true},
+		{"missing proposal", 100, types.StatusNil, false},
+		{"not a claim proposal", textProposal.ProposalID, types.StatusNil, false},
+		{"claim proposal not passed", claimProposal.ProposalID, types.StatusValidatorVotingPeriod, false},
+		{"passed claim proposal", claimProposal.ProposalID, types.StatusPassed, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.status != types.StatusNil {
+				claimProposal.Status = tc.status
+				app.GovKeeper.SetProposal(ctx, claimProposal)
+			}
+			content := cert.NewCertifierSlashProposal("title", "description", addrs[0], addrs[0], amount, tc.claimProposalID)
+			_, err := handler(ctx, govTypes.NewMsgSubmitProposal(content, deposit, addrs[0]))
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.True(t, cert.ErrInvalidClaimProposal.Is(err))
+			}
+		})
+	}
+}
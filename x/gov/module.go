@@ -54,6 +54,7 @@ func (AppModuleBasic) Name() string {
 // RegisterCodec registers gov's necessary types and interfaces
 func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 	gov.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
 }
 
 // DefaultGenesis returns the default genesis state.
@@ -41,6 +41,12 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &voteB)
 		return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+	case bytes.Equal(kvA.Key[:1], types.EvidenceAcknowledgementsKeyPrefix):
+		var acknowledgementA, acknowledgementB types.EvidenceAcknowledgement
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &acknowledgementA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &acknowledgementB)
+		return fmt.Sprintf("%v\n%v", acknowledgementA, acknowledgementB)
+
 	default:
 		panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 	}
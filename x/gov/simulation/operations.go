@@ -174,7 +174,18 @@ func SimulateSubmitProposal(
 			}
 		}
 
-		// 3) Schedule operations for certifier voting
+		// 3) Schedule operations for evidence acknowledgement and certifier voting
+		if content.ProposalType() == shield.ProposalTypeShieldClaim {
+			for _, acc := range accs {
+				if ck.IsCertifier(ctx, acc.Address) {
+					fops = append(fops, simulation.FutureOperation{
+						BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 1, 5),
+						Op:          SimulateMsgAcknowledgeEvidence(ak, ck, k, acc, proposalID),
+					})
+					break
+				}
+			}
+		}
 		if content.ProposalType() == shield.ProposalTypeShieldClaim ||
 			content.ProposalType() == cert.ProposalTypeCertifierUpdate ||
 			content.ProposalType() == upgrade.ProposalTypeSoftwareUpgrade {
@@ -313,6 +324,53 @@ func SimulateCertifierMsgVote(ak govTypes.AccountKeeper, ck types.CertKeeper, k
 	}
 }
 
+// SimulateMsgAcknowledgeEvidence simulates a certifier acknowledging the
+// evidence of a shield claim proposal.
+func SimulateMsgAcknowledgeEvidence(ak govTypes.AccountKeeper, ck types.CertKeeper, k keeper.Keeper,
+	simAccount simulation.Account, proposalID uint64) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !ck.IsCertifier(ctx, simAccount.Address) {
+			return simulation.NoOpMsg(govTypes.ModuleName), nil, nil
+		}
+
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok {
+			return simulation.NoOpMsg(govTypes.ModuleName), nil, nil
+		}
+
+		if proposal.Status != types.StatusCertifierVotingPeriod {
+			return simulation.NoOpMsg(govTypes.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgAcknowledgeEvidence(proposalID, simAccount.Address)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(govTypes.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(govTypes.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 func SimulateMsgDeposit(ak govTypes.AccountKeeper, k keeper.Keeper, proposalID uint64) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, chainID string,
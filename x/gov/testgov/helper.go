@@ -29,13 +29,22 @@ func NewHelper(t *testing.T, ctx sdk.Context, k gov.Keeper, denom string) *Helpe
 }
 
 func (gh *Helper) ShieldClaimProposal(proposer sdk.AccAddress, loss int64, poolID, purchaseID uint64, ok bool) *sdk.Result {
+	return gh.ShieldClaimProposalWithAttachments(proposer, loss, poolID, purchaseID, shieldTypes.ClaimEvidence{}, ok)
+}
+
+func (gh *Helper) ShieldClaimProposalWithAttachments(proposer sdk.AccAddress, loss int64, poolID, purchaseID uint64, attachments shieldTypes.ClaimEvidence, ok bool) *sdk.Result {
 	initDeposit := sdk.NewCoins(sdk.NewInt64Coin(gh.denom, 5000e6))
 	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(gh.denom, loss))
-	content := shieldTypes.NewShieldClaimProposal(poolID, lossCoins, purchaseID, "test_claim_evidence", "test_claim_description", proposer)
+	content := shieldTypes.NewShieldClaimProposal(poolID, lossCoins, purchaseID, "test_claim_evidence", attachments, "test_claim_description", proposer)
 	proposal := cosmosGov.NewMsgSubmitProposal(content, initDeposit, proposer)
 	return gh.Handle(proposal, ok)
 }
 
+func (gh *Helper) AcknowledgeEvidence(acknowledger sdk.AccAddress, proposalID uint64, ok bool) *sdk.Result {
+	msg := gov.NewMsgAcknowledgeEvidence(proposalID, acknowledger)
+	return gh.Handle(msg, ok)
+}
+
 // TurnBlock updates context and calls endblocker.
 func (sh *Helper) TurnBlock(ctx sdk.Context) {
 	sh.ctx = ctx
@@ -156,7 +156,7 @@ func createTestInput(t *testing.T) testInput {
 	supplyKeeper.SetModuleAccount(ctx, bondedPool)
 	distrKeeper := TestDistrKeeper{&sdk.Coins{}}
 	slashingKeeper := slashing.NewKeeper(cdc, keySlashing, stakingKeeper, paramsKeeper.Subspace(slashing.DefaultParamspace))
-	certKeeper := cert.NewKeeper(cdc, keyCert, slashingKeeper, stakingKeeper)
+	certKeeper := cert.NewKeeper(cdc, keyCert, slashingKeeper, stakingKeeper, supplyKeeper, paramsKeeper.Subspace(cert.DefaultParamSpace))
 	govKeeper := gov.Keeper{}
 	shieldKeeper := shield.NewKeeper(cdc, keyShield, accKeeper, stakingKeeper, &govKeeper, supplyKeeper, paramsKeeper.Subspace(shield.DefaultParamSpace))
 
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/oracle/internal/keeper"
+	"github.com/certikfoundation/shentu/x/oracle/internal/types"
 )
 
 func BeginBlocker(ctx sdk.Context, k Keeper) {
@@ -13,16 +14,73 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 }
 
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// Recurring tasks reopen before closing tasks are aggregated, so that rounds closing in the same block are not missed.
+	reopenTaskIDs := k.GetReopenTaskIDs(ctx, ctx.BlockHeight())
+	for _, taskID := range reopenTaskIDs {
+		task, err := k.ReopenTask(ctx, taskID)
+		if err != nil {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReopenTask,
+				sdk.NewAttribute("id", strconv.FormatUint(task.ID, 10)),
+				sdk.NewAttribute("parent", strconv.FormatUint(task.Parent, 10)),
+				sdk.NewAttribute("contract", task.Contract),
+				sdk.NewAttribute("function", task.Function),
+				sdk.NewAttribute("bounty", task.Bounty.String()),
+				sdk.NewAttribute("prepaid", task.Prepaid.String()),
+				sdk.NewAttribute("expiration", task.Expiration.String()),
+				sdk.NewAttribute("closingHeight", strconv.FormatInt(task.ClosingBlock, 10)),
+				sdk.NewAttribute("revealHeight", revealHeight(task)),
+			),
+		)
+	}
+	k.DeleteReopenTaskIDs(ctx, ctx.BlockHeight())
+
 	closingTaskIDs := k.GetClosingTaskIDs(ctx, ctx.BlockHeight())
 	for _, taskID := range closingTaskIDs {
-		err := k.Aggregate(ctx, taskID.Contract, taskID.Function)
+		err := k.Aggregate(ctx, taskID)
 		if err != nil {
 			continue
 		}
-		task, err := k.GetTask(ctx, taskID.Contract, taskID.Function)
+		task, err := k.GetTaskByID(ctx, taskID)
 		if err != nil {
 			continue
 		}
+		if task.CanReopen() {
+			k.ScheduleReopen(ctx, task)
+		}
+
+		// Operators who committed to a response without revealing it lose a share of their collateral.
+		if fraction := k.GetTaskParams(ctx).NonRevealPenalty; !fraction.IsNil() && fraction.IsPositive() {
+			for _, operator := range task.NonRevealers() {
+				penalty, err := k.PenalizeOperator(ctx, operator, fraction)
+				if err != nil || penalty.IsZero() {
+					continue
+				}
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypePenalizeOperator,
+						sdk.NewAttribute("operator", operator.String()),
+						sdk.NewAttribute("id", strconv.FormatUint(task.ID, 10)),
+						sdk.NewAttribute("penalty", penalty.String()),
+					),
+				)
+			}
+		}
+
+		// Operators responding far from the result or missing too many tasks in a row are slashed and jailed.
+		slashingParams := k.GetSlashingParams(ctx)
+		for _, response := range task.Responses {
+			if k.IsOutlier(ctx, task, response) {
+				slashOperator(ctx, k, task, response.Operator, slashingParams.SlashFractionDeviation, "deviation")
+			}
+		}
+		for _, operator := range k.UpdateMissedTasks(ctx, task) {
+			slashOperator(ctx, k, task, operator, slashingParams.SlashFractionMissing, "missing")
+		}
 
 		if err := k.DistributeBounty(ctx, task); err != nil {
 			// TODO
@@ -32,6 +90,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				"aggregate_task",
+				sdk.NewAttribute("id", strconv.FormatUint(task.ID, 10)),
 				sdk.NewAttribute("contract", task.Contract),
 				sdk.NewAttribute("function", task.Function),
 				sdk.NewAttribute("begin_block_height", strconv.FormatInt(task.BeginBlock, 10)),
@@ -48,3 +107,34 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 	k.DeleteClosingTaskIDs(ctx, ctx.BlockHeight())
 }
+
+// revealHeight returns the first block height at which committed responses to a task are revealed,
+// or zero if the task takes plain responses.
+func revealHeight(task types.Task) string {
+	if !task.IsCommitReveal() {
+		return "0"
+	}
+	return strconv.FormatInt(task.RevealBlock(), 10)
+}
+
+// slashOperator slashes and jails an operator for its response to a task, or for missing it.
+func slashOperator(ctx sdk.Context, k keeper.Keeper, task types.Task, operator sdk.AccAddress, fraction sdk.Dec, reason string) {
+	slashed, err := k.SlashOperator(ctx, operator, fraction)
+	if err != nil {
+		return
+	}
+	jailed, err := k.GetOperator(ctx, operator)
+	if err != nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashOperator,
+			sdk.NewAttribute("operator", operator.String()),
+			sdk.NewAttribute("id", strconv.FormatUint(task.ID, 10)),
+			sdk.NewAttribute("reason", reason),
+			sdk.NewAttribute("amount", slashed.String()),
+			sdk.NewAttribute("jailedUntil", jailed.JailedUntil.String()),
+		),
+	)
+}
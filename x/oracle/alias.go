@@ -16,13 +16,30 @@ var (
 	NewKeeper                 = keeper.NewKeeper
 	NewQuerier                = keeper.NewQuerier
 	NewMsgTaskResponse        = types.NewMsgTaskResponse
+	NewMsgCommitTaskResponse  = types.NewMsgCommitTaskResponse
+	NewMsgRevealTaskResponse  = types.NewMsgRevealTaskResponse
+	NewMsgUnjailOperator      = types.NewMsgUnjailOperator
+	TaskResponseHash          = types.TaskResponseHash
 	DefaultGenesisState       = types.DefaultGenesisState
 	TaskStoreKeyPrefix        = types.TaskStoreKeyPrefix
 	ClosingTaskStoreKeyPrefix = types.ClosingTaskStoreKeyPrefix
+	NewTask                   = types.NewTask
+)
+
+const (
+	TaskStatusPending   = types.TaskStatusPending
+	TaskStatusSucceeded = types.TaskStatusSucceeded
+	TaskStatusFailed    = types.TaskStatusFailed
 )
 
 type (
-	Keeper          = keeper.Keeper
-	MsgTaskResponse = types.MsgTaskResponse
-	MsgCreateTask   = types.MsgCreateTask
+	Keeper                = keeper.Keeper
+	MsgTaskResponse       = types.MsgTaskResponse
+	MsgCommitTaskResponse = types.MsgCommitTaskResponse
+	MsgRevealTaskResponse = types.MsgRevealTaskResponse
+	MsgUnjailOperator     = types.MsgUnjailOperator
+	MsgCreateTask         = types.MsgCreateTask
+	Task                  = types.Task
+	TaskStatus            = types.TaskStatus
+	AggregationStrategy   = types.AggregationStrategy
 )
@@ -16,6 +16,8 @@ import (
 
 const (
 	FlagOperator = "operator"
+	FlagID       = "id"
+	FlagLimit    = "limit"
 )
 
 // GetQueryCmd returns the cli query commands for this module.
@@ -30,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdOperators(queryRoute, cdc),
 		GetCmdWithdraws(queryRoute, cdc),
 		GetCmdTask(queryRoute, cdc),
+		GetCmdTaskHistory(queryRoute, cdc),
 		GetCmdResponse(queryRoute, cdc),
 	)...)
 
@@ -108,6 +111,49 @@ func GetCmdTask(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			var params types.QueryTaskParams
+			if id := viper.GetUint64(FlagID); id != 0 {
+				params = types.NewQueryTaskByIDParams(id)
+			} else {
+				contract := viper.GetString(FlagContract)
+				if contract == "" {
+					return fmt.Errorf("contract address is required")
+				}
+				function := viper.GetString(FlagFunction)
+				if function == "" {
+					return fmt.Errorf("function is required")
+				}
+				params = types.NewQueryTaskParams(contract, function)
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/task", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+			var out types.Task
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().String(FlagContract, "", "Provide the contract address")
+	cmd.Flags().String(FlagFunction, "", "Provide the function")
+	cmd.Flags().Uint64(FlagID, 0, "Provide the task ID instead of the contract address and the function")
+	return cmd
+}
+
+// GetCmdTaskHistory returns the task history query command.
+func GetCmdTaskHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "task-history <flags>",
+		Short: "Get the tasks and scores of a contract and function from the newest to the oldest",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
 			contract := viper.GetString(FlagContract)
 			if contract == "" {
 				return fmt.Errorf("contract address is required")
@@ -117,22 +163,23 @@ func GetCmdTask(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("function is required")
 			}
 
-			params := types.NewQueryTaskParams(contract, function)
+			params := types.NewQueryTaskHistoryParams(contract, function, viper.GetInt(FlagLimit))
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/task", queryRoute), bz)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTaskHistory), bz)
 			if err != nil {
 				return err
 			}
-			var out types.Task
+			var out []types.Task
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
 	}
 	cmd.Flags().String(FlagContract, "", "Provide the contract address")
 	cmd.Flags().String(FlagFunction, "", "Provide the function")
+	cmd.Flags().Int(FlagLimit, 0, "Maximum number of tasks to return, all of them if zero")
 	return cmd
 }
 
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,12 @@ const (
 	FlagWait          = "wait"
 	FlagName          = "name"
 	FlagValidDuration = "valid"
+	FlagInterval      = "interval"
+	FlagPrepaid       = "prepaid"
+	FlagStrategy      = "strategy"
+	FlagVetoThreshold = "veto-threshold"
+	FlagTrimRatio     = "trim-ratio"
+	FlagSalt          = "salt"
 )
 
 var FlagForce bool
@@ -45,8 +52,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdDepositCollateral(cdc),
 		GetCmdWithdrawCollateral(cdc),
 		GetCmdClaimReward(cdc),
+		GetCmdUnjailOperator(cdc),
 		GetCmdCreateTask(cdc),
 		GetCmdRespondToTask(cdc),
+		GetCmdCommitTaskResponse(cdc),
+		GetCmdRevealTaskResponse(cdc),
 		GetCmdInquiry(cdc),
 		GetCmdDeleteTask(cdc),
 	)...)
@@ -203,6 +213,31 @@ func GetCmdClaimReward(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdUnjailOperator returns command to unjail an operator after its jail time.
+func GetCmdUnjailOperator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-operator <address>",
+		Short: "Unjail an operator whose jail time has passed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUnjailOperator(address)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
 // GetCmdCreateTask returns command to create a task.
 func GetCmdCreateTask(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -245,8 +280,20 @@ func GetCmdCreateTask(cdc *codec.Codec) *cobra.Command {
 			wait := viper.GetInt64(FlagWait)
 			hours := viper.GetInt64(FlagValidDuration)
 			validDuration := time.Duration(hours) * time.Hour
+			interval := viper.GetInt64(FlagInterval)
+			prepaid, err := sdk.ParseCoins(viper.GetString(FlagPrepaid))
+			if err != nil {
+				return err
+			}
 
-			msg := types.NewMsgCreateTask(contract, function, bounty, description, cliCtx.GetFromAddress(), wait, validDuration)
+			strategy, err := types.ParseAggregationStrategy(viper.GetString(FlagStrategy),
+				viper.GetString(FlagVetoThreshold), viper.GetString(FlagTrimRatio))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTask(contract, function, bounty, description, cliCtx.GetFromAddress(), wait, validDuration,
+				interval, prepaid, strategy)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -260,6 +307,11 @@ func GetCmdCreateTask(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagDescription, "", "description of the task")
 	cmd.Flags().String(FlagWait, "0", "number of blocks between task creation and aggregation")
 	cmd.Flags().String(FlagValidDuration, "0", "valid duration of the task result")
+	cmd.Flags().String(FlagInterval, "0", "number of blocks between the closing of a round of a recurring task and the next round")
+	cmd.Flags().String(FlagPrepaid, "", "prepaid bounty for the following rounds of a recurring task")
+	cmd.Flags().String(FlagStrategy, "weighted-mean", "aggregation of the responses: weighted-mean, weighted-median or trimmed-mean")
+	cmd.Flags().String(FlagVetoThreshold, "", "share of collateral responding with the minimum score that vetoes the result (default 1/3)")
+	cmd.Flags().String(FlagTrimRatio, "", "share of collateral trimmed from each end of the scores by the trimmed mean")
 
 	return cmd
 }
@@ -309,6 +361,104 @@ func GetCmdRespondToTask(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdCommitTaskResponse returns command to commit to a response to a task.
+func GetCmdCommitTaskResponse(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-task-response <flags>",
+		Short: "Commit to a response to a task, to be revealed in the reveal phase of the task",
+		Long: strings.TrimSpace(`Commit to a response to a task that takes committed responses. Only the hash of the score,
+the salt and the operator is submitted. The same score and salt have to be revealed with reveal-task-response
+in the last blocks of the task, otherwise the response is not counted and the operator is penalized.`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			contract, function, score, salt, err := parseRevealFlags()
+			if err != nil {
+				return err
+			}
+			hash := types.TaskResponseHash(contract, function, score, salt, cliCtx.GetFromAddress())
+
+			msg := types.NewMsgCommitTaskResponse(contract, function, hash, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "contract address")
+	cmd.Flags().String(FlagFunction, "", "function")
+	cmd.Flags().String(FlagScore, "", "score")
+	cmd.Flags().String(FlagSalt, "", "secret salt of the committed response")
+
+	return cmd
+}
+
+// GetCmdRevealTaskResponse returns command to reveal a committed response to a task.
+func GetCmdRevealTaskResponse(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-task-response <flags>",
+		Short: "Reveal a committed response to a task",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			accGetter := authtxb.NewAccountRetriever(cliCtx)
+			if _, err := accGetter.GetAccount(cliCtx.GetFromAddress()); err != nil {
+				return err
+			}
+
+			contract, function, score, salt, err := parseRevealFlags()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealTaskResponse(contract, function, score, salt, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "contract address")
+	cmd.Flags().String(FlagFunction, "", "function")
+	cmd.Flags().String(FlagScore, "", "score")
+	cmd.Flags().String(FlagSalt, "", "secret salt of the committed response")
+
+	return cmd
+}
+
+// parseRevealFlags returns the contract, function, score and salt of a committed response.
+func parseRevealFlags() (string, string, int64, string, error) {
+	contract := viper.GetString(FlagContract)
+	if contract == "" {
+		return "", "", 0, "", fmt.Errorf("contract address is required to respond to a task")
+	}
+	function := viper.GetString(FlagFunction)
+	if function == "" {
+		return "", "", 0, "", fmt.Errorf("function is required to respond to a task")
+	}
+	if viper.GetString(FlagScore) == "" {
+		return "", "", 0, "", fmt.Errorf("score is required to respond to a task")
+	}
+	salt := viper.GetString(FlagSalt)
+	if salt == "" {
+		return "", "", 0, "", fmt.Errorf("salt is required to commit to a response")
+	}
+	return contract, function, viper.GetInt64(FlagScore), salt, nil
+}
+
 // GetCmdInquiry returns a inquiry-task command.
 func GetCmdInquiry(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
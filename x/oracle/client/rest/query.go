@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -19,6 +20,7 @@ func RegisterQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/withdraws", types.QuerierRoute), withdrawsHandler(cliCtx)).Methods("Get")
 
 	r.HandleFunc(fmt.Sprintf("/%s/task", types.QuerierRoute), taskHandler(cliCtx)).Methods("Get")
+	r.HandleFunc(fmt.Sprintf("/%s/task-history", types.QuerierRoute), taskHistoryHandler(cliCtx)).Methods("Get")
 	r.HandleFunc(fmt.Sprintf("/%s/response", types.QuerierRoute), responseHandler(cliCtx)).Methods("Get")
 }
 
@@ -83,6 +85,52 @@ func withdrawsHandler(cliCtx context.CLIContext) http.HandlerFunc {
 }
 
 func taskHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var params types.QueryTaskParams
+		if idStr := r.URL.Query().Get("id"); idStr != "" {
+			id, err := strconv.ParseUint(idStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params = types.NewQueryTaskByIDParams(id)
+		} else {
+			contract := r.URL.Query().Get("contract")
+			if contract == "" {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is require to query a task")
+				return
+			}
+			function := r.URL.Query().Get("function")
+			if function == "" {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "function is require to query a task")
+				return
+			}
+			params = types.NewQueryTaskParams(contract, function)
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTask)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func taskHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
@@ -91,21 +139,32 @@ func taskHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		contract := r.URL.Query().Get("contract")
 		if contract == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is require to submit a task")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "contract is require to query the task history")
+			return
 		}
 		function := r.URL.Query().Get("function")
 		if function == "" {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, "function is require to submit a task")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "function is require to query the task history")
+			return
+		}
+		var limit int
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
 		}
 
-		params := types.NewQueryTaskParams(contract, function)
+		params := types.NewQueryTaskHistoryParams(contract, function, limit)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTask)
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTaskHistory)
 		res, height, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
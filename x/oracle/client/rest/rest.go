@@ -51,6 +51,11 @@ type claimRewardReq struct {
 	Address string       `json:"address"`
 }
 
+type unjailOperatorReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Address string       `json:"address"`
+}
+
 type createTaskReq struct {
 	BaseReq       rest.BaseReq `json:"base_req"`
 	Contract      string       `json:"contract"`
@@ -59,6 +64,11 @@ type createTaskReq struct {
 	Description   string       `json:"description"`
 	Wait          string       `json:"wait"`
 	ValidDuration string       `json:"valid_duration"`
+	Interval      string       `json:"interval"`
+	Prepaid       string       `json:"prepaid"`
+	Strategy      string       `json:"strategy"`
+	VetoThreshold string       `json:"veto_threshold"`
+	TrimRatio     string       `json:"trim_ratio"`
 }
 
 type respondToTaskReq struct {
@@ -69,6 +79,23 @@ type respondToTaskReq struct {
 	Operator string       `json:"operator"`
 }
 
+type commitTaskResponseReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Contract string       `json:"contract"`
+	Function string       `json:"function"`
+	Hash     string       `json:"hash"`
+	Operator string       `json:"operator"`
+}
+
+type revealTaskResponseReq struct {
+	BaseReq  rest.BaseReq `json:"base_req"`
+	Contract string       `json:"contract"`
+	Function string       `json:"function"`
+	Score    string       `json:"score"`
+	Salt     string       `json:"salt"`
+	Operator string       `json:"operator"`
+}
+
 type deleteTaskReq struct {
 	BaseReq  rest.BaseReq `json:"base_req"`
 	Contract string       `json:"contract"`
@@ -13,6 +13,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	totalCollateral := data.TotalCollateral
 	poolParams := data.PoolParams
 	taskParams := data.TaskParams
+	slashingParams := data.SlashingParams
 	withdraws := data.Withdraws
 	tasks := data.Tasks
 
@@ -23,14 +24,32 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.SetTotalCollateral(ctx, totalCollateral)
 	k.SetLockedPoolParams(ctx, poolParams)
 	k.SetTaskParams(ctx, taskParams)
+	// Genesis states exported before slashing have no slashing params.
+	if slashingParams.SlashFractionDeviation.IsNil() {
+		slashingParams = types.DefaultSlashingParams()
+	}
+	k.SetSlashingParams(ctx, slashingParams)
 
 	for _, withdraw := range withdraws {
 		withdraw.DueBlock += ctx.BlockHeight()
 		k.SetWithdraw(ctx, withdraw)
 	}
 
+	nextTaskID := data.NextTaskID
+	if nextTaskID == 0 {
+		nextTaskID = 1
+	}
+	k.SetNextTaskID(ctx, nextTaskID)
 	for _, task := range tasks {
-		k.UpdateAndSetTask(ctx, task)
+		// Tasks exported before tasks had IDs are given new ones.
+		if task.ID == 0 {
+			task.ID = k.GetNextTaskID(ctx)
+			k.SetNextTaskID(ctx, task.ID+1)
+		}
+		task = k.UpdateAndSetTask(ctx, task)
+		if task.Status != types.TaskStatusPending && task.CanReopen() {
+			k.ScheduleReopen(ctx, task)
+		}
 	}
 }
 
@@ -41,9 +60,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 
 	poolParams := k.GetLockedPoolParams(ctx)
 	taskParams := k.GetTaskParams(ctx)
+	slashingParams := k.GetSlashingParams(ctx)
 	withdraws := k.GetAllWithdrawsForExport(ctx)
 
 	tasks := k.UpdateAndGetAllTasks(ctx)
 
-	return types.NewGenesisState(operators, totalCollateral, poolParams, taskParams, withdraws, tasks)
+	return types.NewGenesisState(operators, totalCollateral, poolParams, taskParams, slashingParams, withdraws, tasks, k.GetNextTaskID(ctx))
 }
@@ -1,6 +1,7 @@
 package oracle
 
 import (
+	"encoding/hex"
 	"strconv"
 	"time"
 
@@ -25,10 +26,16 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgReduceCollateral(ctx, k, msg)
 		case types.MsgWithdrawReward:
 			return handleMsgWithdrawReward(ctx, k, msg)
+		case types.MsgUnjailOperator:
+			return handleMsgUnjailOperator(ctx, k, msg)
 		case types.MsgCreateTask:
 			return handleMsgCreateTask(ctx, k, msg)
 		case types.MsgTaskResponse:
 			return handleMsgTaskResponse(ctx, k, msg)
+		case types.MsgCommitTaskResponse:
+			return handleMsgCommitTaskResponse(ctx, k, msg)
+		case types.MsgRevealTaskResponse:
+			return handleMsgRevealTaskResponse(ctx, k, msg)
 		case types.MsgInquiryTask:
 			return handleMsgInquiryTask(ctx, k, msg)
 		case types.MsgDeleteTask:
@@ -110,6 +117,19 @@ func handleMsgWithdrawReward(ctx sdk.Context, k Keeper, msg types.MsgWithdrawRew
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgUnjailOperator(ctx sdk.Context, k Keeper, msg types.MsgUnjailOperator) (*sdk.Result, error) {
+	if err := k.UnjailOperator(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjailOperator,
+			sdk.NewAttribute("operator", msg.Address.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgCreateTask(ctx sdk.Context, k Keeper, msg types.MsgCreateTask) (*sdk.Result, error) {
 	taskParams := k.GetTaskParams(ctx)
 	var windowSize int64
@@ -125,13 +145,19 @@ func handleMsgCreateTask(ctx sdk.Context, k Keeper, msg types.MsgCreateTask) (*s
 		expiration = ctx.BlockTime().Add(msg.ValidDuration)
 	}
 
-	if err := k.CreateTask(ctx, msg.Contract, msg.Function, msg.Bounty, msg.Description,
-		expiration, msg.Creator, windowSize); err != nil {
+	id, err := k.CreateTask(ctx, msg.Contract, msg.Function, msg.Bounty, msg.Description,
+		expiration, msg.Creator, windowSize, msg.Interval, msg.Prepaid, msg.Strategy)
+	if err != nil {
+		return nil, err
+	}
+	task, err := k.GetTaskByID(ctx, id)
+	if err != nil {
 		return nil, err
 	}
 
 	createTaskEvent := sdk.NewEvent(
 		types.EventTypeCreateTask,
+		sdk.NewAttribute("id", strconv.FormatUint(id, 10)),
 		sdk.NewAttribute("contract", msg.Contract),
 		sdk.NewAttribute("function", msg.Function),
 		sdk.NewAttribute("bounty", msg.Bounty.String()),
@@ -140,6 +166,10 @@ func handleMsgCreateTask(ctx sdk.Context, k Keeper, msg types.MsgCreateTask) (*s
 		sdk.NewAttribute("creator", msg.Creator.String()),
 		sdk.NewAttribute("windowSize", strconv.FormatInt(windowSize, 10)),
 		sdk.NewAttribute("closingHeight", strconv.FormatInt(ctx.BlockHeight()+windowSize, 10)),
+		sdk.NewAttribute("interval", strconv.FormatInt(msg.Interval, 10)),
+		sdk.NewAttribute("prepaid", msg.Prepaid.String()),
+		sdk.NewAttribute("strategy", msg.Strategy.Type.String()),
+		sdk.NewAttribute("revealHeight", revealHeight(task)),
 	)
 	ctx.EventManager().EmitEvent(createTaskEvent)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
@@ -160,6 +190,36 @@ func handleMsgTaskResponse(ctx sdk.Context, k Keeper, msg types.MsgTaskResponse)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgCommitTaskResponse(ctx sdk.Context, k Keeper, msg types.MsgCommitTaskResponse) (*sdk.Result, error) {
+	if err := k.CommitToTask(ctx, msg.Contract, msg.Function, msg.Hash, msg.Operator); err != nil {
+		return nil, err
+	}
+	commitResponseEvent := sdk.NewEvent(
+		types.EventTypeCommitResponse,
+		sdk.NewAttribute("contract", msg.Contract),
+		sdk.NewAttribute("function", msg.Function),
+		sdk.NewAttribute("hash", hex.EncodeToString(msg.Hash)),
+		sdk.NewAttribute("operator", msg.Operator.String()),
+	)
+	ctx.EventManager().EmitEvent(commitResponseEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevealTaskResponse(ctx sdk.Context, k Keeper, msg types.MsgRevealTaskResponse) (*sdk.Result, error) {
+	if err := k.RevealTaskResponse(ctx, msg.Contract, msg.Function, msg.Score, msg.Salt, msg.Operator); err != nil {
+		return nil, err
+	}
+	revealResponseEvent := sdk.NewEvent(
+		types.EventTypeRevealResponse,
+		sdk.NewAttribute("contract", msg.Contract),
+		sdk.NewAttribute("function", msg.Function),
+		sdk.NewAttribute("score", strconv.FormatInt(msg.Score, 10)),
+		sdk.NewAttribute("operator", msg.Operator.String()),
+	)
+	ctx.EventManager().EmitEvent(revealResponseEvent)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgInquiryTask(ctx sdk.Context, k Keeper, msg types.MsgInquiryTask) (*sdk.Result, error) {
 	task, err := k.GetTask(ctx, msg.Contract, msg.Function)
 	if err != nil {
@@ -171,6 +231,7 @@ func handleMsgInquiryTask(ctx sdk.Context, k Keeper, msg types.MsgInquiryTask) (
 		sdk.NewAttribute("function", msg.Function),
 		sdk.NewAttribute("txhash", msg.TxHash),
 		sdk.NewAttribute("inquirer", msg.Inquirer.String()),
+		sdk.NewAttribute("id", strconv.FormatUint(task.ID, 10)),
 		sdk.NewAttribute("result", strconv.FormatUint(task.Result.Uint64(), 10)),
 		sdk.NewAttribute("expiration", task.Expiration.String()),
 	)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/certikfoundation/shentu/x/oracle/internal/types"
@@ -87,6 +89,9 @@ func (k Keeper) RemoveOperator(ctx sdk.Context, address sdk.AccAddress) error {
 	if err != nil {
 		return nil
 	}
+	if operator.Jailed {
+		return types.ErrOperatorJailed
+	}
 	if err := k.ReduceTotalCollateral(ctx, operator.Collateral); err != nil {
 		return err
 	}
@@ -153,6 +158,107 @@ func (k Keeper) ReduceCollateral(ctx sdk.Context, address sdk.AccAddress, decrem
 	return nil
 }
 
+// PenalizeOperator takes a share of an operator's collateral to the community pool and returns the penalty.
+func (k Keeper) PenalizeOperator(ctx sdk.Context, address sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	penalty, err := k.takeCollateral(ctx, address, fraction)
+	if err != nil || penalty.IsZero() {
+		return penalty, err
+	}
+	if err := k.FundCommunityPool(ctx, penalty); err != nil {
+		return nil, err
+	}
+	return penalty, nil
+}
+
+// SlashOperator takes a share of an operator's collateral, which is burned or sent to the community pool
+// depending on the slashing params, jails the operator and returns the slashed collateral.
+func (k Keeper) SlashOperator(ctx sdk.Context, address sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	params := k.GetSlashingParams(ctx)
+	slashed, err := k.takeCollateral(ctx, address, fraction)
+	if err != nil {
+		return nil, err
+	}
+	if !slashed.IsZero() {
+		if params.BurnSlashed {
+			err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, slashed)
+		} else {
+			err = k.FundCommunityPool(ctx, slashed)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := k.JailOperator(ctx, address, ctx.BlockTime().Add(params.JailDuration)); err != nil {
+		return nil, err
+	}
+	return slashed, nil
+}
+
+// takeCollateral removes a share of an operator's collateral from the collateral pool and returns it.
+func (k Keeper) takeCollateral(ctx sdk.Context, address sdk.AccAddress, fraction sdk.Dec) (sdk.Coins, error) {
+	operator, err := k.GetOperator(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	taken := sdk.NewCoins()
+	for _, coin := range operator.Collateral {
+		taken = taken.Add(sdk.NewCoin(coin.Denom, fraction.MulInt(coin.Amount).TruncateInt()))
+	}
+	if taken.IsZero() {
+		return taken, nil
+	}
+	operator.Collateral = operator.Collateral.Sub(taken)
+	k.SetOperator(ctx, operator)
+	if err := k.ReduceTotalCollateral(ctx, taken); err != nil {
+		return nil, err
+	}
+	return taken, nil
+}
+
+// JailOperator jails an operator until the given time, or keeps it jailed if it is jailed until a later time.
+// Jailed operators cannot respond to tasks, and their count of missed tasks starts over.
+func (k Keeper) JailOperator(ctx sdk.Context, address sdk.AccAddress, until time.Time) error {
+	operator, err := k.GetOperator(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !operator.Jailed || until.After(operator.JailedUntil) {
+		operator.JailedUntil = until
+	}
+	operator.Jailed = true
+	operator.MissedTasks = 0
+	k.SetOperator(ctx, operator)
+	return nil
+}
+
+// UnjailOperator unjails an operator whose jail time has passed and whose collateral
+// meets the minimum requirement.
+func (k Keeper) UnjailOperator(ctx sdk.Context, address sdk.AccAddress) error {
+	operator, err := k.GetOperator(ctx, address)
+	if err != nil {
+		return err
+	}
+	if !operator.Jailed {
+		return types.ErrOperatorNotJailed
+	}
+	if ctx.BlockTime().Before(operator.JailedUntil) {
+		return types.ErrOperatorStillJailed
+	}
+	if k.IsBelowMinCollateral(ctx, operator.Collateral) {
+		return types.ErrNoEnoughCollateral
+	}
+	operator.Jailed = false
+	operator.JailedUntil = time.Time{}
+	k.SetOperator(ctx, operator)
+	return nil
+}
+
+// IsJailed determines if an address belongs to a jailed operator.
+func (k Keeper) IsJailed(ctx sdk.Context, address sdk.AccAddress) bool {
+	operator, err := k.GetOperator(ctx, address)
+	return err == nil && operator.Jailed
+}
+
 // AddReward increases an operators accumulated rewards.
 func (k Keeper) AddReward(ctx sdk.Context, address sdk.AccAddress, increment sdk.Coins) error {
 	if !k.IsOperator(ctx, address) {
@@ -29,3 +29,15 @@ func (k Keeper) GetLockedPoolParams(ctx sdk.Context) types.LockedPoolParams {
 	k.paramSpace.Get(ctx, types.ParamsStoreKeyPoolParams, &poolParams)
 	return poolParams
 }
+
+// SetSlashingParams sets the current slashing params to the global param store.
+func (k Keeper) SetSlashingParams(ctx sdk.Context, slashingParams types.SlashingParams) {
+	k.paramSpace.Set(ctx, types.ParamsStoreKeySlashingParams, &slashingParams)
+}
+
+// GetSlashingParams gets the current slashing params from the global param store.
+func (k Keeper) GetSlashingParams(ctx sdk.Context) types.SlashingParams {
+	var slashingParams types.SlashingParams
+	k.paramSpace.Get(ctx, types.ParamsStoreKeySlashingParams, &slashingParams)
+	return slashingParams
+}
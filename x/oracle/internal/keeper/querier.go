@@ -13,11 +13,12 @@ import (
 )
 
 const (
-	QueryOperator  = "operator"
-	QueryOperators = "operators"
-	QueryWithdraws = "withdraws"
-	QueryTask      = "task"
-	QueryResponse  = "response"
+	QueryOperator    = "operator"
+	QueryOperators   = "operators"
+	QueryWithdraws   = "withdraws"
+	QueryTask        = "task"
+	QueryTaskHistory = "task_history"
+	QueryResponse    = "response"
 )
 
 // NewQuerier is the module level router for state queries.
@@ -32,6 +33,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryWithdraws(ctx, path[1:], keeper)
 		case QueryTask:
 			return queryTask(ctx, path[1:], req, keeper)
+		case QueryTaskHistory:
+			return queryTaskHistory(ctx, path[1:], req, keeper)
 		case QueryResponse:
 			return queryResponse(ctx, path[1:], req, keeper)
 		default:
@@ -131,7 +134,12 @@ func queryTask(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
-	task, err := keeper.GetTask(ctx, params.Contract, params.Function)
+	var task types.Task
+	if params.ID != 0 {
+		task, err = keeper.GetTaskByID(ctx, params.ID)
+	} else {
+		task, err = keeper.GetTask(ctx, params.Contract, params.Function)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -141,3 +149,24 @@ func queryTask(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Kee
 	}
 	return res, nil
 }
+
+// queryTaskHistory returns the tasks of a contract and function from the newest to the oldest.
+func queryTaskHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 0); err != nil {
+		return nil, err
+	}
+	var params types.QueryTaskHistoryParams
+	err = keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	tasks := keeper.GetTaskHistory(ctx, params.Contract, params.Function, params.Limit)
+	if tasks == nil {
+		tasks = []types.Task{}
+	}
+	res, err = codec.MarshalJSONIndent(keeper.cdc, tasks)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
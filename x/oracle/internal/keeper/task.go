@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/binary"
+	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/certikfoundation/shentu/x/oracle/internal/types"
 )
@@ -12,51 +16,95 @@ var (
 	amplifier = sdk.NewInt(1000000)
 )
 
-// SetTask sets a task in KVStore.
+// SetTask sets a task in KVStore and indexes it in the task history of its contract and function.
 func (k Keeper) SetTask(ctx sdk.Context, task types.Task) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.TaskStoreKey(task.Contract, task.Function), k.cdc.MustMarshalBinaryLengthPrefixed(task))
+	store.Set(types.TaskStoreKey(task.ID), k.cdc.MustMarshalBinaryLengthPrefixed(task))
+	store.Set(types.TaskHistoryKey(task.Contract, task.Function, task.ID), sdk.Uint64ToBigEndian(task.ID))
 }
 
 // DeleteTask deletes a task from KVStore.
 func (k Keeper) DeleteTask(ctx sdk.Context, task types.Task) error {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.TaskStoreKey(task.Contract, task.Function))
+	store.Delete(types.TaskStoreKey(task.ID))
+	store.Delete(types.TaskHistoryKey(task.Contract, task.Function, task.ID))
 	return nil
 }
 
-// UpdateAndSetTask updates a task and set it in KVStore.
-func (k Keeper) UpdateAndSetTask(ctx sdk.Context, task types.Task) {
-	task.ClosingBlock = ctx.BlockHeight() + task.WaitingBlocks
+// GetNextTaskID gets the next unused task ID.
+func (k Keeper) GetNextTaskID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextTaskIDKey())
+	if bz == nil {
+		return 1
+	}
+	return binary.LittleEndian.Uint64(bz)
+}
+
+// SetNextTaskID sets the next unused task ID.
+func (k Keeper) SetNextTaskID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, id)
+	ctx.KVStore(k.storeKey).Set(types.NextTaskIDKey(), bz)
+}
+
+// UpdateAndSetTask sets an imported task, whose closing block is relative to the export height, in KVStore.
+func (k Keeper) UpdateAndSetTask(ctx sdk.Context, task types.Task) types.Task {
+	task.ClosingBlock += ctx.BlockHeight()
 	k.SetTask(ctx, task)
-	if task.WaitingBlocks > 0 {
+	if task.ClosingBlock > ctx.BlockHeight() {
 		k.SetClosingBlockStore(ctx, task)
 	}
+	return task
 }
 
 // SetClosingBlockStore sets the store of the aggregation block for a task.
 func (k Keeper) SetClosingBlockStore(ctx sdk.Context, task types.Task) {
 	store := ctx.KVStore(k.storeKey)
 	taskIDs := k.GetClosingTaskIDs(ctx, task.ClosingBlock)
-	taskIDs = append(taskIDs, types.TaskID{Contract: task.Contract, Function: task.Function})
+	taskIDs = append(taskIDs, task.ID)
 	store.Set(types.ClosingTaskIDsStoreKey(task.ClosingBlock), k.cdc.MustMarshalBinaryLengthPrefixed(taskIDs))
 }
 
-// GetTask returns a task given contract and function.
-func (k Keeper) GetTask(ctx sdk.Context, contract, function string) (types.Task, error) {
-	TaskData := ctx.KVStore(k.storeKey).Get(types.TaskStoreKey(contract, function))
-	if TaskData == nil {
+// GetTaskByID returns a task given its ID.
+func (k Keeper) GetTaskByID(ctx sdk.Context, id uint64) (types.Task, error) {
+	taskData := ctx.KVStore(k.storeKey).Get(types.TaskStoreKey(id))
+	if taskData == nil {
 		return types.Task{}, types.ErrTaskNotExists
 	}
 	var task types.Task
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(TaskData, &task)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(taskData, &task)
 	return task, nil
 }
 
+// GetTask returns the latest task given contract and function.
+func (k Keeper) GetTask(ctx sdk.Context, contract, function string) (types.Task, error) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.TaskHistoryPrefix(contract, function))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.Task{}, types.ErrTaskNotExists
+	}
+	return k.GetTaskByID(ctx, binary.BigEndian.Uint64(iterator.Value()))
+}
+
+// GetTaskHistory returns the tasks of a contract and function from the newest to the oldest.
+// A non-positive limit returns all of them.
+func (k Keeper) GetTaskHistory(ctx sdk.Context, contract, function string, limit int) (tasks []types.Task) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.TaskHistoryPrefix(contract, function))
+	defer iterator.Close()
+	for ; iterator.Valid() && (limit <= 0 || len(tasks) < limit); iterator.Next() {
+		task, err := k.GetTaskByID(ctx, binary.BigEndian.Uint64(iterator.Value()))
+		if err != nil {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return
+}
+
 // GetClosingTaskIDs returns a list of task IDs by the closing block.
-func (k Keeper) GetClosingTaskIDs(ctx sdk.Context, closingBlock int64) []types.TaskID {
+func (k Keeper) GetClosingTaskIDs(ctx sdk.Context, closingBlock int64) []uint64 {
 	closingTaskIDsData := ctx.KVStore(k.storeKey).Get(types.ClosingTaskIDsStoreKey(closingBlock))
-	var closingTaskIDs []types.TaskID
+	var closingTaskIDs []uint64
 	if closingTaskIDsData != nil {
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(closingTaskIDsData, &closingTaskIDs)
 	}
@@ -68,29 +116,111 @@ func (k Keeper) DeleteClosingTaskIDs(ctx sdk.Context, closingBlock int64) {
 	ctx.KVStore(k.storeKey).Delete(types.ClosingTaskIDsStoreKey(closingBlock))
 }
 
-// CreateTask creates a new task.
+// ScheduleReopen schedules the next round of a recurring task, Interval blocks after it closes.
+func (k Keeper) ScheduleReopen(ctx sdk.Context, task types.Task) {
+	reopenBlock := task.ClosingBlock + task.Interval
+	if reopenBlock <= ctx.BlockHeight() {
+		reopenBlock = ctx.BlockHeight() + 1
+	}
+	// Keep the IDs sorted, so that the queue does not depend on the order in which tasks closed.
+	taskIDs := append(k.GetReopenTaskIDs(ctx, reopenBlock), task.ID)
+	sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+	ctx.KVStore(k.storeKey).Set(types.ReopenTaskIDsStoreKey(reopenBlock), k.cdc.MustMarshalBinaryLengthPrefixed(taskIDs))
+}
+
+// GetReopenTaskIDs returns a list of IDs of recurring tasks to reopen at the given block.
+func (k Keeper) GetReopenTaskIDs(ctx sdk.Context, reopenBlock int64) []uint64 {
+	reopenTaskIDsData := ctx.KVStore(k.storeKey).Get(types.ReopenTaskIDsStoreKey(reopenBlock))
+	var reopenTaskIDs []uint64
+	if reopenTaskIDsData != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(reopenTaskIDsData, &reopenTaskIDs)
+	}
+	return reopenTaskIDs
+}
+
+// DeleteReopenTaskIDs deletes stores for task IDs reopened at given block.
+func (k Keeper) DeleteReopenTaskIDs(ctx sdk.Context, reopenBlock int64) {
+	ctx.KVStore(k.storeKey).Delete(types.ReopenTaskIDsStoreKey(reopenBlock))
+}
+
+// CreateTask creates a new task and returns its ID. The task follows the latest task of
+// the same contract and function, which must be closed and must not recur anymore.
 func (k Keeper) CreateTask(ctx sdk.Context, contract string, function string, bounty sdk.Coins,
-	description string, expiration time.Time, creator sdk.AccAddress, waitingBlocks int64) error {
-	task, err := k.GetTask(ctx, contract, function)
-	if err == nil {
+	description string, expiration time.Time, creator sdk.AccAddress, waitingBlocks int64,
+	interval int64, prepaid sdk.Coins, strategy types.AggregationStrategy) (uint64, error) {
+	var parent uint64
+	if task, err := k.GetTask(ctx, contract, function); err == nil {
 		if task.ClosingBlock > ctx.BlockHeight() {
-			return types.ErrTaskNotClosed
+			return 0, types.ErrTaskNotClosed
 		}
-		if err := k.DeleteTask(ctx, task); err != nil {
-			return err
+		if task.CanReopen() {
+			return 0, types.ErrTaskRecurring
 		}
+		parent = task.ID
+	}
+	if err := k.CollectBounty(ctx, bounty.Add(prepaid...), creator); err != nil {
+		return 0, err
 	}
 	closingBlock := ctx.BlockHeight() + waitingBlocks
-	task = types.NewTask(contract, function, ctx.BlockHeight(), bounty, description, expiration, creator, closingBlock, waitingBlocks)
+	task := types.NewTask(contract, function, ctx.BlockHeight(), bounty, description, expiration, creator, closingBlock, waitingBlocks)
+	task.ID = k.GetNextTaskID(ctx)
+	task.Parent = parent
+	task.Interval = interval
+	task.Prepaid = prepaid
+	task.Strategy = strategy
+	task.RevealWindow = k.revealWindow(ctx, waitingBlocks)
+	k.SetNextTaskID(ctx, task.ID+1)
 	k.SetTask(ctx, task)
 	k.SetClosingBlockStore(ctx, task)
-	if err := k.CollectBounty(ctx, bounty, creator); err != nil {
-		return err
+	return task.ID, nil
+}
+
+// revealWindow returns the number of blocks at the end of a task's window in which committed responses
+// are revealed. It is at most half of the window, so that operators have time to commit.
+func (k Keeper) revealWindow(ctx sdk.Context, waitingBlocks int64) int64 {
+	revealWindow := k.GetTaskParams(ctx).RevealWindow
+	if revealWindow > waitingBlocks/2 {
+		revealWindow = waitingBlocks / 2
 	}
-	return nil
+	return revealWindow
 }
 
-// RemoveTask removes a task from kvstore if it is closed, expired and requested by its creator.
+// ReopenTask opens the next round of a recurring task, paying its bounty from the
+// prepaid bounty, and returns the new round.
+func (k Keeper) ReopenTask(ctx sdk.Context, id uint64) (types.Task, error) {
+	task, err := k.GetTaskByID(ctx, id)
+	if err != nil {
+		return types.Task{}, err
+	}
+	if latest, err := k.GetTask(ctx, task.Contract, task.Function); err != nil || latest.ID != task.ID {
+		return types.Task{}, sdkerrors.Wrapf(types.ErrInvalidRecurrence, "task %d has been superseded", id)
+	}
+	if !task.CanReopen() {
+		return types.Task{}, sdkerrors.Wrapf(types.ErrInvalidRecurrence, "prepaid bounty of task %d is used up", id)
+	}
+
+	// Rounds after the first one expire after the default duration.
+	expiration := ctx.BlockTime().Add(k.GetTaskParams(ctx).ExpirationDuration)
+	round := types.NewTask(task.Contract, task.Function, ctx.BlockHeight(), task.Bounty, task.Description,
+		expiration, task.Creator, ctx.BlockHeight()+task.WaitingBlocks, task.WaitingBlocks)
+	round.ID = k.GetNextTaskID(ctx)
+	round.Parent = task.ID
+	round.Interval = task.Interval
+	round.Prepaid = task.Prepaid.Sub(task.Bounty)
+	round.Strategy = task.Strategy
+	round.RevealWindow = task.RevealWindow
+	k.SetNextTaskID(ctx, round.ID+1)
+
+	// The remaining prepaid bounty moves to the new round.
+	task.Prepaid = sdk.NewCoins()
+	k.SetTask(ctx, task)
+	k.SetTask(ctx, round)
+	k.SetClosingBlockStore(ctx, round)
+	return round, nil
+}
+
+// RemoveTask removes the latest task of a contract and function from kvstore if it is closed,
+// expired and requested by its creator. The remaining prepaid bounty is refunded to the creator.
 func (k Keeper) RemoveTask(ctx sdk.Context, contract, function string, force bool, creator sdk.AccAddress) error {
 	task, err := k.GetTask(ctx, contract, function)
 	if err != nil {
@@ -112,6 +242,9 @@ func (k Keeper) RemoveTask(ctx sdk.Context, contract, function string, force boo
 	if err != nil {
 		return err
 	}
+	if !task.Prepaid.IsZero() {
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, task.Creator, task.Prepaid)
+	}
 	return nil
 }
 
@@ -140,10 +273,10 @@ func (k Keeper) GetAllTasks(ctx sdk.Context) (tasks []types.Task) {
 	return
 }
 
-// UpdateAndGetAllTasks updates all tasks and returns them.
+// UpdateAndGetAllTasks returns all tasks for export, with their closing blocks relative to the current height.
 func (k Keeper) UpdateAndGetAllTasks(ctx sdk.Context) (tasks []types.Task) {
 	k.IteratorAllTasks(ctx, func(task types.Task) bool {
-		task.WaitingBlocks = task.ClosingBlock - ctx.BlockHeight()
+		task.ClosingBlock = task.ClosingBlock - ctx.BlockHeight()
 		tasks = append(tasks, task)
 		return false
 	})
@@ -155,10 +288,8 @@ func (k Keeper) IsValidResponse(ctx sdk.Context, task types.Task, response types
 	if ctx.BlockHeight() > task.ClosingBlock {
 		return types.ErrTaskClosed
 	}
-	for _, r := range task.Responses {
-		if r.Operator.Equals(response.Operator) {
-			return types.ErrDuplicateResponse
-		}
+	if task.HasResponse(response.Operator) {
+		return types.ErrDuplicateResponse
 	}
 	if response.Score.LT(types.MinScore) || response.Score.GT(types.MaxScore) {
 		return types.ErrInvalidScore
@@ -166,16 +297,22 @@ func (k Keeper) IsValidResponse(ctx sdk.Context, task types.Task, response types
 	return nil
 }
 
-// RespondToTask records the response from an operator for a task.
+// RespondToTask records the response from an operator for the latest task of a contract and function.
 func (k Keeper) RespondToTask(ctx sdk.Context, contract string, function string, score int64, operatorAddress sdk.AccAddress) error {
 	if !k.IsOperator(ctx, operatorAddress) {
 		return types.ErrUnqualifiedOperator
 	}
+	if k.IsJailed(ctx, operatorAddress) {
+		return types.ErrOperatorJailed
+	}
 
 	task, err := k.GetTask(ctx, contract, function)
 	if err != nil {
 		return err
 	}
+	if task.IsCommitReveal() {
+		return types.ErrCommitRevealRequired
+	}
 
 	response := types.NewResponse(sdk.NewInt(score), operatorAddress)
 	err = k.IsValidResponse(ctx, task, response)
@@ -189,47 +326,89 @@ func (k Keeper) RespondToTask(ctx sdk.Context, contract string, function string,
 	return nil
 }
 
-// Aggregate does an aggregation of responses for a task and updated task result.
-func (k Keeper) Aggregate(ctx sdk.Context, contract, function string) error {
-	taskParams := k.GetTaskParams(ctx)
+// CommitToTask records the hash of a response from an operator for the latest task of a contract
+// and function, before the reveal phase of the task.
+func (k Keeper) CommitToTask(ctx sdk.Context, contract, function string, hash []byte, operatorAddress sdk.AccAddress) error {
+	if !k.IsOperator(ctx, operatorAddress) {
+		return types.ErrUnqualifiedOperator
+	}
+	if k.IsJailed(ctx, operatorAddress) {
+		return types.ErrOperatorJailed
+	}
+
+	task, err := k.GetTask(ctx, contract, function)
+	if err != nil {
+		return err
+	}
+	if !task.IsCommitReveal() || ctx.BlockHeight() >= task.RevealBlock() {
+		return types.ErrNotCommitPhase
+	}
+	if _, ok := task.GetCommit(operatorAddress); ok {
+		return types.ErrDuplicateResponse
+	}
+
+	task.Commits = append(task.Commits, types.TaskCommit{Operator: operatorAddress, Hash: hash})
+	k.SetTask(ctx, task)
+	return nil
+}
+
+// RevealTaskResponse records the response from an operator for the latest task of a contract and
+// function during the reveal phase of the task, if it matches the commit of the operator.
+func (k Keeper) RevealTaskResponse(ctx sdk.Context, contract, function string, score int64, salt string,
+	operatorAddress sdk.AccAddress) error {
 	task, err := k.GetTask(ctx, contract, function)
 	if err != nil {
 		return err
 	}
+	if !task.IsCommitReveal() || ctx.BlockHeight() < task.RevealBlock() {
+		return types.ErrNotRevealPhase
+	}
+	commit, ok := task.GetCommit(operatorAddress)
+	if !ok {
+		return types.ErrNoCommitFound
+	}
+
+	response := types.NewResponse(sdk.NewInt(score), operatorAddress)
+	if err := k.IsValidResponse(ctx, task, response); err != nil {
+		return err
+	}
+	if !bytes.Equal(commit.Hash, types.TaskResponseHash(contract, function, score, salt, operatorAddress)) {
+		return types.ErrRevealMismatch
+	}
+
+	task.Responses = append(task.Responses, response)
+	k.SetTask(ctx, task)
+	return nil
+}
+
+// Aggregate does an aggregation of responses for a task with its aggregation strategy and updates
+// the task result. The weight of each response is set to its weight in the result. Only revealed
+// responses are recorded for a commit-reveal task, so commits without a reveal are not counted.
+func (k Keeper) Aggregate(ctx sdk.Context, id uint64) error {
+	taskParams := k.GetTaskParams(ctx)
+	task, err := k.GetTaskByID(ctx, id)
+	if err != nil {
+		return err
+	}
 
 	if task.Status != types.TaskStatusPending {
 		return types.ErrTaskClosed
 	}
 
-	result := taskParams.AggregationResult
-	totalCollateral := sdk.NewInt(0)
-	minScoreCollateral := sdk.NewInt(0)
 	for i, response := range task.Responses {
 		amount, err := k.GetCollateralAmount(ctx, response.Operator)
 		if err != nil {
-			continue
+			amount = sdk.NewInt(0)
 		}
-		result = result.Add(response.Score.Mul(amount))
 		task.Responses[i].Weight = amount
-		totalCollateral = totalCollateral.Add(amount)
-		if response.Score.Equal(types.MinScore) {
-			minScoreCollateral = minScoreCollateral.Add(amount)
-		}
 	}
 
-	if totalCollateral.IsPositive() {
-		if minScoreCollateral.MulRaw(3).GTE(totalCollateral) {
-			result = minScoreCollateral
-			for i, response := range task.Responses {
-				if !response.Score.Equal(types.MinScore) {
-					task.Responses[i].Weight = sdk.NewInt(0)
-				}
-			}
-		} else {
-			result = result.Quo(totalCollateral)
-		}
+	task.Vetoed = task.Strategy.Vetoes(task.Responses)
+	result, ok := task.Strategy.Aggregate(task.Responses)
+	if ok {
 		task.Status = types.TaskStatusSucceeded
 	} else {
+		result = taskParams.AggregationResult
 		task.Status = types.TaskStatusFailed
 	}
 	task.Result = result
@@ -237,107 +416,108 @@ func (k Keeper) Aggregate(ctx sdk.Context, contract, function string) error {
 	return nil
 }
 
-// TotalValidTaskCollateral calculates the total amount of valid collateral of a task.
-func (k Keeper) TotalValidTaskCollateral(ctx sdk.Context, task types.Task) sdk.Int {
-	taskParams := k.GetTaskParams(ctx)
-	totalValidTaskCollateral := sdk.NewInt(0)
-	if task.Result.Equal(types.MinScore) {
-		for _, response := range task.Responses {
-			if response.Score.Equal(types.MinScore) {
-				collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(collateral)
+// IsOutlier determines if a response to an aggregated task deviates from the result by more than
+// the deviation threshold. Responses to a task whose result is vetoed are not outliers, as the
+// result does not come from their scores.
+func (k Keeper) IsOutlier(ctx sdk.Context, task types.Task, response types.Response) bool {
+	if task.Status != types.TaskStatusSucceeded || task.Vetoed {
+		return false
+	}
+	deviation := response.Score.Sub(task.Result)
+	if deviation.IsNegative() {
+		deviation = deviation.Neg()
+	}
+	return deviation.GT(k.GetSlashingParams(ctx).DeviationThreshold)
+}
+
+// UpdateMissedTasks counts an aggregated task as missed by the registered operators who have not
+// responded to it, and starts the count of the responding operators over. Tasks without responses
+// and jailed operators are not counted, and nothing is counted without a maximum of missed tasks.
+// It returns the operators who have missed the maximum number of tasks in a row.
+func (k Keeper) UpdateMissedTasks(ctx sdk.Context, task types.Task) []sdk.AccAddress {
+	maxMissedTasks := k.GetSlashingParams(ctx).MaxMissedTasks
+	if maxMissedTasks == 0 || len(task.Responses) == 0 {
+		return nil
+	}
+
+	var missing []sdk.AccAddress
+	for _, operator := range k.GetAllOperators(ctx) {
+		switch {
+		case task.HasResponse(operator.Address):
+			if operator.MissedTasks == 0 {
+				continue
 			}
-		}
-	} else if task.Result.LT(taskParams.ThresholdScore) {
-		for _, response := range task.Responses {
-			if response.Score.LT(taskParams.ThresholdScore) {
-				collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(
-					amplifier.Mul(collateral).Quo(response.Score.Add(taskParams.Epsilon1)),
-				)
+			operator.MissedTasks = 0
+		case operator.Jailed:
+			continue
+		default:
+			operator.MissedTasks++
+			if operator.MissedTasks >= maxMissedTasks {
+				missing = append(missing, operator.Address)
 			}
 		}
-	} else {
-		for _, response := range task.Responses {
-			if response.Score.GTE(taskParams.ThresholdScore) {
-				collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-				if err != nil {
-					continue
-				}
-				totalValidTaskCollateral = totalValidTaskCollateral.Add(
-					amplifier.Mul(collateral).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2)),
-				)
-			}
+		k.SetOperator(ctx, operator)
+	}
+	return missing
+}
+
+// TotalValidTaskCollateral calculates the total amount of valid collateral of a task,
+// counting the responses with their weights in the aggregation result.
+func (k Keeper) TotalValidTaskCollateral(ctx sdk.Context, task types.Task) sdk.Int {
+	totalValidTaskCollateral := sdk.NewInt(0)
+	for _, response := range task.Responses {
+		if share, ok := k.validCollateral(ctx, task, response); ok {
+			totalValidTaskCollateral = totalValidTaskCollateral.Add(share)
 		}
 	}
 	return totalValidTaskCollateral
 }
 
+// validCollateral returns the valid collateral of a response to a task and
+// whether the response is rewarded. Outliers are not rewarded.
+func (k Keeper) validCollateral(ctx sdk.Context, task types.Task, response types.Response) (sdk.Int, bool) {
+	if !response.Weight.IsPositive() || k.IsOutlier(ctx, task, response) {
+		return sdk.Int{}, false
+	}
+	taskParams := k.GetTaskParams(ctx)
+	switch {
+	case task.Result.Equal(types.MinScore):
+		if response.Score.Equal(types.MinScore) {
+			return response.Weight, true
+		}
+	case task.Result.LT(taskParams.ThresholdScore):
+		if response.Score.LT(taskParams.ThresholdScore) {
+			return amplifier.Mul(response.Weight).Quo(response.Score.Add(taskParams.Epsilon1)), true
+		}
+	default:
+		if response.Score.GTE(taskParams.ThresholdScore) {
+			return amplifier.Mul(response.Weight).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2)), true
+		}
+	}
+	return sdk.Int{}, false
+}
+
 // TODO: this is a simplified version (without confidence calculation)
-// DistributeBounty distributes bounty to operators based on responses and the aggregation result.
+// DistributeBounty distributes bounty to operators based on responses, their weights
+// in the aggregation and the aggregation result.
 func (k Keeper) DistributeBounty(ctx sdk.Context, task types.Task) error {
-	taskParams := k.GetTaskParams(ctx)
 	totalValidTaskCollateral := k.TotalValidTaskCollateral(ctx, task)
 	if totalValidTaskCollateral.IsZero() {
 		return types.ErrTaskFailed
 	}
 
 	for _, bounty := range task.Bounty {
-		if task.Result.Equal(types.MinScore) {
-			for i, response := range task.Responses {
-				if response.Score.Equal(types.MinScore) {
-					collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(collateral).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
-					if err := k.AddReward(ctx, response.Operator, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
-			}
-		} else if task.Result.LT(taskParams.ThresholdScore) {
-			for i, response := range task.Responses {
-				if response.Score.LT(taskParams.ThresholdScore) {
-					collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(
-						amplifier.Mul(collateral).Quo(response.Score.Add(taskParams.Epsilon1)),
-					).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(task.Bounty[0].Denom, amount))
-					if err := k.AddReward(ctx, response.Operator, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
+		for i, response := range task.Responses {
+			share, ok := k.validCollateral(ctx, task, response)
+			if !ok {
+				continue
 			}
-		} else {
-			for i, response := range task.Responses {
-				if response.Score.GTE(taskParams.ThresholdScore) {
-					collateral, err := k.GetCollateralAmount(ctx, response.Operator)
-					if err != nil {
-						continue
-					}
-					amount := bounty.Amount.Mul(
-						amplifier.Mul(collateral).Quo(types.MaxScore.Sub(response.Score).Add(taskParams.Epsilon2)),
-					).Quo(totalValidTaskCollateral)
-					reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
-					if err := k.AddReward(ctx, response.Operator, reward); err != nil {
-						continue
-					}
-					task.Responses[i].Reward = reward
-				}
+			amount := bounty.Amount.Mul(share).Quo(totalValidTaskCollateral)
+			reward := sdk.NewCoins(sdk.NewCoin(bounty.Denom, amount))
+			if err := k.AddReward(ctx, response.Operator, reward); err != nil {
+				continue
 			}
+			task.Responses[i].Reward = reward
 		}
 	}
 	k.SetTask(ctx, task)
@@ -0,0 +1,250 @@
+package types
+
+import (
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AggregationType is the type for the way responses to a task are combined into its result.
+type AggregationType byte
+
+// Aggregation types
+const (
+	AggregationTypeWeightedMean AggregationType = iota
+	AggregationTypeWeightedMedian
+	AggregationTypeTrimmedMean
+	AggregationTypeNil AggregationType = 0xFF
+)
+
+// DefaultVetoThreshold is the share of collateral responding with the minimum score that vetoes
+// the result of a task, for strategies without a veto threshold.
+var DefaultVetoThreshold = sdk.OneDec().QuoInt64(3)
+
+// String returns the string for an aggregation type.
+func (t AggregationType) String() string {
+	switch t {
+	case AggregationTypeWeightedMean:
+		return "WeightedMean"
+	case AggregationTypeWeightedMedian:
+		return "WeightedMedian"
+	case AggregationTypeTrimmedMean:
+		return "TrimmedMean"
+	default:
+		return "UnknownAggregationType"
+	}
+}
+
+// AggregationTypeFromString returns the aggregation type given a string.
+// An empty string is the weighted mean.
+func AggregationTypeFromString(s string) AggregationType {
+	switch strings.ToUpper(strings.NewReplacer("-", "", "_", "", " ", "").Replace(s)) {
+	case "", "MEAN", "WEIGHTEDMEAN":
+		return AggregationTypeWeightedMean
+	case "MEDIAN", "WEIGHTEDMEDIAN":
+		return AggregationTypeWeightedMedian
+	case "TRIMMEDMEAN":
+		return AggregationTypeTrimmedMean
+	default:
+		return AggregationTypeNil
+	}
+}
+
+// AggregationStrategy defines how the responses to a task are combined into its result.
+// Responses are weighted by the collateral of their operators. When the share of collateral
+// responding with the minimum score reaches VetoThreshold, the result is the minimum score.
+// A zero VetoThreshold is the default one. TrimRatio is the share of collateral trimmed from
+// each end of the scores by the trimmed mean.
+type AggregationStrategy struct {
+	Type          AggregationType `json:"type"`
+	VetoThreshold sdk.Dec         `json:"veto_threshold"`
+	TrimRatio     sdk.Dec         `json:"trim_ratio"`
+}
+
+// NewAggregationStrategy returns a new aggregation strategy.
+func NewAggregationStrategy(aggregationType AggregationType, vetoThreshold, trimRatio sdk.Dec) AggregationStrategy {
+	return AggregationStrategy{
+		Type:          aggregationType,
+		VetoThreshold: vetoThreshold,
+		TrimRatio:     trimRatio,
+	}
+}
+
+// ParseAggregationStrategy returns the aggregation strategy given the names of its type, veto
+// threshold and trim ratio. Empty strings are the weighted mean and the default parameters.
+func ParseAggregationStrategy(aggregationType, vetoThreshold, trimRatio string) (AggregationStrategy, error) {
+	strategy := NewAggregationStrategy(AggregationTypeFromString(aggregationType), sdk.ZeroDec(), sdk.ZeroDec())
+	if strategy.Type == AggregationTypeNil {
+		return AggregationStrategy{}, sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "unknown aggregation type %s", aggregationType)
+	}
+	var err error
+	if vetoThreshold != "" {
+		if strategy.VetoThreshold, err = sdk.NewDecFromStr(vetoThreshold); err != nil {
+			return AggregationStrategy{}, sdkerrors.Wrap(ErrInvalidAggregationStrategy, err.Error())
+		}
+	}
+	if trimRatio != "" {
+		if strategy.TrimRatio, err = sdk.NewDecFromStr(trimRatio); err != nil {
+			return AggregationStrategy{}, sdkerrors.Wrap(ErrInvalidAggregationStrategy, err.Error())
+		}
+	}
+	return strategy, strategy.ValidateBasic()
+}
+
+// ValidateBasic runs stateless checks on the strategy.
+func (s AggregationStrategy) ValidateBasic() error {
+	if s.Type != AggregationTypeWeightedMean && s.Type != AggregationTypeWeightedMedian && s.Type != AggregationTypeTrimmedMean {
+		return sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "unknown aggregation type %d", s.Type)
+	}
+	if !s.VetoThreshold.IsNil() && (s.VetoThreshold.IsNegative() || s.VetoThreshold.GT(sdk.OneDec())) {
+		return sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "veto threshold %s is not between 0 and 1", s.VetoThreshold)
+	}
+	if !s.TrimRatio.IsNil() {
+		if s.Type != AggregationTypeTrimmedMean && !s.TrimRatio.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "trim ratio of %s", s.Type)
+		}
+		if s.TrimRatio.IsNegative() || s.TrimRatio.GTE(sdk.NewDecWithPrec(5, 1)) {
+			return sdkerrors.Wrapf(ErrInvalidAggregationStrategy, "trim ratio %s is not between 0 and 0.5", s.TrimRatio)
+		}
+	}
+	return nil
+}
+
+// vetoThreshold returns the veto threshold of the strategy, or the default one if it is zero.
+func (s AggregationStrategy) vetoThreshold() sdk.Dec {
+	if s.VetoThreshold.IsNil() || s.VetoThreshold.IsZero() {
+		return DefaultVetoThreshold
+	}
+	return s.VetoThreshold
+}
+
+// Aggregate combines the scores of responses, whose weights are the collateral of their operators,
+// and sets the weight of each response to its weight in the result. It returns false if the
+// responses have no weight.
+func (s AggregationStrategy) Aggregate(responses Responses) (sdk.Int, bool) {
+	total := totalWeight(responses)
+	if !total.IsPositive() {
+		return sdk.Int{}, false
+	}
+
+	if s.vetoes(responses, total) {
+		for i, response := range responses {
+			if !response.Score.Equal(MinScore) {
+				responses[i].Weight = sdk.ZeroInt()
+			}
+		}
+		return MinScore, true
+	}
+
+	switch s.Type {
+	case AggregationTypeWeightedMedian:
+		return weightedMedian(responses, total), true
+	case AggregationTypeTrimmedMean:
+		trimRatio := s.TrimRatio
+		if trimRatio.IsNil() {
+			trimRatio = sdk.ZeroDec()
+		}
+		return trimmedMean(responses, total, trimRatio), true
+	default:
+		return weightedMean(responses, total), true
+	}
+}
+
+// Vetoes returns whether the responses veto the result of a task, which is then the minimum score.
+func (s AggregationStrategy) Vetoes(responses Responses) bool {
+	total := totalWeight(responses)
+	return total.IsPositive() && s.vetoes(responses, total)
+}
+
+// vetoes returns whether the share of collateral responding with the minimum score reaches the veto threshold.
+func (s AggregationStrategy) vetoes(responses Responses, total sdk.Int) bool {
+	minScoreCollateral := sdk.ZeroInt()
+	for _, response := range responses {
+		if response.Score.Equal(MinScore) {
+			minScoreCollateral = minScoreCollateral.Add(response.Weight)
+		}
+	}
+	return minScoreCollateral.ToDec().GTE(s.vetoThreshold().MulInt(total))
+}
+
+// totalWeight returns the total weight of responses.
+func totalWeight(responses Responses) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, response := range responses {
+		total = total.Add(response.Weight)
+	}
+	return total
+}
+
+// weightedMean returns the mean of the scores weighted by collateral.
+func weightedMean(responses Responses, total sdk.Int) sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, response := range responses {
+		sum = sum.Add(response.Score.Mul(response.Weight))
+	}
+	return sum.Quo(total)
+}
+
+// weightedMedian returns the lowest score at which the responses with lower or equal
+// scores hold at least half of the collateral.
+func weightedMedian(responses Responses, total sdk.Int) sdk.Int {
+	order := scoreOrder(responses)
+	cumulative := sdk.ZeroInt()
+	for _, i := range order {
+		cumulative = cumulative.Add(responses[i].Weight)
+		if cumulative.MulRaw(2).GTE(total) {
+			return responses[i].Score
+		}
+	}
+	return responses[order[len(order)-1]].Score
+}
+
+// trimmedMean trims the given share of collateral from the responses with the lowest
+// scores and from those with the highest scores, and returns the weighted mean of the rest.
+func trimmedMean(responses Responses, total sdk.Int, trimRatio sdk.Dec) sdk.Int {
+	order := scoreOrder(responses)
+	weights := make([]sdk.Dec, len(responses))
+	for i, response := range responses {
+		weights[i] = response.Weight.ToDec()
+	}
+	trim := func(indices []int) {
+		remaining := trimRatio.MulInt(total)
+		for _, i := range indices {
+			if !remaining.IsPositive() {
+				return
+			}
+			cut := sdk.MinDec(weights[i], remaining)
+			weights[i] = weights[i].Sub(cut)
+			remaining = remaining.Sub(cut)
+		}
+	}
+	trim(order)
+	reversed := make([]int, len(order))
+	for i, index := range order {
+		reversed[len(order)-1-i] = index
+	}
+	trim(reversed)
+
+	sum := sdk.ZeroDec()
+	kept := sdk.ZeroDec()
+	for i, response := range responses {
+		sum = sum.Add(weights[i].MulInt(response.Score))
+		kept = kept.Add(weights[i])
+		responses[i].Weight = weights[i].TruncateInt()
+	}
+	return sum.Quo(kept).TruncateInt()
+}
+
+// scoreOrder returns the indices of responses in the ascending order of their scores.
+func scoreOrder(responses Responses) []int {
+	order := make([]int, len(responses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return responses[order[a]].Score.LT(responses[order[b]].Score)
+	})
+	return order
+}
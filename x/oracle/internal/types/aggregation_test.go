@@ -0,0 +1,185 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newTestResponses returns responses with the given scores and weights.
+func newTestResponses(scoresAndWeights ...int64) Responses {
+	var responses Responses
+	for i := 0; i+1 < len(scoresAndWeights); i += 2 {
+		responses = append(responses, Response{
+			Score:  sdk.NewInt(scoresAndWeights[i]),
+			Weight: sdk.NewInt(scoresAndWeights[i+1]),
+		})
+	}
+	return responses
+}
+
+// weightsOf returns the weights of responses.
+func weightsOf(responses Responses) []sdk.Int {
+	weights := make([]sdk.Int, len(responses))
+	for i, response := range responses {
+		weights[i] = response.Weight
+	}
+	return weights
+}
+
+func TestWeightedMedian(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses Responses
+		median    int64
+	}{
+		{"single response", newTestResponses(70, 1), 70},
+		{"odd count", newTestResponses(10, 1, 50, 1, 90, 1), 50},
+		{"even split takes the lower median", newTestResponses(40, 2, 60, 2), 40},
+		{"even count takes the lower median", newTestResponses(10, 1, 20, 1, 30, 1, 40, 1), 20},
+		{"unsorted tie takes the lower median", newTestResponses(90, 3, 10, 3), 10},
+		{"heavy high score", newTestResponses(10, 1, 50, 1, 90, 5), 90},
+		{"heavy low score", newTestResponses(10, 5, 50, 1, 90, 1), 10},
+		{"just short of half", newTestResponses(10, 4, 50, 1, 90, 4), 50},
+		{"equal scores", newTestResponses(30, 1, 30, 1, 80, 1), 30},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := sdk.ZeroInt()
+			for _, response := range tt.responses {
+				total = total.Add(response.Weight)
+			}
+			weights := weightsOf(tt.responses)
+			require.Equal(t, tt.median, weightedMedian(tt.responses, total).Int64())
+			require.Equal(t, weights, weightsOf(tt.responses))
+		})
+	}
+}
+
+func TestTrimmedMean(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses Responses
+		trimRatio sdk.Dec
+		mean      int64
+		weights   []int64
+	}{
+		{
+			"no trim",
+			newTestResponses(10, 1, 20, 1, 90, 2),
+			sdk.ZeroDec(),
+			52,
+			[]int64{1, 1, 2},
+		},
+		{
+			"trim whole responses",
+			newTestResponses(10, 1, 20, 1, 30, 1, 90, 1),
+			sdk.NewDecWithPrec(25, 2),
+			25,
+			[]int64{0, 1, 1, 0},
+		},
+		{
+			"trim through a response at each end",
+			newTestResponses(10, 4, 50, 2, 90, 4),
+			sdk.NewDecWithPrec(25, 2),
+			50,
+			[]int64{1, 2, 1},
+		},
+		{
+			"trim past a response into the next",
+			newTestResponses(10, 4, 60, 2, 90, 2),
+			sdk.NewDecWithPrec(3, 1),
+			35,
+			[]int64{1, 1, 0},
+		},
+		{
+			"unsorted responses",
+			newTestResponses(90, 1, 30, 1, 10, 1, 20, 1),
+			sdk.NewDecWithPrec(25, 2),
+			25,
+			[]int64{0, 1, 0, 1},
+		},
+		{
+			"trim leaves a fraction of a weight",
+			newTestResponses(10, 2, 50, 2, 90, 2),
+			sdk.NewDecWithPrec(25, 2),
+			50,
+			[]int64{0, 2, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := sdk.ZeroInt()
+			for _, response := range tt.responses {
+				total = total.Add(response.Weight)
+			}
+			require.Equal(t, tt.mean, trimmedMean(tt.responses, total, tt.trimRatio).Int64())
+			for i, weight := range tt.weights {
+				require.Equal(t, weight, tt.responses[i].Weight.Int64())
+			}
+		})
+	}
+}
+
+func TestAggregationStrategy_Aggregate(t *testing.T) {
+	mean := NewAggregationStrategy(AggregationTypeWeightedMean, sdk.ZeroDec(), sdk.ZeroDec())
+	median := NewAggregationStrategy(AggregationTypeWeightedMedian, sdk.ZeroDec(), sdk.ZeroDec())
+	trimmed := NewAggregationStrategy(AggregationTypeTrimmedMean, sdk.ZeroDec(), sdk.NewDecWithPrec(25, 2))
+
+	tests := []struct {
+		name      string
+		strategy  AggregationStrategy
+		responses Responses
+		ok        bool
+		result    int64
+		weights   []int64
+	}{
+		{"mean", mean, newTestResponses(0, 1, 80, 3), true, 60, []int64{1, 3}},
+		{"median", median, newTestResponses(0, 1, 80, 3), true, 80, []int64{1, 3}},
+		{"trimmed mean", trimmed, newTestResponses(0, 1, 80, 3), true, 80, []int64{0, 2}},
+		{"mean veto", mean, newTestResponses(0, 1, 80, 2), true, 0, []int64{1, 0}},
+		{"median veto", median, newTestResponses(0, 1, 80, 2), true, 0, []int64{1, 0}},
+		{"trimmed mean veto", trimmed, newTestResponses(0, 1, 80, 2), true, 0, []int64{1, 0}},
+		{
+			"veto with several minimum scores",
+			median,
+			newTestResponses(0, 1, 60, 2, 0, 1, 90, 2),
+			true,
+			0,
+			[]int64{1, 0, 1, 0},
+		},
+		{
+			"veto below a custom threshold",
+			NewAggregationStrategy(AggregationTypeWeightedMean, sdk.NewDecWithPrec(5, 1), sdk.ZeroDec()),
+			newTestResponses(0, 1, 80, 2),
+			true,
+			53,
+			[]int64{1, 2},
+		},
+		{
+			"veto at a custom threshold",
+			NewAggregationStrategy(AggregationTypeTrimmedMean, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(25, 2)),
+			newTestResponses(0, 1, 80, 1),
+			true,
+			0,
+			[]int64{1, 0},
+		},
+		{"nil parameters", AggregationStrategy{Type: AggregationTypeTrimmedMean}, newTestResponses(20, 1, 80, 3), true, 65, []int64{1, 3}},
+		{"no weight", median, newTestResponses(0, 0, 80, 0), false, 0, []int64{0, 0}},
+		{"no responses", mean, nil, false, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := tt.strategy.Aggregate(tt.responses)
+			require.Equal(t, tt.ok, ok)
+			if tt.ok {
+				require.Equal(t, tt.result, result.Int64())
+			}
+			for i, weight := range tt.weights {
+				require.Equal(t, weight, tt.responses[i].Weight.Int64())
+			}
+		})
+	}
+}
@@ -24,4 +24,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgTaskResponse{}, "oracle/RespondToTask", nil)
 	cdc.RegisterConcrete(MsgInquiryTask{}, "oracle/InquiryTask", nil)
 	cdc.RegisterConcrete(MsgDeleteTask{}, "oracle/DeleteTask", nil)
+	cdc.RegisterConcrete(MsgCommitTaskResponse{}, "oracle/CommitTaskResponse", nil)
+	cdc.RegisterConcrete(MsgRevealTaskResponse{}, "oracle/RevealTaskResponse", nil)
+	cdc.RegisterConcrete(MsgUnjailOperator{}, "oracle/UnjailOperator", nil)
 }
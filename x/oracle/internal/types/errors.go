@@ -14,6 +14,10 @@ var (
 	ErrNoEnoughCollateral      = sdkerrors.Register(ModuleName, 107, "collateral not enough")
 	ErrInvalidPoolParams       = sdkerrors.Register(ModuleName, 108, "invalid pool params")
 	ErrInvalidTaskParams       = sdkerrors.Register(ModuleName, 109, "invalid task params")
+	ErrInvalidSlashingParams   = sdkerrors.Register(ModuleName, 110, "invalid slashing params")
+	ErrOperatorJailed          = sdkerrors.Register(ModuleName, 111, "operator is jailed")
+	ErrOperatorNotJailed       = sdkerrors.Register(ModuleName, 112, "operator is not jailed")
+	ErrOperatorStillJailed     = sdkerrors.Register(ModuleName, 113, "operator jail time has not passed")
 
 	ErrTaskNotExists       = sdkerrors.Register(ModuleName, 201, "task does not exist")
 	ErrUnqualifiedOperator = sdkerrors.Register(ModuleName, 202, "operator is not qualified")
@@ -25,6 +29,15 @@ var (
 	ErrNotFinished         = sdkerrors.Register(ModuleName, 208, "the task is on going")
 	ErrTaskFailed          = sdkerrors.Register(ModuleName, 209, "task failed")
 	ErrInvalidScore        = sdkerrors.Register(ModuleName, 210, "invalid score")
+	ErrInvalidRecurrence   = sdkerrors.Register(ModuleName, 211, "invalid task recurrence")
+	ErrTaskRecurring       = sdkerrors.Register(ModuleName, 212, "task is recurring")
+
+	ErrInvalidAggregationStrategy = sdkerrors.Register(ModuleName, 213, "invalid aggregation strategy")
+	ErrCommitRevealRequired       = sdkerrors.Register(ModuleName, 214, "task only accepts committed responses")
+	ErrNotCommitPhase             = sdkerrors.Register(ModuleName, 215, "task is not accepting committed responses")
+	ErrNotRevealPhase             = sdkerrors.Register(ModuleName, 216, "task is not accepting revealed responses")
+	ErrNoCommitFound              = sdkerrors.Register(ModuleName, 217, "no committed response was found")
+	ErrRevealMismatch             = sdkerrors.Register(ModuleName, 218, "revealed response does not match the commit")
 
 	ErrInconsistentOperators = sdkerrors.Register(ModuleName, 301, "two operators not consistent")
 )
@@ -10,4 +10,10 @@ const (
 	EventTypeRespondToTask    = "respond_to_task"
 	EventTypeInquireTask      = "inquire_task"
 	EventTypeDeleteTask       = "delete_task"
+	EventTypeReopenTask       = "reopen_task"
+	EventTypeCommitResponse   = "commit_task_response"
+	EventTypeRevealResponse   = "reveal_task_response"
+	EventTypePenalizeOperator = "penalize_operator"
+	EventTypeSlashOperator    = "slash_operator"
+	EventTypeUnjailOperator   = "unjail_operator"
 )
@@ -12,20 +12,24 @@ type GenesisState struct {
 	TotalCollateral sdk.Coins        `json:"total_collateral"`
 	PoolParams      LockedPoolParams `json:"pool_params"`
 	TaskParams      TaskParams       `json:"task_params"`
+	SlashingParams  SlashingParams   `json:"slashing_params"`
 	Withdraws       []Withdraw       `json:"withdraws"`
 	Tasks           []Task           `json:"tasks"`
+	NextTaskID      uint64           `json:"next_task_id"`
 }
 
 // NewGenesisState constructs a GenesisState object.
 func NewGenesisState(operators []Operator, totalCollateral sdk.Coins, poolParams LockedPoolParams, taskParams TaskParams,
-	withdraws []Withdraw, tasks []Task) GenesisState {
+	slashingParams SlashingParams, withdraws []Withdraw, tasks []Task, nextTaskID uint64) GenesisState {
 	return GenesisState{
 		Operators:       operators,
 		TotalCollateral: totalCollateral,
 		PoolParams:      poolParams,
 		TaskParams:      taskParams,
+		SlashingParams:  slashingParams,
 		Withdraws:       withdraws,
 		Tasks:           tasks,
+		NextTaskID:      nextTaskID,
 	}
 }
 
@@ -36,8 +40,10 @@ func DefaultGenesisState() GenesisState {
 		nil,
 		DefaultLockedPoolParams(),
 		DefaultTaskParams(),
+		DefaultSlashingParams(),
 		nil,
 		nil,
+		1,
 	)
 }
 
@@ -61,8 +67,25 @@ func ValidateGenesis(bz json.RawMessage) error {
 	if !sum.IsEqual(gs.TotalCollateral) {
 		panic(ErrTotalCollateralNotEqual)
 	}
+	// Tasks without IDs are given IDs at genesis.
+	taskIDs := make(map[uint64]bool)
+	for _, task := range gs.Tasks {
+		if task.ID == 0 {
+			continue
+		}
+		if task.ID >= gs.NextTaskID || taskIDs[task.ID] {
+			return fmt.Errorf("invalid %s genesis state: invalid task ID %d", ModuleName, task.ID)
+		}
+		taskIDs[task.ID] = true
+	}
 	if gs.PoolParams.LockedInBlocks < 0 || gs.PoolParams.MinimumCollateral < 0 {
 		panic(ErrInvalidPoolParams)
 	}
+	// Genesis states exported before slashing have no slashing params, and take the default ones.
+	if !gs.SlashingParams.SlashFractionDeviation.IsNil() {
+		if err := validateSlashingParams(gs.SlashingParams); err != nil {
+			return err
+		}
+	}
 	return nil
 }
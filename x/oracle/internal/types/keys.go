@@ -26,6 +26,9 @@ var (
 	TotalCollateralKeyPrefix  = []byte{0x03}
 	TaskStoreKeyPrefix        = []byte{0x04}
 	ClosingTaskStoreKeyPrefix = []byte{0x05}
+	NextTaskIDKeyPrefix       = []byte{0x06}
+	TaskHistoryKeyPrefix      = []byte{0x07}
+	ReopenTaskStoreKeyPrefix  = []byte{0x08}
 )
 
 func OperatorStoreKey(operator sdk.AccAddress) []byte {
@@ -42,8 +45,28 @@ func TotalCollateralKey() []byte {
 	return TotalCollateralKeyPrefix
 }
 
-func TaskStoreKey(contract, function string) []byte {
-	return append(append(TaskStoreKeyPrefix, []byte(contract)...), []byte(function)...)
+// TaskStoreKey returns the key of a task. IDs are big-endian so that tasks are iterated in the order of creation.
+func TaskStoreKey(id uint64) []byte {
+	return append(TaskStoreKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+func NextTaskIDKey() []byte {
+	return NextTaskIDKeyPrefix
+}
+
+// TaskHistoryPrefix returns the prefix of the task history of a contract and function.
+// Both are length-prefixed so that no pair is a prefix of another.
+func TaskHistoryPrefix(contract, function string) []byte {
+	b := make([]byte, 4)
+	key := append([]byte{}, TaskHistoryKeyPrefix...)
+	binary.BigEndian.PutUint32(b, uint32(len(contract)))
+	key = append(append(key, b...), []byte(contract)...)
+	binary.BigEndian.PutUint32(b, uint32(len(function)))
+	return append(append(key, b...), []byte(function)...)
+}
+
+func TaskHistoryKey(contract, function string, id uint64) []byte {
+	return append(TaskHistoryPrefix(contract, function), sdk.Uint64ToBigEndian(id)...)
 }
 
 func ClosingTaskIDsStoreKey(blockHeight int64) []byte {
@@ -51,3 +74,9 @@ func ClosingTaskIDsStoreKey(blockHeight int64) []byte {
 	binary.LittleEndian.PutUint64(b, uint64(blockHeight))
 	return append(ClosingTaskStoreKeyPrefix, b...)
 }
+
+func ReopenTaskIDsStoreKey(blockHeight int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(blockHeight))
+	return append(ReopenTaskStoreKeyPrefix, b...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"time"
 
@@ -224,6 +225,46 @@ func (m MsgWithdrawReward) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Address}
 }
 
+// MsgUnjailOperator is the message for unjailing an operator after its jail time.
+type MsgUnjailOperator struct {
+	Address sdk.AccAddress
+}
+
+// NewMsgUnjailOperator returns the message for unjailing an operator.
+func NewMsgUnjailOperator(address sdk.AccAddress) MsgUnjailOperator {
+	return MsgUnjailOperator{
+		Address: address,
+	}
+}
+
+// Route returns the module name.
+func (MsgUnjailOperator) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (MsgUnjailOperator) Type() string { return EventTypeUnjailOperator }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgUnjailOperator) ValidateBasic() error {
+	if m.Address == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, string(m.Address.Bytes()))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgUnjailOperator) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgUnjailOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
+
 // MsgCreateTask is the message for creating a task.
 type MsgCreateTask struct {
 	Contract      string
@@ -233,11 +274,17 @@ type MsgCreateTask struct {
 	Creator       sdk.AccAddress
 	Wait          int64
 	ValidDuration time.Duration
+	Interval      int64
+	Prepaid       sdk.Coins
+	Strategy      AggregationStrategy
 }
 
-// NewMsgCreateTask returns a new message for creating a task.
+// NewMsgCreateTask returns a new message for creating a task. A positive interval makes
+// the task recur, with the bounty of the following rounds paid from the prepaid amount.
+// The strategy defines how the responses are aggregated.
 func NewMsgCreateTask(contract, function string, bounty sdk.Coins, description string,
-	creator sdk.AccAddress, wait int64, validDuration time.Duration) MsgCreateTask {
+	creator sdk.AccAddress, wait int64, validDuration time.Duration, interval int64, prepaid sdk.Coins,
+	strategy AggregationStrategy) MsgCreateTask {
 	return MsgCreateTask{
 		Contract:      contract,
 		Function:      function,
@@ -246,6 +293,9 @@ func NewMsgCreateTask(contract, function string, bounty sdk.Coins, description s
 		Creator:       creator,
 		Wait:          wait,
 		ValidDuration: validDuration,
+		Interval:      interval,
+		Prepaid:       prepaid,
+		Strategy:      strategy,
 	}
 }
 
@@ -257,7 +307,19 @@ func (MsgCreateTask) Type() string { return EventTypeCreateTask }
 
 // ValidateBasic runs stateless checks on the message.
 func (m MsgCreateTask) ValidateBasic() error {
-	return nil
+	if m.Interval < 0 {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "negative interval")
+	}
+	if !m.Prepaid.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Prepaid.String())
+	}
+	if m.Interval == 0 && !m.Prepaid.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "prepaid bounty of a task that does not recur")
+	}
+	if m.Interval > 0 && (m.Bounty.IsZero() || !m.Prepaid.IsAllGTE(m.Bounty)) {
+		return sdkerrors.Wrap(ErrInvalidRecurrence, "prepaid bounty must cover the bounty of at least one more round")
+	}
+	return m.Strategy.ValidateBasic()
 }
 
 // GetSignBytes encodes the message for signing.
@@ -317,6 +379,98 @@ func (m MsgTaskResponse) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Operator}
 }
 
+// MsgCommitTaskResponse is the message for committing to a response to a task.
+type MsgCommitTaskResponse struct {
+	Contract string
+	Function string
+	Hash     []byte
+	Operator sdk.AccAddress
+}
+
+// NewMsgCommitTaskResponse returns a new message for committing to a response to a task.
+func NewMsgCommitTaskResponse(contract, function string, hash []byte, operator sdk.AccAddress) MsgCommitTaskResponse {
+	return MsgCommitTaskResponse{
+		Contract: contract,
+		Function: function,
+		Hash:     hash,
+		Operator: operator,
+	}
+}
+
+// Route returns the module name.
+func (MsgCommitTaskResponse) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (MsgCommitTaskResponse) Type() string { return EventTypeCommitResponse }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgCommitTaskResponse) ValidateBasic() error {
+	if len(m.Hash) != sha256.Size {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid response hash length %d", len(m.Hash))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgCommitTaskResponse) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgCommitTaskResponse) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Operator}
+}
+
+// MsgRevealTaskResponse is the message for revealing a committed response to a task.
+type MsgRevealTaskResponse struct {
+	Contract string
+	Function string
+	Score    int64
+	Salt     string
+	Operator sdk.AccAddress
+}
+
+// NewMsgRevealTaskResponse returns a new message for revealing a committed response to a task.
+func NewMsgRevealTaskResponse(contract, function string, score int64, salt string,
+	operator sdk.AccAddress) MsgRevealTaskResponse {
+	return MsgRevealTaskResponse{
+		Contract: contract,
+		Function: function,
+		Score:    score,
+		Salt:     salt,
+		Operator: operator,
+	}
+}
+
+// Route returns the module name.
+func (MsgRevealTaskResponse) Route() string { return ModuleName }
+
+// Type returns the action name.
+func (MsgRevealTaskResponse) Type() string { return EventTypeRevealResponse }
+
+// ValidateBasic runs stateless checks on the message.
+func (m MsgRevealTaskResponse) ValidateBasic() error {
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (m MsgRevealTaskResponse) GetSignBytes() []byte {
+	b, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required.
+func (m MsgRevealTaskResponse) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Operator}
+}
+
 // MsgInquiryTask is the message for inquiry a task.
 type MsgInquiryTask struct {
 	Contract string
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,6 +14,9 @@ type Operator struct {
 	Collateral         sdk.Coins      `json:"collateral"`
 	AccumulatedRewards sdk.Coins      `json:"accumulated_rewards"`
 	Name               string         `json:"name"`
+	MissedTasks        int64          `json:"missed_tasks"`
+	Jailed             bool           `json:"jailed"`
+	JailedUntil        time.Time      `json:"jailed_until"`
 }
 
 // NewOperator returns an Operator object.
@@ -34,8 +38,12 @@ func (o Operator) String() string {
   Proposer: %s
   Collatetal: %s
   AccumulatedRewards: %s
-  Name: %s`,
-		o.Address, o.Proposer, o.Collateral.String(), o.AccumulatedRewards.String(), o.Name)
+  Name: %s
+  MissedTasks: %d
+  Jailed: %t
+  JailedUntil: %s`,
+		o.Address, o.Proposer, o.Collateral.String(), o.AccumulatedRewards.String(), o.Name,
+		o.MissedTasks, o.Jailed, o.JailedUntil)
 }
 
 type Operators []Operator
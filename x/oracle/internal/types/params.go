@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	ParamsStoreKeyTaskParams = []byte("taskparams")
-	ParamsStoreKeyPoolParams = []byte("poolparams")
+	ParamsStoreKeyTaskParams     = []byte("taskparams")
+	ParamsStoreKeyPoolParams     = []byte("poolparams")
+	ParamsStoreKeySlashingParams = []byte("slashingparams")
 )
 
 // Default parameters
@@ -25,9 +26,18 @@ var (
 	DefaultAggregationWindow  = int64(20)
 	DefaultEpsilon1           = sdk.NewInt(1)
 	DefaultEpsilon2           = sdk.NewInt(100)
+	DefaultRevealWindow       = int64(5)
+	DefaultNonRevealPenalty   = sdk.NewDecWithPrec(1, 2)
 
 	DefaultLockedInBlocks    = int64(30)
 	DefaultMinimumCollateral = int64(50000)
+
+	DefaultDeviationThreshold     = sdk.NewInt(40)
+	DefaultSlashFractionDeviation = sdk.NewDecWithPrec(5, 3)
+	DefaultMaxMissedTasks         = int64(50)
+	DefaultSlashFractionMissing   = sdk.NewDecWithPrec(1, 2)
+	DefaultJailDuration           = time.Duration(24) * time.Hour
+	DefaultBurnSlashed            = false
 )
 
 // ParamKeyTable is the key declaration for parameters.
@@ -35,6 +45,7 @@ func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		params.NewParamSetPair(ParamsStoreKeyTaskParams, TaskParams{}, validateTaskParams),
 		params.NewParamSetPair(ParamsStoreKeyPoolParams, LockedPoolParams{}, validatePoolParams),
+		params.NewParamSetPair(ParamsStoreKeySlashingParams, SlashingParams{}, validateSlashingParams),
 	)
 }
 
@@ -45,11 +56,14 @@ type TaskParams struct {
 	ThresholdScore     sdk.Int       `json:"task_threshold_score"`
 	Epsilon1           sdk.Int       `json:"task_epsilon1"`
 	Epsilon2           sdk.Int       `json:"task_epsilon2"`
+	RevealWindow       int64         `json:"task_reveal_window"`
+	NonRevealPenalty   sdk.Dec       `json:"task_non_reveal_penalty"`
 }
 
-// NewTaskParams returns a TaskParams object.
+// NewTaskParams returns a TaskParams object. A positive reveal window makes operators
+// commit to their responses before revealing them in the last blocks of a task.
 func NewTaskParams(expirationDuration time.Duration, aggregationWindow int64, aggregationResult,
-	thresholdScore, epsilon1, epsilon2 sdk.Int) TaskParams {
+	thresholdScore, epsilon1, epsilon2 sdk.Int, revealWindow int64, nonRevealPenalty sdk.Dec) TaskParams {
 	return TaskParams{
 		ExpirationDuration: expirationDuration,
 		AggregationWindow:  aggregationWindow,
@@ -57,13 +71,16 @@ func NewTaskParams(expirationDuration time.Duration, aggregationWindow int64, ag
 		ThresholdScore:     thresholdScore,
 		Epsilon1:           epsilon1,
 		Epsilon2:           epsilon2,
+		RevealWindow:       revealWindow,
+		NonRevealPenalty:   nonRevealPenalty,
 	}
 }
 
 // DefaultTaskParams generates default set for TaskParams.
 func DefaultTaskParams() TaskParams {
 	return NewTaskParams(DefaultExpirationDuration, DefaultAggregationWindow,
-		DefaultAggregationResult, DefaultThresholdScore, DefaultEpsilon1, DefaultEpsilon2)
+		DefaultAggregationResult, DefaultThresholdScore, DefaultEpsilon1, DefaultEpsilon2,
+		DefaultRevealWindow, DefaultNonRevealPenalty)
 }
 
 func validateTaskParams(i interface{}) error {
@@ -75,7 +92,14 @@ func validateTaskParams(i interface{}) error {
 		taskParams.AggregationWindow < 0 ||
 		taskParams.ThresholdScore.GT(MaxScore) ||
 		taskParams.Epsilon1.LT(sdk.NewInt(0)) ||
-		taskParams.Epsilon2.LT(sdk.NewInt(0)) {
+		taskParams.Epsilon2.LT(sdk.NewInt(0)) ||
+		taskParams.RevealWindow < 0 {
+		return ErrInvalidTaskParams
+	}
+	// The penalty is a share of the collateral, which must not be taken entirely.
+	// A missing penalty from params without commit-reveal is no penalty.
+	if !taskParams.NonRevealPenalty.IsNil() && (taskParams.NonRevealPenalty.IsNegative() ||
+		taskParams.NonRevealPenalty.GTE(sdk.OneDec())) {
 		return ErrInvalidTaskParams
 	}
 	return nil
@@ -113,6 +137,60 @@ func validatePoolParams(i interface{}) error {
 	return nil
 }
 
+// SlashingParams defines when operators are slashed and jailed. A response deviating from the result
+// of a task by more than DeviationThreshold loses SlashFractionDeviation of the collateral of its
+// operator. An operator missing MaxMissedTasks tasks in a row loses SlashFractionMissing of its
+// collateral. Slashed operators are jailed for JailDuration, and the slashed collateral is burned
+// if BurnSlashed is true, or sent to the community pool otherwise.
+type SlashingParams struct {
+	DeviationThreshold     sdk.Int       `json:"deviation_threshold"`
+	SlashFractionDeviation sdk.Dec       `json:"slash_fraction_deviation"`
+	MaxMissedTasks         int64         `json:"max_missed_tasks"`
+	SlashFractionMissing   sdk.Dec       `json:"slash_fraction_missing"`
+	JailDuration           time.Duration `json:"jail_duration"`
+	BurnSlashed            bool          `json:"burn_slashed"`
+}
+
+// NewSlashingParams returns a SlashingParams object. A zero maximum of missed tasks
+// disables slashing operators for missing tasks.
+func NewSlashingParams(deviationThreshold sdk.Int, slashFractionDeviation sdk.Dec, maxMissedTasks int64,
+	slashFractionMissing sdk.Dec, jailDuration time.Duration, burnSlashed bool) SlashingParams {
+	return SlashingParams{
+		DeviationThreshold:     deviationThreshold,
+		SlashFractionDeviation: slashFractionDeviation,
+		MaxMissedTasks:         maxMissedTasks,
+		SlashFractionMissing:   slashFractionMissing,
+		JailDuration:           jailDuration,
+		BurnSlashed:            burnSlashed,
+	}
+}
+
+// DefaultSlashingParams generates default set for SlashingParams.
+func DefaultSlashingParams() SlashingParams {
+	return NewSlashingParams(DefaultDeviationThreshold, DefaultSlashFractionDeviation, DefaultMaxMissedTasks,
+		DefaultSlashFractionMissing, DefaultJailDuration, DefaultBurnSlashed)
+}
+
+func validateSlashingParams(i interface{}) error {
+	slashingParams, ok := i.(SlashingParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if slashingParams.DeviationThreshold.IsNegative() ||
+		slashingParams.DeviationThreshold.GT(MaxScore) ||
+		slashingParams.MaxMissedTasks < 0 ||
+		slashingParams.JailDuration < 0 {
+		return ErrInvalidSlashingParams
+	}
+	// Slash fractions are shares of the collateral, which must not be taken entirely.
+	for _, fraction := range []sdk.Dec{slashingParams.SlashFractionDeviation, slashingParams.SlashFractionMissing} {
+		if fraction.IsNil() || fraction.IsNegative() || fraction.GTE(sdk.OneDec()) {
+			return ErrInvalidSlashingParams
+		}
+	}
+	return nil
+}
+
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
@@ -8,10 +8,14 @@ const (
 	QueryOperators   = "operators"
 	QueryWithdrawals = "withdrawals"
 	QueryTask        = "task"
+	QueryTaskHistory = "task_history"
 	QueryResponse    = "response"
 )
 
+// QueryTaskParams queries a task by its ID or, when the ID is zero, the latest
+// task of a contract and function.
 type QueryTaskParams struct {
+	ID       uint64
 	Contract string
 	Function string
 }
@@ -24,6 +28,29 @@ func NewQueryTaskParams(contract string, function string) QueryTaskParams {
 	}
 }
 
+// NewQueryTaskByIDParams returns a QueryTaskParams object querying a task by its ID.
+func NewQueryTaskByIDParams(id uint64) QueryTaskParams {
+	return QueryTaskParams{
+		ID: id,
+	}
+}
+
+// QueryTaskHistoryParams queries the tasks of a contract and function from the newest to the oldest.
+type QueryTaskHistoryParams struct {
+	Contract string
+	Function string
+	Limit    int
+}
+
+// NewQueryTaskHistoryParams returns a QueryTaskHistoryParams object.
+func NewQueryTaskHistoryParams(contract string, function string, limit int) QueryTaskHistoryParams {
+	return QueryTaskHistoryParams{
+		Contract: contract,
+		Function: function,
+		Limit:    limit,
+	}
+}
+
 type QueryResponseParams struct {
 	Contract string
 	Function string
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/json"
 	"time"
 
@@ -32,18 +34,26 @@ func (t TaskStatus) String() string {
 
 // Task defines the data structure of a task.
 type Task struct {
-	Contract      string         `json:"contract"`
-	Function      string         `json:"function"`
-	BeginBlock    int64          `json:"begin_block"`
-	Bounty        sdk.Coins      `json:"bounty"`
-	Description   string         `json:"string"`
-	Expiration    time.Time      `json:"expiration"`
-	Creator       sdk.AccAddress `json:"creator"`
-	Responses     Responses      `json:"responses"`
-	Result        sdk.Int        `json:"result"`
-	ClosingBlock  int64          `json:"closing_block"`
-	WaitingBlocks int64          `json:"waiting_blocks"`
-	Status        TaskStatus     `json:"status"`
+	ID            uint64              `json:"id"`
+	Parent        uint64              `json:"parent"`
+	Contract      string              `json:"contract"`
+	Function      string              `json:"function"`
+	BeginBlock    int64               `json:"begin_block"`
+	Bounty        sdk.Coins           `json:"bounty"`
+	Description   string              `json:"string"`
+	Expiration    time.Time           `json:"expiration"`
+	Creator       sdk.AccAddress      `json:"creator"`
+	Responses     Responses           `json:"responses"`
+	Result        sdk.Int             `json:"result"`
+	ClosingBlock  int64               `json:"closing_block"`
+	WaitingBlocks int64               `json:"waiting_blocks"`
+	Status        TaskStatus          `json:"status"`
+	Interval      int64               `json:"interval"`
+	Prepaid       sdk.Coins           `json:"prepaid"`
+	Strategy      AggregationStrategy `json:"strategy"`
+	RevealWindow  int64               `json:"reveal_window"`
+	Commits       TaskCommits         `json:"commits"`
+	Vetoed        bool                `json:"vetoed"`
 }
 
 // NewTask returns a new task.
@@ -72,10 +82,80 @@ func NewTask(
 	}
 }
 
-// TaskID defines the data structure of the ID of a task.
-type TaskID struct {
-	Contract string `json:"contract"`
-	Function string `json:"function"`
+// IsRecurring returns whether the task re-opens after it closes.
+func (t Task) IsRecurring() bool {
+	return t.Interval > 0
+}
+
+// CanReopen returns whether the prepaid bounty of a recurring task covers another round.
+func (t Task) CanReopen() bool {
+	return t.IsRecurring() && !t.Bounty.IsZero() && t.Prepaid.IsAllGTE(t.Bounty)
+}
+
+// IsCommitReveal returns whether operators respond to the task by committing to their
+// responses first and revealing them in the last RevealWindow blocks.
+func (t Task) IsCommitReveal() bool {
+	return t.RevealWindow > 0
+}
+
+// RevealBlock returns the first block of the reveal phase of a commit-reveal task.
+func (t Task) RevealBlock() int64 {
+	return t.ClosingBlock - t.RevealWindow + 1
+}
+
+// GetCommit returns the commit of an operator to the task.
+func (t Task) GetCommit(operator sdk.AccAddress) (TaskCommit, bool) {
+	for _, commit := range t.Commits {
+		if commit.Operator.Equals(operator) {
+			return commit, true
+		}
+	}
+	return TaskCommit{}, false
+}
+
+// HasResponse returns whether an operator has responded to the task.
+func (t Task) HasResponse(operator sdk.AccAddress) bool {
+	for _, response := range t.Responses {
+		if response.Operator.Equals(operator) {
+			return true
+		}
+	}
+	return false
+}
+
+// NonRevealers returns the operators who committed to a response to the task but did not reveal it.
+func (t Task) NonRevealers() []sdk.AccAddress {
+	var operators []sdk.AccAddress
+	for _, commit := range t.Commits {
+		if !t.HasResponse(commit.Operator) {
+			operators = append(operators, commit.Operator)
+		}
+	}
+	return operators
+}
+
+// TaskCommit defines the data structure of a committed response, which is the hash of
+// the response to be revealed later.
+type TaskCommit struct {
+	Operator sdk.AccAddress `json:"operator"`
+	Hash     []byte         `json:"hash"`
+}
+
+// TaskCommits defines a list of committed responses.
+type TaskCommits []TaskCommit
+
+// TaskResponseHash returns the hash an operator commits to for a response to a task. The
+// operator is part of the hash, so that other operators cannot reveal a copied commit.
+func TaskResponseHash(contract, function string, score int64, salt string, operator sdk.AccAddress) []byte {
+	var bz []byte
+	for _, field := range [][]byte{[]byte(contract), []byte(function), []byte(salt), operator} {
+		length := make([]byte, 4)
+		binary.BigEndian.PutUint32(length, uint32(len(field)))
+		bz = append(append(bz, length...), field...)
+	}
+	bz = append(bz, sdk.Uint64ToBigEndian(uint64(score))...)
+	hash := sha256.Sum256(bz)
+	return hash[:]
 }
 
 // Response defines the data structure of a response.
@@ -0,0 +1,386 @@
+package oracle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/simapp"
+	"github.com/certikfoundation/shentu/x/oracle"
+	"github.com/certikfoundation/shentu/x/oracle/internal/types"
+)
+
+func TestTask_ExportKeepsWindow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(80000*1e6))
+	bounty := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000))
+	strategy := types.NewAggregationStrategy(types.AggregationTypeWeightedMean, sdk.ZeroDec(), sdk.ZeroDec())
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "contract", "function", bounty, "",
+		ctx.BlockTime().Add(time.Hour), addrs[0], 10, 5, bounty.Add(bounty...), strategy)
+	require.NoError(t, err)
+
+	// The closing block is exported relative to the export height, while the window is kept.
+	ctx = ctx.WithBlockHeight(4)
+	genesis := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Len(t, genesis.Tasks, 1)
+	require.Equal(t, int64(10), genesis.Tasks[0].WaitingBlocks)
+	require.Equal(t, int64(7), genesis.Tasks[0].ClosingBlock)
+
+	task, err := app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), task.WaitingBlocks)
+	require.Equal(t, int64(11), task.ClosingBlock)
+
+	importApp := simapp.Setup(false)
+	importCtx := importApp.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 2})
+	oracle.InitGenesis(importCtx, importApp.OracleKeeper, genesis)
+	task, err = importApp.OracleKeeper.GetTaskByID(importCtx, id)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), task.WaitingBlocks)
+	require.Equal(t, int64(9), task.ClosingBlock)
+	require.Equal(t, []uint64{id}, importApp.OracleKeeper.GetClosingTaskIDs(importCtx, 9))
+
+	// The next round of the imported task opens with the same window.
+	importCtx = importCtx.WithBlockHeight(9)
+	oracle.EndBlocker(importCtx, importApp.OracleKeeper)
+	importCtx = importCtx.WithBlockHeight(14)
+	oracle.EndBlocker(importCtx, importApp.OracleKeeper)
+	round, err := importApp.OracleKeeper.GetTask(importCtx, "contract", "function")
+	require.NoError(t, err)
+	require.Equal(t, id, round.Parent)
+	require.Equal(t, int64(10), round.WaitingBlocks)
+	require.Equal(t, int64(24), round.ClosingBlock)
+}
+
+func TestTask_DistributeBountyTrimmed(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(80000*1e6))
+	bounty := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000000))
+	strategy := types.NewAggregationStrategy(types.AggregationTypeTrimmedMean, sdk.ZeroDec(), sdk.NewDecWithPrec(25, 2))
+	taskParams := app.OracleKeeper.GetTaskParams(ctx)
+	taskParams.RevealWindow = 0
+	app.OracleKeeper.SetTaskParams(ctx, taskParams)
+
+	id, err := app.OracleKeeper.CreateTask(ctx, "contract", "function", bounty, "",
+		ctx.BlockTime().Add(time.Hour), addrs[4], 10, 0, nil, strategy)
+	require.NoError(t, err)
+
+	tests := []struct {
+		score      int64
+		collateral int64
+		weight     int64
+		reward     int64
+	}{
+		// partly trimmed, but on the other side of the threshold score from the result
+		{10, 100000, 10000, 0},
+		{60, 100000, 100000, 550458},
+		// partly trimmed
+		{80, 100000, 70000, 449541},
+		// trimmed out
+		{95, 60000, 0, 0},
+	}
+	for i, tt := range tests {
+		collateral := sdk.NewCoins(sdk.NewInt64Coin("uctk", tt.collateral))
+		require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[i], collateral, addrs[4], ""))
+		require.NoError(t, app.OracleKeeper.RespondToTask(ctx, "contract", "function", tt.score, addrs[i]))
+	}
+
+	require.NoError(t, app.OracleKeeper.Aggregate(ctx, id))
+	task, err := app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatus(types.TaskStatusSucceeded), task.Status)
+	require.Equal(t, int64(65), task.Result.Int64())
+	require.NoError(t, app.OracleKeeper.DistributeBounty(ctx, task))
+
+	task, err = app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	for i, tt := range tests {
+		response := task.Responses[i]
+		require.Equal(t, tt.weight, response.Weight.Int64())
+		operator, err := app.OracleKeeper.GetOperator(ctx, addrs[i])
+		require.NoError(t, err)
+		if tt.reward == 0 {
+			require.True(t, response.Reward.Empty())
+			require.True(t, operator.AccumulatedRewards.Empty())
+			continue
+		}
+		reward := sdk.NewCoins(sdk.NewInt64Coin("uctk", tt.reward))
+		require.Equal(t, reward, response.Reward)
+		require.Equal(t, reward, operator.AccumulatedRewards)
+	}
+}
+
+func TestTask_CommitReveal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 5, sdk.NewInt(80000*1e6))
+	bounty := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000000))
+	collateral := sdk.NewCoins(sdk.NewInt64Coin("uctk", 100000))
+	strategy := types.NewAggregationStrategy(types.AggregationTypeWeightedMean, sdk.ZeroDec(), sdk.ZeroDec())
+	for _, addr := range addrs[:4] {
+		require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addr, collateral, addrs[4], ""))
+	}
+
+	// Tasks are committed to by default.
+	id, err := app.OracleKeeper.CreateTask(ctx, "contract", "function", bounty, "",
+		ctx.BlockTime().Add(time.Hour), addrs[4], 10, 0, nil, strategy)
+	require.NoError(t, err)
+	task, err := app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	require.True(t, task.IsCommitReveal())
+	require.Equal(t, types.DefaultRevealWindow, task.RevealWindow)
+	require.Equal(t, int64(7), task.RevealBlock())
+	require.Equal(t, types.ErrCommitRevealRequired,
+		app.OracleKeeper.RespondToTask(ctx, "contract", "function", 80, addrs[0]))
+
+	// commit phase
+	hash := func(score int64, salt string, i int) []byte {
+		return types.TaskResponseHash("contract", "function", score, salt, addrs[i])
+	}
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(80, "salt0", 0), addrs[0]))
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(60, "salt1", 1), addrs[1]))
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(70, "salt2", 2), addrs[2]))
+	require.Equal(t, types.ErrDuplicateResponse,
+		app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(90, "salt0", 0), addrs[0]))
+	require.Equal(t, types.ErrUnqualifiedOperator,
+		app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(90, "salt4", 4), addrs[4]))
+	ctx = ctx.WithBlockHeight(6)
+	require.Equal(t, types.ErrNotRevealPhase,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 80, "salt0", addrs[0]))
+
+	// reveal phase
+	ctx = ctx.WithBlockHeight(7)
+	require.Equal(t, types.ErrNotCommitPhase,
+		app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash(50, "salt3", 3), addrs[3]))
+	require.Equal(t, types.ErrNoCommitFound,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 50, "salt3", addrs[3]))
+	require.NoError(t, app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 80, "salt0", addrs[0]))
+	require.Equal(t, types.ErrDuplicateResponse,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 80, "salt0", addrs[0]))
+
+	// A reveal must match the score, the salt and the operator of the commit.
+	require.Equal(t, types.ErrRevealMismatch,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 61, "salt1", addrs[1]))
+	require.Equal(t, types.ErrRevealMismatch,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 60, "salt", addrs[1]))
+	require.Equal(t, types.ErrRevealMismatch,
+		app.OracleKeeper.RevealTaskResponse(ctx, "contract", "function", 60, "salt1", addrs[2]))
+	task, err = app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, task.Commits, 3)
+	require.Len(t, task.Responses, 1)
+	require.Equal(t, addrs[1:3], task.NonRevealers())
+
+	// Only the revealed response is aggregated, and the operators who did not reveal are penalized.
+	ctx = ctx.WithBlockHeight(11)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	task, err = app.OracleKeeper.GetTaskByID(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatus(types.TaskStatusSucceeded), task.Status)
+	require.Equal(t, int64(80), task.Result.Int64())
+
+	penalty := types.DefaultNonRevealPenalty.MulInt64(100000).TruncateInt64()
+	for i, amount := range []int64{100000, 100000 - penalty, 100000 - penalty, 100000} {
+		operator, err := app.OracleKeeper.GetOperator(ctx, addrs[i])
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uctk", amount)), operator.Collateral)
+		require.False(t, operator.Jailed)
+	}
+	totalCollateral, err := app.OracleKeeper.GetTotalCollateral(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(400000-2*penalty), totalCollateral.AmountOf("uctk").Int64())
+}
+
+func TestOperator_SlashOutliers(t *testing.T) {
+	mean := types.NewAggregationStrategy(types.AggregationTypeWeightedMean, sdk.ZeroDec(), sdk.ZeroDec())
+	median := types.NewAggregationStrategy(types.AggregationTypeWeightedMedian, sdk.ZeroDec(), sdk.ZeroDec())
+	trimmed := types.NewAggregationStrategy(types.AggregationTypeTrimmedMean, sdk.ZeroDec(), sdk.NewDecWithPrec(25, 2))
+
+	tests := []struct {
+		name     string
+		strategy types.AggregationStrategy
+		scores   []int64
+		vetoed   bool
+		result   int64
+		slashed  []bool
+	}{
+		{"mean veto", mean, []int64{0, 80, 80}, true, 0, []bool{false, false, false}},
+		{"median veto", median, []int64{0, 90, 20}, true, 0, []bool{false, false, false}},
+		{"trimmed mean veto", trimmed, []int64{0, 0, 70, 70, 70, 70}, true, 0, []bool{false, false, false, false, false, false}},
+		{"no outliers", mean, []int64{40, 50, 60}, false, 50, []bool{false, false, false}},
+		{"deviation from the result", mean, []int64{10, 40, 50, 60, 90}, false, 50, []bool{true, false, false, false, true}},
+		{"deviation of a trimmed response", trimmed, []int64{10, 50, 55, 60}, false, 52, []bool{true, false, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 1})
+			addrs := simapp.AddTestAddrs(app, ctx, len(tt.scores)+1, sdk.NewInt(80000*1e6))
+			creator := addrs[len(tt.scores)]
+			bounty := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000000))
+			collateral := sdk.NewCoins(sdk.NewInt64Coin("uctk", 100000))
+			taskParams := app.OracleKeeper.GetTaskParams(ctx)
+			taskParams.RevealWindow = 0
+			app.OracleKeeper.SetTaskParams(ctx, taskParams)
+			slashingParams := app.OracleKeeper.GetSlashingParams(ctx)
+			slashingParams.DeviationThreshold = sdk.NewInt(20)
+			app.OracleKeeper.SetSlashingParams(ctx, slashingParams)
+
+			id, err := app.OracleKeeper.CreateTask(ctx, "contract", "function", bounty, "",
+				ctx.BlockTime().Add(time.Hour), creator, 5, 0, nil, tt.strategy)
+			require.NoError(t, err)
+			for i, score := range tt.scores {
+				require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addrs[i], collateral, creator, ""))
+				require.NoError(t, app.OracleKeeper.RespondToTask(ctx, "contract", "function", score, addrs[i]))
+			}
+
+			ctx = ctx.WithBlockHeight(6)
+			oracle.EndBlocker(ctx, app.OracleKeeper)
+			task, err := app.OracleKeeper.GetTaskByID(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, types.TaskStatus(types.TaskStatusSucceeded), task.Status)
+			require.Equal(t, tt.vetoed, task.Vetoed)
+			require.Equal(t, tt.result, task.Result.Int64())
+
+			slashed := slashingParams.SlashFractionDeviation.MulInt64(100000).TruncateInt64()
+			for i, isSlashed := range tt.slashed {
+				operator, err := app.OracleKeeper.GetOperator(ctx, addrs[i])
+				require.NoError(t, err)
+				require.Equal(t, isSlashed, operator.Jailed)
+				amount := int64(100000)
+				if isSlashed {
+					amount -= slashed
+					require.Equal(t, ctx.BlockTime().Add(slashingParams.JailDuration), operator.JailedUntil)
+				}
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uctk", amount)), operator.Collateral)
+				if isSlashed {
+					require.True(t, task.Responses[i].Reward.IsZero())
+				}
+			}
+		})
+	}
+}
+
+func TestOperator_MissedTasksAndUnjail(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC(), Height: 1})
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(80000*1e6))
+	creator := addrs[3]
+	bounty := sdk.NewCoins(sdk.NewInt64Coin("uctk", 1000000))
+	collateral := sdk.NewCoins(sdk.NewInt64Coin("uctk", 100000))
+	strategy := types.NewAggregationStrategy(types.AggregationTypeWeightedMean, sdk.ZeroDec(), sdk.ZeroDec())
+	slashingParams := app.OracleKeeper.GetSlashingParams(ctx)
+	slashingParams.MaxMissedTasks = 3
+	app.OracleKeeper.SetSlashingParams(ctx, slashingParams)
+	for _, addr := range addrs[:3] {
+		require.NoError(t, app.OracleKeeper.CreateOperator(ctx, addr, collateral, creator, ""))
+	}
+
+	createTask := func(contract string) {
+		_, err := app.OracleKeeper.CreateTask(ctx, contract, "function", bounty, "",
+			ctx.BlockTime().Add(time.Hour), creator, 10, 0, nil, strategy)
+		require.NoError(t, err)
+	}
+	commit := func(contract string, i int) {
+		hash := types.TaskResponseHash(contract, "function", 80, "salt", addrs[i])
+		require.NoError(t, app.OracleKeeper.CommitToTask(ctx, contract, "function", hash, addrs[i]))
+	}
+	reveal := func(contract string, i int) {
+		require.NoError(t, app.OracleKeeper.RevealTaskResponse(ctx, contract, "function", 80, "salt", addrs[i]))
+	}
+	requireOperators := func(missedTasks []int64, collaterals []int64, jailed []bool) {
+		for i := range missedTasks {
+			operator, err := app.OracleKeeper.GetOperator(ctx, addrs[i])
+			require.NoError(t, err)
+			require.Equal(t, missedTasks[i], operator.MissedTasks)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uctk", collaterals[i])), operator.Collateral)
+			require.Equal(t, jailed[i], operator.Jailed)
+		}
+	}
+
+	// Operator 2 does not respond to any task.
+	createTask("contract")
+	commit("contract", 0)
+	commit("contract", 1)
+	ctx = ctx.WithBlockHeight(7)
+	reveal("contract", 0)
+	reveal("contract", 1)
+	ctx = ctx.WithBlockHeight(11)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	requireOperators([]int64{0, 0, 1}, []int64{100000, 100000, 100000}, []bool{false, false, false})
+
+	// Operator 1 commits without revealing and misses the task. The failed task without
+	// responses is not counted.
+	createTask("contract")
+	createTask("failed")
+	commit("contract", 0)
+	commit("contract", 1)
+	ctx = ctx.WithBlockHeight(17)
+	reveal("contract", 0)
+	ctx = ctx.WithBlockHeight(21)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	failed, err := app.OracleKeeper.GetTask(ctx, "failed", "function")
+	require.NoError(t, err)
+	require.Equal(t, types.TaskStatus(types.TaskStatusFailed), failed.Status)
+	penalty := types.DefaultNonRevealPenalty.MulInt64(100000).TruncateInt64()
+	requireOperators([]int64{0, 1, 2}, []int64{100000, 100000 - penalty, 100000}, []bool{false, false, false})
+
+	// Operator 1 responds again and starts over, while operator 2 misses the maximum number
+	// of tasks in a row and is jailed.
+	createTask("contract")
+	commit("contract", 0)
+	commit("contract", 1)
+	ctx = ctx.WithBlockHeight(27)
+	reveal("contract", 0)
+	reveal("contract", 1)
+	ctx = ctx.WithBlockHeight(31)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	slashed := slashingParams.SlashFractionMissing.MulInt64(100000).TruncateInt64()
+	remaining := 100000 - slashed
+	requireOperators([]int64{0, 0, 0}, []int64{100000, 100000 - penalty, remaining}, []bool{false, false, true})
+	operator, err := app.OracleKeeper.GetOperator(ctx, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(slashingParams.JailDuration), operator.JailedUntil)
+
+	// The jailed operator cannot respond, and does not miss tasks.
+	createTask("contract")
+	hash := types.TaskResponseHash("contract", "function", 80, "salt", addrs[2])
+	require.Equal(t, types.ErrOperatorJailed, app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash, addrs[2]))
+	commit("contract", 0)
+	ctx = ctx.WithBlockHeight(37)
+	reveal("contract", 0)
+	ctx = ctx.WithBlockHeight(41)
+	oracle.EndBlocker(ctx, app.OracleKeeper)
+	requireOperators([]int64{0, 1, 0}, []int64{100000, 100000 - penalty, remaining}, []bool{false, false, true})
+
+	// Operators are unjailed after the jail time, with enough collateral.
+	handler := oracle.NewHandler(app.OracleKeeper)
+	_, err = handler(ctx, oracle.NewMsgUnjailOperator(addrs[0]))
+	require.Equal(t, types.ErrOperatorNotJailed, err)
+	_, err = handler(ctx, oracle.NewMsgUnjailOperator(addrs[2]))
+	require.Equal(t, types.ErrOperatorStillJailed, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(slashingParams.JailDuration))
+	poolParams := app.OracleKeeper.GetLockedPoolParams(ctx)
+	app.OracleKeeper.SetLockedPoolParams(ctx, types.NewLockedPoolParams(poolParams.LockedInBlocks, remaining+1))
+	_, err = handler(ctx, oracle.NewMsgUnjailOperator(addrs[2]))
+	require.Equal(t, types.ErrNoEnoughCollateral, err)
+
+	app.OracleKeeper.SetLockedPoolParams(ctx, poolParams)
+	_, err = handler(ctx, oracle.NewMsgUnjailOperator(addrs[2]))
+	require.NoError(t, err)
+	requireOperators([]int64{0, 1, 0}, []int64{100000, 100000 - penalty, remaining}, []bool{false, false, false})
+	operator, err = app.OracleKeeper.GetOperator(ctx, addrs[2])
+	require.NoError(t, err)
+	require.True(t, operator.JailedUntil.IsZero())
+	createTask("contract")
+	require.NoError(t, app.OracleKeeper.CommitToTask(ctx, "contract", "function", hash, addrs[2]))
+}
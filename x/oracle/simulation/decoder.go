@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	tmkv "github.com/tendermint/tendermint/libs/kv"
@@ -39,12 +40,19 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &taskB)
 		return fmt.Sprintf("%v\n%v", taskA, taskB)
 
-	case bytes.Equal(kvA.Key[:1], types.ClosingTaskStoreKeyPrefix):
-		var taskIDsA, taskIDsB []types.TaskID
+	case bytes.Equal(kvA.Key[:1], types.ClosingTaskStoreKeyPrefix),
+		bytes.Equal(kvA.Key[:1], types.ReopenTaskStoreKeyPrefix):
+		var taskIDsA, taskIDsB []uint64
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &taskIDsA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &taskIDsB)
 		return fmt.Sprintf("%v\n%v", taskIDsA, taskIDsB)
 
+	case bytes.Equal(kvA.Key[:1], types.NextTaskIDKeyPrefix):
+		return fmt.Sprintf("%d\n%d", binary.LittleEndian.Uint64(kvA.Value), binary.LittleEndian.Uint64(kvB.Value))
+
+	case bytes.Equal(kvA.Key[:1], types.TaskHistoryKeyPrefix):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -37,6 +38,9 @@ func TestDecodeStore(t *testing.T) {
 		Collateral:         RandomCoins(100000),
 		AccumulatedRewards: RandomCoins(100000),
 		Name:               RandomString(10),
+		MissedTasks:        rand.Int63n(100),
+		Jailed:             true,
+		JailedUntil:        time.Now().UTC(),
 	}
 
 	withdraw := types.Withdraw{
@@ -48,6 +52,8 @@ func TestDecodeStore(t *testing.T) {
 	totalCollateral := RandomCoins(1000000)
 
 	task := types.Task{
+		ID:            rand.Uint64(),
+		Parent:        rand.Uint64(),
 		Contract:      RandomString(30),
 		Function:      RandomString(15),
 		Bounty:        RandomCoins(100000),
@@ -59,21 +65,31 @@ func TestDecodeStore(t *testing.T) {
 		ClosingBlock:  rand.Int63n(10000),
 		WaitingBlocks: rand.Int63n(1000),
 		Status:        types.TaskStatus(rand.Intn(4)),
+		Interval:      rand.Int63n(1000),
+		Prepaid:       RandomCoins(100000),
+		Strategy: types.NewAggregationStrategy(types.AggregationTypeTrimmedMean,
+			sdk.NewDecWithPrec(rand.Int63n(100), 2), sdk.NewDecWithPrec(rand.Int63n(50), 2)),
+		RevealWindow: rand.Int63n(10),
+		Commits: types.TaskCommits{{
+			Operator: RandomAccount().Address,
+			Hash:     types.TaskResponseHash(RandomString(30), RandomString(15), rand.Int63n(100), RandomString(10), RandomAccount().Address),
+		}},
 	}
 
-	taskIDs := []types.TaskID{
-		{
-			Contract: task.Contract,
-			Function: task.Function,
-		},
-	}
+	taskIDs := []uint64{task.ID}
+
+	nextTaskID := make([]byte, 8)
+	binary.LittleEndian.PutUint64(nextTaskID, task.ID+1)
 
 	KVPairs := kv.Pairs{
 		kv.Pair{Key: types.OperatorStoreKey(operator.Address), Value: cdc.MustMarshalBinaryLengthPrefixed(&operator)},
 		kv.Pair{Key: types.WithdrawStoreKey(withdraw.Address, withdraw.DueBlock), Value: cdc.MustMarshalBinaryLengthPrefixed(&withdraw)},
 		kv.Pair{Key: types.TotalCollateralKey(), Value: cdc.MustMarshalBinaryLengthPrefixed(&totalCollateral)},
-		kv.Pair{Key: types.TaskStoreKey(task.Contract, task.Function), Value: cdc.MustMarshalBinaryLengthPrefixed(&task)},
+		kv.Pair{Key: types.TaskStoreKey(task.ID), Value: cdc.MustMarshalBinaryLengthPrefixed(&task)},
 		kv.Pair{Key: types.ClosingTaskIDsStoreKey(task.ClosingBlock), Value: cdc.MustMarshalBinaryLengthPrefixed(&taskIDs)},
+		kv.Pair{Key: types.NextTaskIDKey(), Value: nextTaskID},
+		kv.Pair{Key: types.TaskHistoryKey(task.Contract, task.Function, task.ID), Value: sdk.Uint64ToBigEndian(task.ID)},
+		kv.Pair{Key: types.ReopenTaskIDsStoreKey(task.ClosingBlock + task.Interval), Value: cdc.MustMarshalBinaryLengthPrefixed(&taskIDs)},
 	}
 
 	tests := []struct {
@@ -85,6 +101,9 @@ func TestDecodeStore(t *testing.T) {
 		{"TotalCollateral", fmt.Sprintf("%s\n%s", totalCollateral, totalCollateral)},
 		{"Task", fmt.Sprintf("%v\n%v", task, task)},
 		{"TaskIDs", fmt.Sprintf("%v\n%v", taskIDs, taskIDs)},
+		{"NextTaskID", fmt.Sprintf("%d\n%d", task.ID+1, task.ID+1)},
+		{"TaskHistory", fmt.Sprintf("%d\n%d", task.ID, task.ID)},
+		{"ReopenTaskIDs", fmt.Sprintf("%v\n%v", taskIDs, taskIDs)},
 		{"other", ""},
 	}
 
@@ -26,13 +26,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 			taskParams = GenTaskParams(r)
 		})
 
+	var slashingParams types.SlashingParams
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, string(types.ParamsStoreKeySlashingParams), &slashingParams, simState.Rand,
+		func(r *rand.Rand) {
+			slashingParams = GenSlashingParams(r)
+		})
+
 	gs := types.NewGenesisState(
 		nil,
 		nil,
 		poolParams,
 		taskParams,
+		slashingParams,
 		nil,
 		nil,
+		1,
 	)
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
@@ -55,5 +64,19 @@ func GenTaskParams(r *rand.Rand) types.TaskParams {
 		ThresholdScore:     sdk.NewInt(r.Int63n(100)),
 		Epsilon1:           sdk.NewInt(r.Int63n(10)),
 		Epsilon2:           sdk.NewInt(r.Int63n(10) + 90),
+		RevealWindow:       r.Int63n(6),
+		NonRevealPenalty:   sdk.NewDecWithPrec(r.Int63n(10), 2),
+	}
+}
+
+// GenSlashingParams returns a randomized SlashingParams object.
+func GenSlashingParams(r *rand.Rand) types.SlashingParams {
+	return types.SlashingParams{
+		DeviationThreshold:     sdk.NewInt(r.Int63n(50) + 50),
+		SlashFractionDeviation: sdk.NewDecWithPrec(r.Int63n(10), 3),
+		MaxMissedTasks:         r.Int63n(100),
+		SlashFractionMissing:   sdk.NewDecWithPrec(r.Int63n(10), 2),
+		JailDuration:           time.Duration(r.Int63n(60)) * time.Minute,
+		BurnSlashed:            r.Intn(2) == 0,
 	}
 }
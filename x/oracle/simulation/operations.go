@@ -20,6 +20,7 @@ import (
 const (
 	OpWeightMsgCreateOperator = "op_weight_msg_create_operator"
 	OpWeightMsgCreateTask     = "op_weight_msg_create_task"
+	OpWeightMsgUnjailOperator = "op_weight_msg_unjail_operator"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights.
@@ -39,6 +40,13 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgUnjailOperator int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnjailOperator, &weightMsgUnjailOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnjailOperator = simappparams.DefaultWeightMsgUnjail
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateOperator,
@@ -49,6 +57,11 @@ func WeightedOperations(
 			weightMsgCreateTask,
 			SimulateMsgCreateTask(ak, k),
 		),
+
+		simulation.NewWeightedOperation(
+			weightMsgUnjailOperator,
+			SimulateMsgUnjailOperator(k, ak),
+		),
 	}
 }
 
@@ -127,7 +140,7 @@ func SimulateMsgAddCollateral(k keeper.Keeper, ak types.AuthKeeper, stdOperator
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		if err := checkConsistency(operator, *stdOperator); err != nil {
+		if err := checkConsistency(operator, stdOperator); err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
@@ -171,7 +184,7 @@ func SimulateMsgReduceCollateral(k keeper.Keeper, ak types.AuthKeeper, stdOperat
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		if err := checkConsistency(operator, *stdOperator); err != nil {
+		if err := checkConsistency(operator, stdOperator); err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
@@ -220,10 +233,15 @@ func SimulateMsgRemoveOperator(k keeper.Keeper, ak types.AuthKeeper, stdOperator
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		if err := checkConsistency(operator, *stdOperator); err != nil {
+		if err := checkConsistency(operator, stdOperator); err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
+		if operator.Jailed {
+			return simulation.NewOperationMsgBasic(types.ModuleName,
+				"NoOp: operator is jailed, skip this tx", "", false, nil), nil, nil
+		}
+
 		operatorAcc := ak.GetAccount(ctx, operator.Address)
 		fees, err := simulation.RandomFees(r, ctx, operatorAcc.SpendableCoins(ctx.BlockTime()))
 		if err != nil {
@@ -261,7 +279,7 @@ func SimulateMsgWithdrawReward(k keeper.Keeper, ak types.AuthKeeper, stdOperator
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		if err := checkConsistency(operator, *stdOperator); err != nil {
+		if err := checkConsistency(operator, stdOperator); err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
@@ -292,13 +310,74 @@ func SimulateMsgWithdrawReward(k keeper.Keeper, ak types.AuthKeeper, stdOperator
 	}
 }
 
-func checkConsistency(operator1, operator2 types.Operator) error {
-	if !operator1.Address.Equals(operator2.Address) ||
-		!operator1.Proposer.Equals(operator2.Proposer) ||
-		!operator1.Collateral.IsEqual(operator2.Collateral) ||
-		operator1.Name != operator2.Name {
+// SimulateMsgUnjailOperator generates a MsgUnjailOperator object for a random jailed operator.
+func SimulateMsgUnjailOperator(k keeper.Keeper, ak types.AuthKeeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAcc, _ := simulation.RandomAcc(r, accs)
+		operator, err := k.GetOperator(ctx, simAcc.Address)
+		if err != nil || !operator.Jailed {
+			return simulation.NewOperationMsgBasic(types.ModuleName,
+				"NoOp: operator is not jailed, skip this tx", "", false, nil), nil, nil
+		}
+		if ctx.BlockTime().Before(operator.JailedUntil) {
+			return simulation.NewOperationMsgBasic(types.ModuleName,
+				"NoOp: operator jail time has not passed, skip this tx", "", false, nil), nil, nil
+		}
+		if operator.Collateral.AmountOf(sdk.DefaultBondDenom).Int64() < k.GetLockedPoolParams(ctx).MinimumCollateral {
+			return simulation.NewOperationMsgBasic(types.ModuleName,
+				"NoOp: collateral not enough, skip this tx", "", false, nil), nil, nil
+		}
+
+		msg := types.NewMsgUnjailOperator(operator.Address)
+
+		operatorAcc := ak.GetAccount(ctx, operator.Address)
+		fees, err := simulation.RandomFees(r, ctx, operatorAcc.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{operatorAcc.GetAccountNumber()},
+			[]uint64{operatorAcc.GetSequence()},
+			simAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomAggregationStrategy returns a random aggregation strategy.
+func randomAggregationStrategy(r *rand.Rand) types.AggregationStrategy {
+	strategy := types.NewAggregationStrategy(types.AggregationType(r.Intn(3)), sdk.ZeroDec(), sdk.ZeroDec())
+	if r.Intn(2) == 0 {
+		strategy.VetoThreshold = sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+	}
+	if strategy.Type == types.AggregationTypeTrimmedMean {
+		strategy.TrimRatio = sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+	}
+	return strategy
+}
+
+// checkConsistency checks an operator against the expected one. Slashing can only reduce the
+// collateral of an operator, so the expected collateral is updated to a lower collateral.
+func checkConsistency(operator types.Operator, stdOperator *types.Operator) error {
+	if !operator.Address.Equals(stdOperator.Address) ||
+		!operator.Proposer.Equals(stdOperator.Proposer) ||
+		!operator.Collateral.IsAllLTE(stdOperator.Collateral) ||
+		operator.Name != stdOperator.Name {
 		return types.ErrInconsistentOperators
 	}
+	stdOperator.Collateral = operator.Collateral
 	return nil
 }
 
@@ -315,9 +394,22 @@ func SimulateMsgCreateTask(ak types.AuthKeeper, k keeper.Keeper) simulation.Oper
 		bounty := simulation.RandSubsetCoins(r, creatorAcc.SpendableCoins(ctx.BlockTime()))
 		wait := simulation.RandIntBetween(r, 5, 20)
 
-		msg := types.NewMsgCreateTask(contract, function, bounty, description, creator.Address, int64(wait), time.Duration(0))
+		// Some tasks recur with the bounty of one or two more rounds prepaid.
+		spendable := creatorAcc.SpendableCoins(ctx.BlockTime()).Sub(bounty)
+		var interval int64
+		prepaid := sdk.NewCoins()
+		if !bounty.IsZero() && spendable.IsAllGTE(bounty) && r.Intn(4) == 0 {
+			interval = int64(simulation.RandIntBetween(r, 1, 10))
+			prepaid = bounty
+			if spendable.IsAllGTE(bounty.Add(bounty...)) && r.Intn(2) == 0 {
+				prepaid = bounty.Add(bounty...)
+			}
+		}
+
+		msg := types.NewMsgCreateTask(contract, function, bounty, description, creator.Address, int64(wait), time.Duration(0),
+			interval, prepaid, randomAggregationStrategy(r))
 
-		fees, err := simulation.RandomFees(r, ctx, creatorAcc.SpendableCoins(ctx.BlockTime()).Sub(bounty))
+		fees, err := simulation.RandomFees(r, ctx, spendable.Sub(prepaid))
 		if err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
@@ -344,16 +436,37 @@ func SimulateMsgCreateTask(ak types.AuthKeeper, k keeper.Keeper) simulation.Oper
 			},
 			{
 				BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 20, 25),
-				Op:          SimulateMsgDeleteTask(ak, contract, function, creator),
+				Op:          SimulateMsgDeleteTask(ak, k, contract, function, creator),
 			},
 		}
 
+		task, err := k.GetTask(ctx, contract, function)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
 		for _, acc := range accs {
-			if k.IsOperator(ctx, acc.Address) && simulation.RandIntBetween(r, 0, 100) < 10 {
+			if !k.IsOperator(ctx, acc.Address) || simulation.RandIntBetween(r, 0, 100) >= 10 {
+				continue
+			}
+			if !task.IsCommitReveal() {
 				futureOperations = append(futureOperations, simulation.FutureOperation{
 					BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 0, wait),
 					Op:          SimulateMsgTaskResponse(ak, k, contract, function, acc),
 				})
+				continue
+			}
+			// Some operators do not reveal their responses and are penalized.
+			score := r.Int63n(100) + 1
+			salt := simulation.RandStringOfLength(r, 10)
+			futureOperations = append(futureOperations, simulation.FutureOperation{
+				BlockHeight: int(ctx.BlockHeight()) + simulation.RandIntBetween(r, 0, int(task.RevealBlock()-ctx.BlockHeight())),
+				Op:          SimulateMsgCommitTaskResponse(ak, k, task.ID, score, salt, acc),
+			})
+			if r.Intn(10) > 0 {
+				futureOperations = append(futureOperations, simulation.FutureOperation{
+					BlockHeight: int(task.RevealBlock()) + r.Intn(int(task.RevealWindow)),
+					Op:          SimulateMsgRevealTaskResponse(ak, k, task.ID, score, salt, acc),
+				})
 			}
 		}
 
@@ -400,7 +513,7 @@ func SimulateMsgTaskResponse(ak types.AuthKeeper, k keeper.Keeper, contract, fun
 	simAcc simulation.Account) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		simulation.OperationMsg, []simulation.FutureOperation, error) {
-		if !k.IsOperator(ctx, simAcc.Address) {
+		if !k.IsOperator(ctx, simAcc.Address) || k.IsJailed(ctx, simAcc.Address) {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -433,10 +546,115 @@ func SimulateMsgTaskResponse(ak types.AuthKeeper, k keeper.Keeper, contract, fun
 	}
 }
 
+// SimulateMsgCommitTaskResponse generates a MsgCommitTaskResponse object committing to a response.
+func SimulateMsgCommitTaskResponse(ak types.AuthKeeper, k keeper.Keeper, id uint64, score int64, salt string,
+	simAcc simulation.Account) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !k.IsOperator(ctx, simAcc.Address) || k.IsJailed(ctx, simAcc.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		task, err := k.GetTaskByID(ctx, id)
+		if err != nil || ctx.BlockHeight() >= task.RevealBlock() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if latest, err := k.GetTask(ctx, task.Contract, task.Function); err != nil || latest.ID != id {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if _, ok := task.GetCommit(simAcc.Address); ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		hash := types.TaskResponseHash(task.Contract, task.Function, score, salt, simAcc.Address)
+		msg := types.NewMsgCommitTaskResponse(task.Contract, task.Function, hash, simAcc.Address)
+
+		operatorAcc := ak.GetAccount(ctx, simAcc.Address)
+		fees, err := simulation.RandomFees(r, ctx, operatorAcc.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{operatorAcc.GetAccountNumber()},
+			[]uint64{operatorAcc.GetSequence()},
+			simAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRevealTaskResponse generates a MsgRevealTaskResponse object revealing a committed response.
+func SimulateMsgRevealTaskResponse(ak types.AuthKeeper, k keeper.Keeper, id uint64, score int64, salt string,
+	simAcc simulation.Account) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
+		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		task, err := k.GetTaskByID(ctx, id)
+		if err != nil || task.Status != types.TaskStatusPending || ctx.BlockHeight() > task.ClosingBlock {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if latest, err := k.GetTask(ctx, task.Contract, task.Function); err != nil || latest.ID != id {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if _, ok := task.GetCommit(simAcc.Address); !ok || task.HasResponse(simAcc.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgRevealTaskResponse(task.Contract, task.Function, score, salt, simAcc.Address)
+
+		operatorAcc := ak.GetAccount(ctx, simAcc.Address)
+		fees, err := simulation.RandomFees(r, ctx, operatorAcc.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{operatorAcc.GetAccountNumber()},
+			[]uint64{operatorAcc.GetSequence()},
+			simAcc.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 // SimulateMsgDeleteTask generates a MsgDeleteTask object with all of its fields randomized.
-func SimulateMsgDeleteTask(ak types.AuthKeeper, contract, function string, creator simulation.Account) simulation.Operation {
+func SimulateMsgDeleteTask(ak types.AuthKeeper, k keeper.Keeper, contract, function string,
+	creator simulation.Account) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string) (
 		simulation.OperationMsg, []simulation.FutureOperation, error) {
+		// A recurring task may have opened a new round, which has to close before it is deleted.
+		task, err := k.GetTask(ctx, contract, function)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if task.ClosingBlock >= ctx.BlockHeight() {
+			return simulation.NoOpMsg(types.ModuleName), []simulation.FutureOperation{
+				{
+					BlockHeight: int(task.ClosingBlock) + 1,
+					Op:          SimulateMsgDeleteTask(ak, k, contract, function, creator),
+				},
+			}, nil
+		}
+
 		msg := types.NewMsgDeleteTask(contract, function, true, creator.Address)
 
 		creatorAcc := ak.GetAccount(ctx, creator.Address)
@@ -25,5 +25,12 @@ func ParamChanges(_ *rand.Rand) []simulation.ParamChange {
 				return string(bz)
 			},
 		),
+
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamsStoreKeySlashingParams),
+			func(r *rand.Rand) string {
+				bz, _ := json.Marshal(GenSlashingParams(r))
+				return string(bz)
+			},
+		),
 	}
 }
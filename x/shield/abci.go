@@ -24,6 +24,16 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 	// Process completed withdraws.
 	k.DequeueCompletedWithdrawQueue(ctx)
 
-	// Close pools who do not have any shield and shield limits are set to zero.
+	// Release matured stake-for-shield unbondings.
+	k.DequeueCompletedStakeUnbondings(ctx)
+
+	// Remove ended claim locks and unbonding delays.
+	k.DequeueExpiredClaimLocksAndDelays(ctx)
+
+	// Apply scheduled pool updates that have become effective.
+	k.ApplyPoolUpdates(ctx)
+
+	// Close pools who do not have any shield and shield limits are set to zero,
+	// and sunset pools whose purchases have all expired.
 	k.ClosePools(ctx)
 }
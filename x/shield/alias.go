@@ -14,13 +14,17 @@ const (
 )
 
 type (
-	Keeper              = keeper.Keeper
-	GenesisState        = types.GenesisState
-	Provider            = types.Provider
-	ClaimProposal       = types.ShieldClaimProposal
-	ClaimProposalParams = types.ClaimProposalParams
-	Purchase            = types.Purchase
-	PurchaseList        = types.PurchaseList
+	Keeper               = keeper.Keeper
+	GenesisState         = types.GenesisState
+	Provider             = types.Provider
+	ClaimProposal        = types.ShieldClaimProposal
+	ClaimProposalParams  = types.ClaimProposalParams
+	ClaimEvidence        = types.ClaimEvidence
+	PoolOperatorProposal = types.PoolOperatorUpdateProposal
+	Pool                 = types.Pool
+	Purchase             = types.Purchase
+	PurchaseList         = types.PurchaseList
+	FixedRateConverter   = keeper.FixedRateConverter
 )
 
 var (
@@ -30,15 +34,21 @@ var (
 	NewQuerier                  = keeper.NewQuerier
 	ModuleCdc                   = types.ModuleCdc
 	ProposalHandler             = client.ProposalHandler
+	PoolOperatorProposalHandler = client.PoolOperatorProposalHandler
 	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
 	ValidateGenesis             = types.ValidateGenesis
 	GetPurchase                 = keeper.GetPurchase
+	NewFixedRateConverter       = keeper.NewFixedRateConverter
 
-	DefaultParamSpace       = types.DefaultParamspace
-	ProposalTypeShieldClaim = types.ProposalTypeShieldClaim
+	DefaultParamSpace              = types.DefaultParamspace
+	ProposalTypeShieldClaim        = types.ProposalTypeShieldClaim
+	ProposalTypePoolOperatorUpdate = types.ProposalTypePoolOperatorUpdate
+	DefaultMinPegScore             = types.DefaultMinPegScore
 
 	// variable aliases
 	ErrPurchaseNotFound = types.ErrPurchaseNotFound
+	ErrNoPoolFound      = types.ErrNoPoolFound
+	ErrInvalidEvidence  = types.ErrInvalidEvidence
 	PurchaseQueueKey    = types.PurchaseQueueKey
 	WithdrawQueueKey    = types.WithdrawQueueKey
 	BlockServiceFeesKey = types.BlockServiceFeesKey
@@ -32,6 +32,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdPurchaseList(queryRoute, cdc),
 		GetCmdPurchaserPurchases(queryRoute, cdc),
 		GetCmdPoolPurchases(queryRoute, cdc),
+		GetCmdPoolOperators(queryRoute, cdc),
 		GetCmdPurchases(queryRoute, cdc),
 		GetCmdProvider(queryRoute, cdc),
 		GetCmdProviders(queryRoute, cdc),
@@ -42,6 +43,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdShieldStakingRate(queryRoute, cdc),
 		GetCmdReimbursement(queryRoute, cdc),
 		GetCmdReimbursements(queryRoute, cdc),
+		GetCmdPoolCapacity(queryRoute, cdc),
+		GetCmdProviderStats(queryRoute, cdc),
+		GetCmdStakerStats(queryRoute, cdc),
 	)...)
 
 	return shieldQueryCmd
@@ -184,6 +188,31 @@ func GetCmdPoolPurchases(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdPoolOperators returns the command for querying
+// the operators of a given pool.
+func GetCmdPoolOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-operators [pool_ID]",
+		Short: "query operators of a given pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryPoolOperators, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out []types.PoolOperator
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdPurchases returns the command for querying all purchases.
 func GetCmdPurchases(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -438,3 +467,78 @@ func GetCmdReimbursements(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPoolCapacity returns the command for querying the collateral
+// underwriting a pool.
+func GetCmdPoolCapacity(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-capacity [pool_ID]",
+		Short: "query the collateral underwriting a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryPoolCapacity, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResPoolCapacity
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdProviderStats returns the command for querying rewards,
+// locks and delays of a provider.
+func GetCmdProviderStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-stats [provider_address]",
+		Short: "query rewards, estimated APR, claim locks, withdraws and unbonding delays of a provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryProviderStats, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResProviderStats
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdStakerStats returns the command for querying stakings,
+// pending unstakes and accrued rewards of a stake-for-shield staker.
+func GetCmdStakerStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-stats [staker_address]",
+		Short: "query stakings, pending unstakes and accrued rewards of a stake-for-shield staker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryStakerStats, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResStakerStats
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
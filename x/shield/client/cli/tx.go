@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -23,11 +24,13 @@ import (
 )
 
 var (
-	flagNativeDeposit = "native-deposit"
-	flagShield        = "shield"
-	flagSponsor       = "sponsor"
-	flagDescription   = "description"
-	flagShieldLimit   = "shield-limit"
+	flagNativeDeposit  = "native-deposit"
+	flagForeignDeposit = "foreign-deposit"
+	flagShield         = "shield"
+	flagSponsor        = "sponsor"
+	flagDescription    = "description"
+	flagShieldLimit    = "shield-limit"
+	flagEffectiveTime  = "effective-time"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -45,8 +48,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdUpdatePool(cdc),
 		GetCmdPausePool(cdc),
 		GetCmdResumePool(cdc),
+		GetCmdSunsetPool(cdc),
 		GetCmdDepositCollateral(cdc),
 		GetCmdWithdrawCollateral(cdc),
+		GetCmdAllocateCollateral(cdc),
+		GetCmdSetTranche(cdc),
 		GetCmdWithdrawRewards(cdc),
 		GetCmdWithdrawForeignRewards(cdc),
 		GetCmdClearPayouts(cdc),
@@ -81,6 +87,16 @@ Where proposal.json contains:
     }
   ],
   "evidence": "Attack happened on <time> caused loss of <amount> to <account> by <txhashes>",
+  "attachments": {
+    "transactions": [
+      {
+        "chain": "ethereum",
+        "tx_hash": "0x2d8d3f6b4a9e1c7f0b5a3e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c9b"
+      }
+    ],
+    "content_hashes": ["QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"],
+    "certificate_ids": [1]
+  },
   "purchase_txhash": "7D5C90FBD3082D2CD763FA1580BBA29568D0749D76C7CD627B841F2FAB22BBEA",
   "description": "Details of the attack",
   "deposit": [
@@ -105,7 +121,61 @@ Where proposal.json contains:
 			}
 			from := cliCtx.GetFromAddress()
 			content := types.NewShieldClaimProposal(proposal.PoolID, proposal.Loss,
-				proposal.PurchaseID, proposal.Evidence, proposal.Description, from)
+				proposal.PurchaseID, proposal.Evidence, proposal.Attachments, proposal.Description, from)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdSubmitPoolOperatorProposal implements the command for submitting
+// a pool operator update proposal.
+func GetCmdSubmitPoolOperatorProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-operator-update [proposal file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or remove an operator of a Shield pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a pool operator update proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+Example:
+$ %s tx gov submit-proposal pool-operator-update <path/to/proposal.json> --from=<key_or_address>
+Where proposal.json contains:
+{
+  "title": "Add pool operator",
+  "description": "Let the project's operations team manage pool 1",
+  "pool_id": 1,
+  "operator": "certik1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "remove": false,
+  "deposit": [
+    {
+      "denom": "ctk",
+      "amount": "100"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParsePoolOperatorUpdateProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+			from := cliCtx.GetFromAddress()
+			content := types.NewPoolOperatorUpdateProposal(proposal.Title, proposal.Description,
+				proposal.PoolID, proposal.Operator, proposal.Remove)
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
 			if err := msg.ValidateBasic(); err != nil {
@@ -156,7 +226,11 @@ $ %s tx shield create-pool <shield amount> <sponsor> <sponsor-address> --native-
 			if err != nil {
 				return err
 			}
-			deposit := types.MixedCoins{Native: nativeDeposit}
+			foreignDeposit, err := sdk.ParseCoins(viper.GetString(flagForeignDeposit))
+			if err != nil {
+				return err
+			}
+			deposit := types.MixedCoins{Native: nativeDeposit, Foreign: foreignDeposit}
 
 			description := viper.GetString(flagDescription)
 
@@ -175,6 +249,7 @@ $ %s tx shield create-pool <shield amount> <sponsor> <sponsor-address> --native-
 	}
 	cmd.Flags().String(flagDescription, "", "description for the pool")
 	cmd.Flags().String(flagNativeDeposit, "", "CTK deposit amount")
+	cmd.Flags().String(flagForeignDeposit, "", "sponsor deposit in foreign denoms to settle claims in those denoms")
 	cmd.Flags().String(flagShieldLimit, "", "the limit of active shield for the pool")
 	return cmd
 }
@@ -186,10 +261,13 @@ func GetCmdUpdatePool(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "update an existing Shield pool by adding more deposit or updating Shield amount.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update a Shield pool. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Update a Shield pool. Can be executed from the Shield admin address or a pool operator address.
+The pool sponsor can only top up deposits and update the description.
+With --effective-time, the new shield limit and deposit take effect at the given RFC3339 time instead.
 
 Example:
-$ %s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield amount> --shield-limit <shield limit>
+$ %[1]s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield amount> --shield-limit <shield limit>
+$ %[1]s tx shield update-pool <id> --native-deposit <ctk deposit> --shield-limit <shield limit> --effective-time 2021-01-01T00:00:00Z
 `,
 				version.ClientName,
 			),
@@ -210,12 +288,16 @@ $ %s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield
 			if err != nil {
 				return err
 			}
+			foreignDeposit, err := sdk.ParseCoins(viper.GetString(flagForeignDeposit))
+			if err != nil {
+				return err
+			}
 
 			shield, err := sdk.ParseCoins(viper.GetString(flagShield))
 			if err != nil {
 				return err
 			}
-			deposit := types.MixedCoins{Native: nativeDeposit}
+			deposit := types.MixedCoins{Native: nativeDeposit, Foreign: foreignDeposit}
 
 			description := viper.GetString(flagDescription)
 
@@ -224,7 +306,15 @@ $ %s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield
 				return fmt.Errorf("invalid input for shield limit")
 			}
 
-			msg := types.NewMsgUpdatePool(fromAddr, shield, deposit, id, description, shieldLimit)
+			var effectiveTime time.Time
+			if timeStr := viper.GetString(flagEffectiveTime); timeStr != "" {
+				effectiveTime, err = time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdatePool(fromAddr, shield, deposit, id, description, shieldLimit, effectiveTime)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -235,8 +325,10 @@ $ %s tx shield update-pool <id> --native-deposit <ctk deposit> --shield <shield
 
 	cmd.Flags().String(flagShield, "", "CTK Shield amount")
 	cmd.Flags().String(flagNativeDeposit, "", "CTK deposit amount")
+	cmd.Flags().String(flagForeignDeposit, "", "sponsor deposit in foreign denoms to settle claims in those denoms")
 	cmd.Flags().String(flagDescription, "", "description for the pool")
 	cmd.Flags().String(flagShieldLimit, "", "the limit of active shield for the pool")
+	cmd.Flags().String(flagEffectiveTime, "", "RFC3339 time at which the shield limit and deposit take effect")
 	return cmd
 }
 
@@ -247,7 +339,7 @@ func GetCmdPausePool(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "pause a Shield pool to disallow further Shield purchase.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Pause a Shield pool to prevent new Shield purchases. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Pause a Shield pool to prevent new Shield purchases. Can only be executed from the Shield admin address or a pool operator address.
 
 Example:
 $ %s tx shield pause-pool <pool id>
@@ -267,7 +359,7 @@ func GetCmdResumePool(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "resume a Shield pool to allow Shield purchase.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Resume a Shield pool to reactivate Shield purchase. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Resume a Shield pool to reactivate Shield purchase. Can only be executed from the Shield admin address or a pool operator address.
 
 Example:
 $ %s tx shield resume-pool <pool id>
@@ -280,6 +372,43 @@ $ %s tx shield resume-pool <pool id>
 	return cmd
 }
 
+// GetCmdSunsetPool implements the command for sunsetting a pool.
+func GetCmdSunsetPool(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sunset-pool [pool id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "sunset a Shield pool to permanently stop Shield purchase.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sunset a Shield pool. Existing purchases remain valid until they expire, after which
+the pool is closed and pending sponsor deposits are refunded. Can only be executed from the Shield admin address or a pool operator address.
+
+Example:
+$ %s tx shield sunset-pool <pool id>
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSunsetPool(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
 func pauseOrResume(cdc *codec.Codec, active bool) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -367,6 +496,77 @@ func GetCmdWithdrawCollateral(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdAllocateCollateral implements command for community member to
+// restrict the pools underwritten by their collateral.
+func GetCmdAllocateCollateral(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allocate-collateral [allocations]",
+		Short: "allocate collateral to pools in the form of <pool_id>:<weight>,...",
+		Long: strings.TrimSpace(`Restrict the pools underwritten by your collateral. Each allocation
+is a pool ID and the fraction of collateral exposed to it. Omit the allocations to back all pools.
+
+Example:
+$ certikcli tx shield allocate-collateral 1:1,2:0.5 --from mykey
+`),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			var allocations types.PoolAllocations
+			if len(args) == 1 {
+				var err error
+				allocations, err = types.ParsePoolAllocations(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgAllocateCollateral(fromAddr, allocations)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// GetCmdSetTranche implements command for community member to set
+// the risk tranche of their collateral.
+func GetCmdSetTranche(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tranche [senior|junior]",
+		Short: "set the risk tranche of your collateral",
+		Long: strings.TrimSpace(`Set the risk tranche of your collateral. Junior collateral absorbs
+losses before senior collateral and earns a larger share of service fees.
+
+Example:
+$ certikcli tx shield set-tranche junior --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			fromAddr := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgSetTranche(fromAddr, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
 // GetCmdWithdrawRewards implements command for requesting to withdraw native tokens rewards.
 func GetCmdWithdrawRewards(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -611,7 +811,7 @@ func GetCmdUpdateSponsor(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		Short: "update the sponsor of an existing pool",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Update a pool's sponsor. Can only be executed from the Shield admin address.
+			fmt.Sprintf(`Update a pool's sponsor. Can only be executed from the Shield admin address or a pool operator address.
 Example:
 $ %s tx shield update-sponsor <id> <new_sponsor_name> <new_sponsor_address> --from=<key_or_address>
 `,
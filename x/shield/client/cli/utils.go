@@ -5,16 +5,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
 )
 
 // ShieldClaimProposalJSON defines a shield claim proposal.
 type ShieldClaimProposalJSON struct {
-	PoolID      uint64    `json:"pool_id" yaml:"pool_id"`
-	Loss        sdk.Coins `json:"loss" yaml:"loss"`
-	Evidence    string    `json:"evidence" yaml:"evidence"`
-	PurchaseID  uint64    `json:"purchase_id" yaml:"purchase_id"`
-	Description string    `json:"description" yaml:"description"`
-	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	PoolID      uint64              `json:"pool_id" yaml:"pool_id"`
+	Loss        sdk.Coins           `json:"loss" yaml:"loss"`
+	Evidence    string              `json:"evidence" yaml:"evidence"`
+	Attachments types.ClaimEvidence `json:"attachments" yaml:"attachments"`
+	PurchaseID  uint64              `json:"purchase_id" yaml:"purchase_id"`
+	Description string              `json:"description" yaml:"description"`
+	Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
 }
 
 // ParseShieldClaimProposalJSON reads and parses a ShieldClaimProposalJSON from a file.
@@ -32,3 +35,29 @@ func ParseShieldClaimProposalJSON(cdc *codec.Codec, proposalFile string) (Shield
 
 	return proposal, nil
 }
+
+// PoolOperatorUpdateProposalJSON defines a pool operator update proposal.
+type PoolOperatorUpdateProposalJSON struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	PoolID      uint64         `json:"pool_id" yaml:"pool_id"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	Remove      bool           `json:"remove" yaml:"remove"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// ParsePoolOperatorUpdateProposalJSON reads and parses a PoolOperatorUpdateProposalJSON from a file.
+func ParsePoolOperatorUpdateProposalJSON(cdc *codec.Codec, proposalFile string) (PoolOperatorUpdateProposalJSON, error) {
+	proposal := PoolOperatorUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -10,4 +10,6 @@ import (
 var (
 	// shield claim proposal handler
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	// pool operator update proposal handler
+	PoolOperatorProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitPoolOperatorProposal, rest.PoolOperatorProposalRESTHandler)
 )
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
 )
 
 // RegisterRoutes registers staking-related REST handlers to a router
@@ -23,6 +25,14 @@ func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler
 	}
 }
 
+// PoolOperatorProposalRESTHandler returns a ProposalRESTHandler that exposes the pool operator update REST handler with a given sub-route.
+func PoolOperatorProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "pool_operator_update",
+		Handler:  postPoolOperatorProposalHandlerFn(cliCtx),
+	}
+}
+
 type depositCollateralReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
@@ -33,6 +43,16 @@ type withdrawCollateralReq struct {
 	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
 }
 
+type allocateCollateralReq struct {
+	BaseReq     rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Allocations []types.PoolAllocation `json:"allocations" yaml:"allocations"`
+}
+
+type setTrancheReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Tranche string       `json:"tranche" yaml:"tranche"`
+}
+
 type withdrawRewardsReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 }
@@ -62,12 +82,23 @@ type withdrawFromShieldReq struct {
 }
 
 // ShieldClaimProposalReq defines a shield claim proposal request body.
+type PoolOperatorUpdateProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	PoolID      uint64         `json:"pool_id" yaml:"pool_id"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	Remove      bool           `json:"remove" yaml:"remove"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
 type ShieldClaimProposalReq struct {
-	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
-	PoolID      uint64       `json:"pool_id" yaml:"pool_id"`
-	Loss        sdk.Coins    `json:"loss" yaml:"loss"`
-	Evidence    string       `json:"evidence" yaml:"evidence"`
-	PurchaseID  uint64       `json:"purchase_id" yaml:"purchase_id"`
-	Description string       `json:"description" yaml:"description"`
-	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	BaseReq     rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	PoolID      uint64              `json:"pool_id" yaml:"pool_id"`
+	Loss        sdk.Coins           `json:"loss" yaml:"loss"`
+	Evidence    string              `json:"evidence" yaml:"evidence"`
+	Attachments types.ClaimEvidence `json:"attachments" yaml:"attachments"`
+	PurchaseID  uint64              `json:"purchase_id" yaml:"purchase_id"`
+	Description string              `json:"description" yaml:"description"`
+	Deposit     sdk.Coins           `json:"deposit" yaml:"deposit"`
 }
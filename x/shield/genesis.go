@@ -48,6 +48,24 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 	for _, pRPair := range data.ProposalIDReimbursementPairs {
 		k.SetReimbursement(ctx, pRPair.ProposalID, pRPair.Reimbursement)
 	}
+	for _, lock := range data.ClaimLocks {
+		k.InsertClaimLockQueue(ctx, lock)
+	}
+	for _, delay := range data.UnbondingDelays {
+		k.InsertUnbondingDelayQueue(ctx, delay)
+	}
+	for _, update := range data.PoolUpdates {
+		k.InsertPoolUpdateQueue(ctx, update)
+	}
+	for _, unbonding := range data.StakeUnbondings {
+		k.InsertStakeUnbondingQueue(ctx, unbonding)
+	}
+	for _, conversion := range data.ClaimConversions {
+		k.SetClaimConversion(ctx, conversion)
+	}
+	for _, operator := range data.PoolOperators {
+		k.SetPoolOperator(ctx, operator)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -75,8 +93,15 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	stakingPurchases := k.GetAllStakeForShields(ctx)
 	originalStaking := k.GetAllOriginalStakings(ctx)
 	reimbursements := k.GetAllProposalIDReimbursementPairs(ctx)
+	claimLocks := k.GetAllClaimLocks(ctx)
+	unbondingDelays := k.GetAllUnbondingDelays(ctx)
+	poolUpdates := k.GetAllPoolUpdates(ctx)
+	stakeUnbondings := k.GetAllStakeUnbondings(ctx)
+	claimConversions := k.GetAllClaimConversions(ctx)
+	poolOperators := k.GetAllPoolOperators(ctx)
 
 	return types.NewGenesisState(shieldAdmin, nextPoolID, nextPurchaseID, poolParams, claimProposalParams,
 		totalCollateral, totalWithdrawing, totalShield, totalClaimed, serviceFees, remainingServiceFees, pools,
-		providers, purchaseLists, withdraws, lastUpdateTime, stakingPurchaseRate, globalStakingPool, stakingPurchases, originalStaking, reimbursements)
+		providers, purchaseLists, withdraws, lastUpdateTime, stakingPurchaseRate, globalStakingPool, stakingPurchases, originalStaking, reimbursements,
+		claimLocks, unbondingDelays, poolUpdates, stakeUnbondings, claimConversions, poolOperators)
 }
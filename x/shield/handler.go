@@ -24,6 +24,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgPausePool(ctx, msg, k)
 		case types.MsgResumePool:
 			return handleMsgResumePool(ctx, msg, k)
+		case types.MsgSunsetPool:
+			return handleMsgSunsetPool(ctx, msg, k)
 		case types.MsgWithdrawRewards:
 			return handleMsgWithdrawRewards(ctx, msg, k)
 		case types.MsgDepositCollateral:
@@ -40,6 +42,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgUnstakeFromShield(ctx, msg, k)
 		case types.MsgWithdrawReimbursement:
 			return handleMsgWithdrawReimbursement(ctx, msg, k)
+		case types.MsgAllocateCollateral:
+			return handleMsgAllocateCollateral(ctx, msg, k)
+		case types.MsgSetTranche:
+			return handleMsgSetTranche(ctx, msg, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -51,6 +57,8 @@ func NewShieldClaimProposalHandler(k Keeper) govtypes.Handler {
 		switch c := content.(type) {
 		case types.ShieldClaimProposal:
 			return handleShieldClaimProposal(ctx, k, c)
+		case types.PoolOperatorUpdateProposal:
+			return handlePoolOperatorUpdateProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized shield proposal content type: %T", c)
 		}
@@ -58,7 +66,7 @@ func NewShieldClaimProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleShieldClaimProposal(ctx sdk.Context, k Keeper, p types.ShieldClaimProposal) error {
-	if err := k.CreateReimbursement(ctx, p.ProposalID, p.Loss, p.Proposer); err != nil {
+	if err := k.CreateReimbursement(ctx, p.ProposalID, p.PoolID, p.Loss, p.Proposer); err != nil {
 		return err
 	}
 
@@ -73,6 +81,27 @@ func handleShieldClaimProposal(ctx sdk.Context, k Keeper, p types.ShieldClaimPro
 	return nil
 }
 
+func handlePoolOperatorUpdateProposal(ctx sdk.Context, k Keeper, p types.PoolOperatorUpdateProposal) error {
+	eventType := types.EventTypeAddPoolOperator
+	if p.Remove {
+		eventType = types.EventTypeRemovePoolOperator
+		if err := k.RemovePoolOperator(ctx, p.PoolID, p.Operator); err != nil {
+			return err
+		}
+	} else if err := k.AddPoolOperator(ctx, p.PoolID, p.Operator); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(p.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyOperator, p.Operator.String()),
+		),
+	)
+	return nil
+}
+
 func handleMsgCreatePool(ctx sdk.Context, msg types.MsgCreatePool, k Keeper) (*sdk.Result, error) {
 	poolID, err := k.CreatePool(ctx, msg.From, msg.Shield, msg.Deposit, msg.Sponsor, msg.SponsorAddr, msg.Description, msg.ShieldLimit)
 	if err != nil {
@@ -97,9 +126,9 @@ func handleMsgCreatePool(ctx sdk.Context, msg types.MsgCreatePool, k Keeper) (*s
 }
 
 func handleMsgUpdatePool(ctx sdk.Context, msg types.MsgUpdatePool, k Keeper) (*sdk.Result, error) {
-	_, err := k.UpdatePool(ctx, msg.PoolID, msg.Description, msg.From, msg.Shield, msg.ServiceFees, msg.ShieldLimit)
+	_, err := k.UpdatePool(ctx, msg.PoolID, msg.Description, msg.From, msg.Shield, msg.ServiceFees, msg.ShieldLimit, msg.EffectiveTime)
 	if err != nil {
-		return &sdk.Result{Events: ctx.EventManager().Events()}, err
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -156,6 +185,26 @@ func handleMsgResumePool(ctx sdk.Context, msg types.MsgResumePool, k Keeper) (*s
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgSunsetPool(ctx sdk.Context, msg types.MsgSunsetPool, k Keeper) (*sdk.Result, error) {
+	_, err := k.SunsetPool(ctx, msg.From, msg.PoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSunsetPool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(msg.PoolID, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgWithdrawRewards(ctx sdk.Context, msg types.MsgWithdrawRewards, k Keeper) (*sdk.Result, error) {
 	amount, err := k.PayoutNativeRewards(ctx, msg.From)
 	if err != nil {
@@ -329,3 +378,34 @@ func handleMsgWithdrawReimbursement(ctx sdk.Context, msg types.MsgWithdrawReimbu
 	})
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgAllocateCollateral(ctx sdk.Context, msg types.MsgAllocateCollateral, k Keeper) (*sdk.Result, error) {
+	if err := k.AllocateCollateral(ctx, msg.From, msg.Allocations); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAllocateCollateral,
+			sdk.NewAttribute(types.AttributeKeyAllocations, types.PoolAllocations(msg.Allocations).String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetTranche(ctx sdk.Context, msg types.MsgSetTranche, k Keeper) (*sdk.Result, error) {
+	tranche := types.TrancheFromString(msg.Tranche)
+	if err := k.SetProviderTranche(ctx, msg.From, tranche); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetTranche,
+			sdk.NewAttribute(types.AttributeKeyTranche, tranche.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
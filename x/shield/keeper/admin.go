@@ -18,3 +18,87 @@ func (k Keeper) GetAdmin(ctx sdk.Context) sdk.AccAddress {
 	store := ctx.KVStore(k.storeKey)
 	return store.Get(types.GetShieldAdminKey())
 }
+
+// SetPoolOperator sets an operator of a pool.
+func (k Keeper) SetPoolOperator(ctx sdk.Context, operator types.PoolOperator) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(operator)
+	store.Set(types.GetPoolOperatorKey(operator.PoolID, operator.Address), bz)
+}
+
+// DeletePoolOperator deletes an operator of a pool.
+func (k Keeper) DeletePoolOperator(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolOperatorKey(poolID, addr))
+}
+
+// IsPoolOperator returns true if the address is an operator of the pool.
+func (k Keeper) IsPoolOperator(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetPoolOperatorKey(poolID, addr))
+}
+
+// IteratePoolOperators iterates over the operators of a pool.
+func (k Keeper) IteratePoolOperators(ctx sdk.Context, poolID uint64, callback func(operator types.PoolOperator) (stop bool)) {
+	k.iteratePoolOperators(ctx, types.GetPoolOperatorsKey(poolID), callback)
+}
+
+func (k Keeper) iteratePoolOperators(ctx sdk.Context, prefix []byte, callback func(operator types.PoolOperator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var operator types.PoolOperator
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &operator)
+
+		if callback(operator) {
+			break
+		}
+	}
+}
+
+// GetPoolOperators retrieves the operators of a pool.
+func (k Keeper) GetPoolOperators(ctx sdk.Context, poolID uint64) (operators []types.PoolOperator) {
+	k.IteratePoolOperators(ctx, poolID, func(operator types.PoolOperator) bool {
+		operators = append(operators, operator)
+		return false
+	})
+	return
+}
+
+// GetAllPoolOperators retrieves the operators of all pools.
+func (k Keeper) GetAllPoolOperators(ctx sdk.Context) (operators []types.PoolOperator) {
+	k.iteratePoolOperators(ctx, types.PoolOperatorKey, func(operator types.PoolOperator) bool {
+		operators = append(operators, operator)
+		return false
+	})
+	return
+}
+
+// AddPoolOperator adds an operator to an existing pool.
+func (k Keeper) AddPoolOperator(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) error {
+	if _, found := k.GetPool(ctx, poolID); !found {
+		return types.ErrNoPoolFound
+	}
+	if k.IsPoolOperator(ctx, poolID, addr) {
+		return types.ErrOperatorAlreadyExists
+	}
+	k.SetPoolOperator(ctx, types.NewPoolOperator(poolID, addr))
+	return nil
+}
+
+// RemovePoolOperator removes an operator from a pool.
+func (k Keeper) RemovePoolOperator(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) error {
+	if !k.IsPoolOperator(ctx, poolID, addr) {
+		return types.ErrOperatorNotFound
+	}
+	k.DeletePoolOperator(ctx, poolID, addr)
+	return nil
+}
+
+// isPoolManager returns true if the address is the shield admin or an
+// operator of the pool.
+func (k Keeper) isPoolManager(ctx sdk.Context, poolID uint64, addr sdk.AccAddress) bool {
+	return addr.Equals(k.GetAdmin(ctx)) || k.IsPoolOperator(ctx, poolID, addr)
+}
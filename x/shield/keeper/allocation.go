@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// AllocateCollateral restricts the pools underwritten by a provider's
+// collateral. An empty allocation list makes the collateral back all
+// pools again.
+func (k Keeper) AllocateCollateral(ctx sdk.Context, addr sdk.AccAddress, allocations []types.PoolAllocation) error {
+	provider, found := k.GetProvider(ctx, addr)
+	if !found {
+		return types.ErrProviderNotFound
+	}
+	for _, allocation := range allocations {
+		if _, found := k.GetPool(ctx, allocation.PoolID); !found {
+			return types.ErrNoPoolFound
+		}
+	}
+	provider.Allocations = allocations
+
+	// Reallocation must not leave shield of any pool uncovered.
+	providers := k.GetAllProviders(ctx)
+	for i := range providers {
+		if providers[i].Address.Equals(addr) {
+			providers[i] = provider
+			break
+		}
+	}
+	for _, pool := range k.GetAllPools(ctx) {
+		if pool.Shield.GT(poolCapacity(providers, pool.ID)) {
+			return types.ErrNotEnoughCollateral
+		}
+	}
+
+	k.SetProvider(ctx, addr, provider)
+	return nil
+}
+
+// pruneAllocations removes the allocations to a closed pool from the
+// providers. The last allocation of a provider is kept, so that its
+// collateral does not start backing all pools.
+func (k Keeper) pruneAllocations(ctx sdk.Context, poolID uint64) {
+	for _, provider := range k.GetAllProviders(ctx) {
+		if len(provider.Allocations) < 2 {
+			continue
+		}
+		allocations := make([]types.PoolAllocation, 0, len(provider.Allocations))
+		for _, allocation := range provider.Allocations {
+			if allocation.PoolID != poolID {
+				allocations = append(allocations, allocation)
+			}
+		}
+		if len(allocations) == len(provider.Allocations) {
+			continue
+		}
+		provider.Allocations = allocations
+		k.SetProvider(ctx, provider.Address, provider)
+	}
+}
+
+// GetPoolCapacity returns the amount of non-withdrawing collateral
+// underwriting the pool.
+func (k Keeper) GetPoolCapacity(ctx sdk.Context, poolID uint64) sdk.Int {
+	return poolCapacity(k.GetAllProviders(ctx), poolID)
+}
+
+// GetPoolMaxShield returns the maximum amount of shield the pool can
+// sell given its shield limit and the collateral underwriting it.
+func (k Keeper) GetPoolMaxShield(ctx sdk.Context, pool types.Pool) sdk.Int {
+	available := k.GetPoolCapacity(ctx, pool.ID).Sub(k.GetTotalClaimed(ctx))
+	return sdk.MinInt(pool.ShieldLimit, available.ToDec().Mul(k.GetPoolParams(ctx).PoolShieldLimit).TruncateInt())
+}
+
+// poolCapacity sums up the providers' non-withdrawing collateral
+// weighted by their allocations to the pool.
+func poolCapacity(providers []types.Provider, poolID uint64) sdk.Int {
+	capacity := sdk.ZeroInt()
+	for _, provider := range providers {
+		available := provider.Collateral.Sub(provider.Withdrawing)
+		capacity = capacity.Add(available.ToDec().Mul(provider.PoolWeight(poolID)).TruncateInt())
+	}
+	return capacity
+}
+
+// poolExposures returns the collateral of each provider exposed to
+// the pool and their sum. It falls back to the providers' entire
+// collateral if no provider underwrites the pool.
+func poolExposures(providers []types.Provider, poolID uint64) ([]sdk.Dec, sdk.Dec) {
+	exposures := make([]sdk.Dec, len(providers))
+	total := sdk.ZeroDec()
+	for i, provider := range providers {
+		exposures[i] = provider.Collateral.ToDec().Mul(provider.PoolWeight(poolID))
+		total = total.Add(exposures[i])
+	}
+	if total.IsPositive() {
+		return exposures, total
+	}
+
+	for i, provider := range providers {
+		exposures[i] = provider.Collateral.ToDec()
+		total = total.Add(exposures[i])
+	}
+	return exposures, total
+}
+
+// backedShields returns the amount of shield of all pools backed by
+// each provider and their sum.
+func (k Keeper) backedShields(ctx sdk.Context, providers []types.Provider) ([]sdk.Dec, sdk.Dec) {
+	backed := make([]sdk.Dec, len(providers))
+	for i := range backed {
+		backed[i] = sdk.ZeroDec()
+	}
+	total := sdk.ZeroDec()
+	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+		if !pool.Shield.IsPositive() {
+			return false
+		}
+		exposures, totalExposure := poolExposures(providers, pool.ID)
+		if !totalExposure.IsPositive() {
+			return false
+		}
+		for i := range providers {
+			shield := exposures[i].Mul(pool.Shield.ToDec()).Quo(totalExposure)
+			backed[i] = backed[i].Add(shield)
+			total = total.Add(shield)
+		}
+		return false
+	})
+	return backed, total
+}
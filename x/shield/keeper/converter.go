@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/oracle"
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// FixedRateConverter is the default reimbursement converter. It converts
+// foreign denoms at the fixed rates of the claim proposal parameters, as
+// long as the oracle scores the peg of the denom high enough.
+type FixedRateConverter struct {
+	k        Keeper
+	ok       types.OracleKeeper
+	minScore sdk.Int
+}
+
+// NewFixedRateConverter creates a new fixed-rate reimbursement converter.
+func NewFixedRateConverter(k Keeper, ok types.OracleKeeper, minScore sdk.Int) FixedRateConverter {
+	return FixedRateConverter{
+		k:        k,
+		ok:       ok,
+		minScore: minScore,
+	}
+}
+
+// ConversionRate implements the ReimbursementConverter interface.
+func (c FixedRateConverter) ConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	rate := c.k.GetClaimProposalParams(ctx).ConversionRates.AmountOf(denom)
+	if !rate.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrUnsupportedLossDenom, "%s", denom)
+	}
+	task, err := c.ok.GetTask(ctx, denom, types.PegTaskFunction)
+	if err != nil || task.Status != oracle.TaskStatusSucceeded || task.Result.LT(c.minScore) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrConversionUnavailable, "%s", denom)
+	}
+	return rate, nil
+}
+
+// SetReimbursementConverter sets the converter used for claims of losses
+// in denoms other than the bond denom.
+func (k *Keeper) SetReimbursementConverter(converter types.ReimbursementConverter) {
+	k.converter = converter
+}
+
+// ConversionRates returns the current conversion rates of the non-bond
+// denoms of the loss.
+func (k Keeper) ConversionRates(ctx sdk.Context, loss sdk.Coins) (sdk.DecCoins, error) {
+	bondDenom := k.BondDenom(ctx)
+	rates := sdk.DecCoins{}
+	for _, coin := range loss {
+		if coin.Denom == bondDenom {
+			continue
+		}
+		if k.converter == nil {
+			return nil, sdkerrors.Wrapf(types.ErrUnsupportedLossDenom, "%s", coin.Denom)
+		}
+		rate, err := k.converter.ConversionRate(ctx, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		rates = rates.Add(sdk.NewDecCoinFromDec(coin.Denom, rate))
+	}
+	return rates, nil
+}
+
+// ConvertLoss returns the amount of bond denom the loss is worth at the
+// current conversion rates.
+func (k Keeper) ConvertLoss(ctx sdk.Context, loss sdk.Coins) (sdk.Int, error) {
+	rates, err := k.ConversionRates(ctx, loss)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return convertLoss(loss, rates, k.BondDenom(ctx)), nil
+}
+
+// convertLoss returns the amount of bond denom the loss is worth at the
+// given rates. Denoms without a rate are worth nothing.
+func convertLoss(loss sdk.Coins, rates sdk.DecCoins, bondDenom string) sdk.Int {
+	total := loss.AmountOf(bondDenom).ToDec()
+	for _, coin := range loss {
+		if coin.Denom == bondDenom {
+			continue
+		}
+		total = total.Add(coin.Amount.ToDec().Mul(rates.AmountOf(coin.Denom)))
+	}
+	return total.TruncateInt()
+}
+
+// claimLossAmount returns the amount of bond denom secured for the loss
+// of a claim proposal.
+func (k Keeper) claimLossAmount(ctx sdk.Context, proposalID uint64, loss sdk.Coins) sdk.Int {
+	conversion, _ := k.GetClaimConversion(ctx, proposalID)
+	return convertLoss(loss, conversion.Rates, k.BondDenom(ctx))
+}
+
+// SetClaimConversion sets the conversion rates of a claim proposal.
+func (k Keeper) SetClaimConversion(ctx sdk.Context, conversion types.ClaimConversion) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(conversion)
+	store.Set(types.GetClaimConversionKey(conversion.ProposalID), bz)
+}
+
+// GetClaimConversion gets the conversion rates of a claim proposal.
+func (k Keeper) GetClaimConversion(ctx sdk.Context, proposalID uint64) (types.ClaimConversion, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetClaimConversionKey(proposalID))
+	if bz == nil {
+		return types.ClaimConversion{}, false
+	}
+	var conversion types.ClaimConversion
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &conversion)
+	return conversion, true
+}
+
+// DeleteClaimConversion deletes the conversion rates of a claim proposal.
+func (k Keeper) DeleteClaimConversion(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetClaimConversionKey(proposalID))
+}
+
+// GetAllClaimConversions retrieves conversion rates of all claim proposals.
+func (k Keeper) GetAllClaimConversions(ctx sdk.Context) (conversions []types.ClaimConversion) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimConversionKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var conversion types.ClaimConversion
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &conversion)
+		conversions = append(conversions, conversion)
+	}
+	return
+}
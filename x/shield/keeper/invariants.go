@@ -15,6 +15,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "shield", ShieldInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "global-staking-pool", GlobalStakingPoolInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "original-global-staking", StakingForShieldPurchaseInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "collateral-backing", CollateralBackingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "stake-for-shield-expiration", StakeForShieldExpirationInvariant(k))
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
@@ -28,13 +30,24 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 		for _, prov := range providers {
 			total = total.Add(prov.Rewards)
 		}
+		stakings := keeper.GetAllStakeForShields(ctx)
+		for _, staked := range stakings {
+			total.Native = total.Native.Add(staked.Rewards...)
+		}
+		unbondings := keeper.GetAllStakeUnbondings(ctx)
+		for _, unbonding := range unbondings {
+			total.Native = total.Native.Add(unbonding.Rewards...)
+		}
 
 		bondDenom := keeper.BondDenom(ctx)
 		totalInt, change := total.Native.TruncateDecimal()
 		stakedCoin := sdk.NewCoin(bondDenom, sdk.ZeroInt())
-		for _, staked := range keeper.GetAllStakeForShields(ctx) {
+		for _, staked := range stakings {
 			stakedCoin = stakedCoin.Add(sdk.NewCoin(bondDenom, staked.Amount))
 		}
+		for _, unbonding := range unbondings {
+			stakedCoin = stakedCoin.Add(sdk.NewCoin(bondDenom, unbonding.Amount))
+		}
 		totalInt = totalInt.Add(stakedCoin)
 
 		blockServiceFees := keeper.GetBlockServiceFees(ctx).Native.AmountOf(bondDenom).TruncateInt()
@@ -42,14 +55,22 @@ func ModuleAccountInvariant(keeper Keeper) sdk.Invariant {
 		totalInt = totalInt.Add(blockFeesCoin)
 
 		for _, rmb := range keeper.GetAllReimbursements(ctx) {
-			totalInt = totalInt.Add(sdk.NewCoin(bondDenom, rmb.Amount.AmountOf(bondDenom)))
+			totalInt = totalInt.Add(rmb.Amount...)
+		}
+
+		for _, pool := range keeper.GetAllPools(ctx) {
+			totalInt = totalInt.Add(pool.ForeignDeposit...)
+		}
+
+		for _, update := range keeper.GetAllPoolUpdates(ctx) {
+			totalInt = totalInt.Add(sdk.NewCoin(bondDenom, update.ServiceFees.Native.AmountOf(bondDenom)))
 		}
 
 		broken := !totalInt.IsEqual(moduleCoins) || !change.Empty()
 
 		return sdk.FormatInvariant(types.ModuleName, "module-account",
 			fmt.Sprintf("\n\tshield ModuleAccount coins: %s"+
-				"\n\tsum of remaining service fees & rewards & staked & reimbursement amount:  %s"+
+				"\n\tsum of remaining service fees & rewards & staked & reimbursement & scheduled & foreign deposit amount:  %s"+
 				"\n\tremaining change amount: %s\n",
 				moduleCoins, totalInt, change)), broken
 	}
@@ -79,6 +100,35 @@ func ProviderInvariant(keeper Keeper) sdk.Invariant {
 	}
 }
 
+// CollateralBackingInvariant checks that the active collateral of each
+// provider does not exceed its bonded and unbonding delegations.
+func CollateralBackingInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		for _, prov := range keeper.GetAllProviders(ctx) {
+			backing := keeper.ComputeTotalUnbondingAmount(ctx, prov.Address)
+			for _, del := range keeper.sk.GetAllDelegatorDelegations(ctx, prov.Address) {
+				val, found := keeper.sk.GetValidator(ctx, del.GetValidatorAddr())
+				if !found {
+					continue
+				}
+				backing = backing.Add(val.TokensFromShares(del.GetShares()).TruncateInt())
+			}
+
+			active := prov.Collateral.Sub(prov.Withdrawing)
+			if active.GT(backing) {
+				broken = true
+				msg += fmt.Sprintf("\n\tprovider %s active collateral %s exceeds bonded and unbonding delegations %s",
+					prov.Address, active, backing)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "collateral-backing",
+			fmt.Sprintf("found providers with insufficient delegations: %t%s\n", broken, msg)), broken
+	}
+}
+
 // ShieldInvariant checks that the sum of individual pools' shield is
 // equal to the total shield.
 func ShieldInvariant(keeper Keeper) sdk.Invariant {
@@ -135,3 +185,31 @@ func StakingForShieldPurchaseInvariant(keeper Keeper) sdk.Invariant {
 				sum, globalStakingPool.String())), broken
 	}
 }
+
+// StakeForShieldExpirationInvariant checks that stakes of stake-for-shield
+// purchases are released from the staking pool once their protections end,
+// so that they are either renewed or queued for unbonding.
+func StakeForShieldExpirationInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// Expired purchases are processed in the end blocker,
+		// which updates the last update time to the block time.
+		lastUpdateTime, found := keeper.GetLastUpdateTime(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "stake-for-shield-expiration", "no purchases processed yet\n"), false
+		}
+
+		var msg string
+		count := 0
+		keeper.IteratePurchaseListEntries(ctx, func(purchase types.Purchase) bool {
+			if !purchase.ProtectionEndTime.After(lastUpdateTime) && !keeper.GetOriginalStaking(ctx, purchase.PurchaseID).IsZero() {
+				count++
+				msg += fmt.Sprintf("\n\tstake of expired purchase %d is not released", purchase.PurchaseID)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "stake-for-shield-expiration",
+			fmt.Sprintf("found %d expired stake-for-shield purchases with unreleased stakes%s\n", count, msg)), broken
+	}
+}
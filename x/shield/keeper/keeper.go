@@ -22,6 +22,7 @@ type Keeper struct {
 	gk           types.GovKeeper
 	supplyKeeper types.SupplyKeeper
 	paramSpace   params.Subspace
+	converter    types.ReimbursementConverter
 }
 
 // NewKeeper creates a shield keeper.
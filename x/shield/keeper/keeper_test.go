@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"encoding/hex"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,12 +12,17 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmosGov "github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/certikfoundation/shentu/common"
 	"github.com/certikfoundation/shentu/simapp"
 
+	"github.com/certikfoundation/shentu/x/gov"
 	"github.com/certikfoundation/shentu/x/gov/testgov"
+	"github.com/certikfoundation/shentu/x/oracle"
+	"github.com/certikfoundation/shentu/x/shield/keeper"
 	"github.com/certikfoundation/shentu/x/shield/testshield"
+	"github.com/certikfoundation/shentu/x/shield/types"
 	"github.com/certikfoundation/shentu/x/staking/teststaking"
 )
 
@@ -273,11 +279,37 @@ func TestClaimProposal(t *testing.T) {
 	// 20 days later (345,600 blocks)
 	ctx = skipBlocks(ctx, 345600, tstaking, tshield, tgov)
 
-	// the purchaser submits a claim proposal
+	// claims with malformed evidence or nonexistent certificates are rejected
 	loss := shield
-	tgov.ShieldClaimProposal(purchaser, loss, poolID, 2, true)
+	govHandler := gov.NewHandler(app.GovKeeper)
+	initDeposit := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5000e6))
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, loss))
+	for _, evidence := range []types.ClaimEvidence{
+		types.NewClaimEvidence(nil, []string{"not_a_hash"}, nil),
+		types.NewClaimEvidence(nil, nil, []uint64{999}),
+	} {
+		content := types.NewShieldClaimProposal(poolID, lossCoins, 2, "test_claim_evidence", evidence, "test_claim_description", purchaser)
+		_, err := govHandler(ctx, cosmosGov.NewMsgSubmitProposal(content, initDeposit, purchaser))
+		require.Error(t, err)
+	}
+
+	// the purchaser submits a claim proposal
+	attachments := types.NewClaimEvidence(
+		[]types.ExternalTx{types.NewExternalTx("ethereum", "0x"+strings.Repeat("ab", 32))},
+		[]string{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"},
+		nil,
+	)
+	tgov.ShieldClaimProposalWithAttachments(purchaser, loss, poolID, 2, attachments, true)
 	var proposalID uint64 = 1 // TODO: unmarshal sdk.Result to obtain proposal ID
 
+	// only the pool sponsor or certifiers can acknowledge the evidence
+	require.False(t, app.GovKeeper.HasEvidenceAcknowledgement(ctx, proposalID))
+	tgov.AcknowledgeEvidence(purchaser, proposalID, false)
+	tgov.AcknowledgeEvidence(sponsorAddr, proposalID, true)
+	acknowledgements := app.GovKeeper.GetEvidenceAcknowledgements(ctx, proposalID)
+	require.True(t, len(acknowledgements) == 1)
+	require.True(t, acknowledgements[0].Acknowledger.Equals(sponsorAddr))
+
 	// verify that the withdrawal and unbonding have been delayed
 	// about 19e9 must be secured (two of three withdraws & ubds are delayed)
 	withdraws = app.ShieldKeeper.GetAllWithdraws(ctx)
@@ -297,13 +329,24 @@ func TestClaimProposal(t *testing.T) {
 	require.True(t, delUBD.Entries[2].Balance.Equal(sdk.NewInt(10e9)))
 	require.True(t, delUBD.Entries[2].CompletionTime.Equal(delayedWithdrawEnd)) // 10e9 delayed
 
+	// verify that the claim lock and unbonding delays are recorded
+	locks := app.ShieldKeeper.GetClaimLocksByProvider(ctx, delAddr)
+	require.True(t, len(locks) == 1)
+	require.True(t, locks[0].EndTime.Equal(delayedWithdrawEnd))
+	require.Equal(t, proposalID, locks[0].ProposalID)
+	delays := app.ShieldKeeper.GetUnbondingDelaysByProvider(ctx, delAddr)
+	require.True(t, len(delays) == 2)
+	require.True(t, delays[0].DelayedTime.Equal(delayedWithdrawEnd))
+	require.True(t, delays[0].Amount.Add(delays[1].Amount).Equal(sdk.NewInt(100e9)))
+
 	// create reimbursement
-	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, loss))
-	err := app.ShieldKeeper.CreateReimbursement(ctx, proposalID, lossCoins, purchaser)
+	lossCoins = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, loss))
+	err := app.ShieldKeeper.CreateReimbursement(ctx, proposalID, poolID, lossCoins, purchaser)
 	require.NoError(t, err)
 	reimbursement, err := app.ShieldKeeper.GetReimbursement(ctx, proposalID)
 	require.NoError(t, err)
 	require.True(t, reimbursement.Amount.IsEqual(lossCoins))
+	require.True(t, len(app.ShieldKeeper.GetClaimLocksByProvider(ctx, delAddr)) == 0)
 
 	// confirm admin delegation reduction
 	lossRatio := float64(loss) / float64(totalDeposit)
@@ -329,9 +372,673 @@ func TestClaimProposal(t *testing.T) {
 	// test withdraw reimbursement
 	// 56 days later (967,680 blocks)
 	ctx = skipBlocks(ctx, 967680, tstaking, tshield, tgov)
+	require.True(t, len(app.ShieldKeeper.GetAllClaimLocks(ctx)) == 0)
+	require.True(t, len(app.ShieldKeeper.GetAllUnbondingDelays(ctx)) == 0)
 
 	beforeInt := app.BankKeeper.GetCoins(ctx, purchaser).AmountOf(bondDenom)
 	tshield.WithdrawReimbursement(purchaser, proposalID, true)
 	afterInt := app.BankKeeper.GetCoins(ctx, purchaser).AmountOf(bondDenom)
 	require.True(t, beforeInt.Add(sdk.NewInt(loss)).Equal(afterInt))
 }
+
+// TestAllocateCollateral tests pool capacities under restricted
+// collateral allocations.
+func TestAllocateCollateral(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	sponsorAddr2 := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+	delAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// shield admin and delegator deposit collateral
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tstaking.Delegate(delAddr, valAddr, 100e9)
+	tshield.DepositCollateral(delAddr, 100e9, true)
+
+	// create two pools
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 500e9, "CertiK", "fake_description")
+	tshield.CreatePool(shieldAdmin, sponsorAddr2, 200e6, 10e9, 500e9, "CertiK2", "fake_description")
+	pools := app.ShieldKeeper.GetAllPools(ctx)
+	require.True(t, len(pools) == 2)
+	poolID, poolID2 := pools[0].ID, pools[1].ID
+
+	// the delegator underwrites the second pool only
+	tshield.AllocateCollateral(delAddr, []types.PoolAllocation{types.NewPoolAllocation(poolID2, sdk.OneDec())}, true)
+	require.True(t, app.ShieldKeeper.GetPoolCapacity(ctx, poolID).Equal(sdk.NewInt(200e9)))
+	require.True(t, app.ShieldKeeper.GetPoolCapacity(ctx, poolID2).Equal(sdk.NewInt(300e9)))
+
+	// allocations to unknown pools or leaving a pool uncovered must fail
+	tshield.AllocateCollateral(shieldAdmin, []types.PoolAllocation{types.NewPoolAllocation(3, sdk.OneDec())}, false)
+	tshield.AllocateCollateral(shieldAdmin, []types.PoolAllocation{types.NewPoolAllocation(poolID2, sdk.OneDec())}, false)
+
+	// the first pool can only sell up to half of the admin's collateral
+	require.True(t, app.ShieldKeeper.GetPoolMaxShield(ctx, pools[0]).Equal(sdk.NewInt(100e9)))
+	tshield.PurchaseShield(purchaser, 60e9, poolID, false)
+	tshield.PurchaseShield(purchaser, 40e9, poolID, true)
+
+	// a claim on the first pool is only paid by the admin's collateral
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10e9))
+	err := app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 3, lossCoins, time.Hour)
+	require.NoError(t, err)
+	err = app.ShieldKeeper.CreateReimbursement(ctx, 1, poolID, lossCoins, purchaser)
+	require.NoError(t, err)
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, delAddr)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(100e9)))
+	provider, _ = app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(190e9)))
+
+	// resetting the allocations makes the collateral back all pools again
+	tshield.AllocateCollateral(delAddr, nil, true)
+	require.True(t, app.ShieldKeeper.GetPoolCapacity(ctx, poolID).Equal(sdk.NewInt(290e9)))
+
+	// closing a pool prunes the allocations to it, except for a provider's last allocation
+	delAllocations := []types.PoolAllocation{
+		types.NewPoolAllocation(poolID, sdk.OneDec()),
+		types.NewPoolAllocation(poolID2, sdk.NewDecWithPrec(5, 1)),
+	}
+	tshield.AllocateCollateral(delAddr, delAllocations, true)
+	adminAllocations := []types.PoolAllocation{types.NewPoolAllocation(poolID2, sdk.OneDec())}
+	tshield.AllocateCollateral(shieldAdmin, adminAllocations, true)
+	pool2, found := app.ShieldKeeper.GetPool(ctx, poolID2)
+	require.True(t, found)
+	app.ShieldKeeper.ClosePool(ctx, pool2)
+	provider, _ = app.ShieldKeeper.GetProvider(ctx, delAddr)
+	require.Equal(t, delAllocations[:1], provider.Allocations)
+	provider, _ = app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.Equal(t, adminAllocations, provider.Allocations)
+	require.True(t, app.ShieldKeeper.GetPoolCapacity(ctx, poolID).Equal(sdk.NewInt(100e9)))
+}
+
+// TestTranches tests that junior collateral absorbs losses first
+// and earns a larger share of service fees.
+func TestTranches(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+	delAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(20e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// shield admin deposits senior collateral and delegator deposits junior collateral
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tstaking.Delegate(delAddr, valAddr, 20e9)
+	tshield.DepositCollateral(delAddr, 20e9, true)
+	tshield.SetTranche(delAddr, "junior", true)
+	tshield.SetTranche(delAddr, "mezzanine", false)
+
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 500e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+	tshield.PurchaseShield(purchaser, 60e9, poolID, true)
+
+	// junior collateral earns 1.5 times the fees per unit of collateral
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	admin, _ := app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	junior, _ := app.ShieldKeeper.GetProvider(ctx, delAddr)
+	require.True(t, admin.Rewards.Native.AmountOf(bondDenom).IsPositive())
+	ratio := junior.Rewards.Native.AmountOf(bondDenom).Quo(admin.Rewards.Native.AmountOf(bondDenom))
+	require.True(t, ratio.Sub(sdk.NewDecWithPrec(15, 2)).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+
+	// the tranche cannot be changed while a claim is pending
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30e9))
+	err := app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 2, lossCoins, time.Hour)
+	require.NoError(t, err)
+	tshield.SetTranche(delAddr, "senior", false)
+
+	// junior collateral is wiped out before senior collateral pays the rest
+	err = app.ShieldKeeper.CreateReimbursement(ctx, 1, poolID, lossCoins, purchaser)
+	require.NoError(t, err)
+	var expected int64 = 190e9
+	if hex.EncodeToString(shieldAdmin) < hex.EncodeToString(delAddr) {
+		expected -= 1 // adjust for discrepancy due to sorting
+	}
+	admin, _ = app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, admin.Collateral.Equal(sdk.NewInt(expected)))
+	junior, _ = app.ShieldKeeper.GetProvider(ctx, delAddr)
+	require.True(t, junior.Collateral.Equal(sdk.NewInt(190e9-expected)))
+
+	tshield.SetTranche(delAddr, "senior", true)
+}
+
+// TestPoolSunset tests scheduled pool updates and pool sunset.
+func TestPoolSunset(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// shield admin deposits collateral and creates a pool
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 100e9, "CertiK", "fake_description")
+	pools := app.ShieldKeeper.GetAllPools(ctx)
+	require.True(t, len(pools) == 1)
+	poolID := pools[0].ID
+	tshield.PurchaseShield(purchaser, 10e9, poolID, true)
+
+	// the sponsor adds a foreign deposit
+	foreignDeposit := sdk.NewCoins(sdk.NewInt64Coin("uusdt", 10e9))
+	simapp.AddCoinsToAcc(app, ctx, sponsorAddr, foreignDeposit)
+	_, err := app.ShieldKeeper.UpdatePool(ctx, poolID, "", sponsorAddr, sdk.NewCoins(), types.MixedCoins{Foreign: foreignDeposit}, sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetCoins(ctx, sponsorAddr).AmountOf("uusdt").IsZero())
+
+	// schedule a shield limit increase with an additional deposit
+	effectiveTime := ctx.BlockTime().Add(time.Hour)
+	tshield.UpdatePool(shieldAdmin, poolID, 0, 10e9, 150e9, effectiveTime, false)
+	beforeInt := app.BankKeeper.GetCoins(ctx, shieldAdmin).AmountOf(bondDenom)
+	tshield.UpdatePool(shieldAdmin, poolID, 100e6, 0, 150e9, effectiveTime, true)
+	afterInt := app.BankKeeper.GetCoins(ctx, shieldAdmin).AmountOf(bondDenom)
+	require.True(t, beforeInt.Sub(afterInt).Equal(sdk.NewInt(100e6)))
+	pool, _ := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.ShieldLimit.Equal(sdk.NewInt(100e9)))
+	require.Len(t, app.ShieldKeeper.GetPoolUpdatesByPool(ctx, poolID), 1)
+
+	// the update is applied once it becomes effective
+	serviceFees := app.ShieldKeeper.GetServiceFees(ctx).Native.AmountOf(bondDenom)
+	ctx = skipBlocks(ctx, 720, tstaking, tshield, tgov)
+	pool, _ = app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.ShieldLimit.Equal(sdk.NewInt(150e9)))
+	require.Empty(t, app.ShieldKeeper.GetAllPoolUpdates(ctx))
+	require.True(t, app.ShieldKeeper.GetServiceFees(ctx).Native.AmountOf(bondDenom).GT(serviceFees))
+
+	// schedule another update and sunset the pool
+	tshield.UpdatePool(shieldAdmin, poolID, 100e6, 0, 200e9, ctx.BlockTime().Add(time.Hour*24*365), true)
+	tshield.SunsetPool(purchaser, poolID, false)
+	tshield.SunsetPool(shieldAdmin, poolID, true)
+	tshield.SunsetPool(shieldAdmin, poolID, false)
+
+	// a sunset pool cannot be purchased, resumed or updated
+	tshield.PurchaseShield(purchaser, 10e9, poolID, false)
+	tshield.Handle(types.NewMsgResumePool(shieldAdmin, poolID), false)
+	tshield.UpdatePool(shieldAdmin, poolID, 100e6, 0, 200e9, time.Time{}, false)
+
+	// existing coverage is honored until it expires
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	pool, found := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	require.True(t, pool.Sunset)
+	require.False(t, pool.Active)
+
+	// the pool is closed and the pending deposit is refunded after all purchases expire
+	beforeInt = app.BankKeeper.GetCoins(ctx, shieldAdmin).AmountOf(bondDenom)
+	ctx = skipBlocks(ctx, 967680, tstaking, tshield, tgov)
+	_, found = app.ShieldKeeper.GetPool(ctx, poolID)
+	require.False(t, found)
+	require.Empty(t, app.ShieldKeeper.GetAllPoolUpdates(ctx))
+	afterInt = app.BankKeeper.GetCoins(ctx, shieldAdmin).AmountOf(bondDenom)
+	require.True(t, afterInt.Sub(beforeInt).Equal(sdk.NewInt(100e6)))
+
+	// the unused foreign deposit is refunded to the sponsor
+	require.True(t, app.BankKeeper.GetCoins(ctx, sponsorAddr).AmountOf("uusdt").Equal(sdk.NewInt(10e9)))
+}
+
+// TestServiceFeeTopUps tests that immediate and scheduled service fee
+// top-ups both take the fees from the updater.
+func TestServiceFeeTopUps(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 100e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+
+	balances := func() (sdk.Int, sdk.Int) {
+		admin := app.BankKeeper.GetCoins(ctx, shieldAdmin).AmountOf(bondDenom)
+		module := app.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(bondDenom)
+		return admin, module
+	}
+
+	// an immediate top-up
+	adminBefore, moduleBefore := balances()
+	tshield.UpdatePool(shieldAdmin, poolID, 100e6, 0, 0, time.Time{}, true)
+	adminAfter, moduleAfter := balances()
+	require.True(t, adminBefore.Sub(adminAfter).Equal(sdk.NewInt(100e6)))
+	require.True(t, moduleAfter.Sub(moduleBefore).Equal(sdk.NewInt(100e6)))
+	msg, broken := keeper.ModuleAccountInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+
+	// a scheduled top-up of the same fees leaves the same balances once applied
+	adminBefore, moduleBefore = balances()
+	tshield.UpdatePool(shieldAdmin, poolID, 100e6, 0, 0, ctx.BlockTime().Add(time.Hour), true)
+	ctx = skipBlocks(ctx, 720, tstaking, tshield, tgov)
+	require.Empty(t, app.ShieldKeeper.GetAllPoolUpdates(ctx))
+	adminAfter, moduleAfter = balances()
+	require.True(t, adminBefore.Sub(adminAfter).Equal(sdk.NewInt(100e6)))
+	require.True(t, moduleAfter.Sub(moduleBefore).Equal(sdk.NewInt(100e6)))
+	msg, broken = keeper.ModuleAccountInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+
+	// an immediate top-up beyond the updater's balance fails
+	tshield.UpdatePool(shieldAdmin, poolID, 1e12, 0, 0, time.Time{}, false)
+}
+
+// TestStakeUnbonding tests the maturity of unstaked stake-for-shield
+// deposits and the stakers' share of block rewards.
+func TestStakeUnbonding(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(50e9))[0]
+	funder := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// shield admin deposits collateral and creates a pool
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 100e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+
+	// the purchaser stakes for shield
+	tshield.StakeForShield(purchaser, 10e9, poolID, true)
+	staking, found := app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	require.True(t, found)
+	stakedAmt := staking.Amount
+	require.True(t, stakedAmt.IsPositive())
+
+	// stakers accrue their share of block rewards
+	blockRewards := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e9))
+	require.NoError(t, app.ShieldKeeper.FundShieldBlockRewards(ctx, blockRewards, funder))
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+	staking, _ = app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	stakingRewardsRate := app.ShieldKeeper.GetPoolParams(ctx).StakingRewardsRate
+	require.True(t, staking.Rewards.AmountOf(bondDenom).Equal(sdk.NewDec(1e9).Mul(stakingRewardsRate)))
+
+	// unstaking more than staked fails
+	tshield.UnstakeFromShield(purchaser, stakedAmt.Int64()+1, poolID, false)
+	tshield.UnstakeFromShield(purchaser, stakedAmt.Int64(), poolID, true)
+
+	// the unstaked deposit enters the unbonding queue once the purchase expires
+	protectionBlocks := int64(app.ShieldKeeper.GetPoolParams(ctx).ProtectionPeriod/time.Second) / int64(common.SecondsPerBlock)
+	ctx = skipBlocks(ctx, protectionBlocks+1, tstaking, tshield, tgov)
+	_, found = app.ShieldKeeper.GetStakeForShield(ctx, poolID, purchaser)
+	require.False(t, found)
+	unbondings := app.ShieldKeeper.GetStakeUnbondingsByPurchaser(ctx, purchaser)
+	require.Len(t, unbondings, 1)
+	require.True(t, unbondings[0].Amount.Equal(stakedAmt))
+	require.True(t, unbondings[0].Rewards.IsEqual(staking.Rewards))
+
+	// the deposit and rewards are released after the maturity period
+	beforeInt := app.BankKeeper.GetCoins(ctx, purchaser).AmountOf(bondDenom)
+	unbondingBlocks := int64(app.ShieldKeeper.GetPoolParams(ctx).StakingUnbondingPeriod/time.Second) / int64(common.SecondsPerBlock)
+	ctx = skipBlocks(ctx, unbondingBlocks+1, tstaking, tshield, tgov)
+	require.Empty(t, app.ShieldKeeper.GetAllStakeUnbondings(ctx))
+	afterInt := app.BankKeeper.GetCoins(ctx, purchaser).AmountOf(bondDenom)
+	rewardsInt := staking.Rewards.AmountOf(bondDenom).TruncateInt()
+	require.True(t, afterInt.Sub(beforeInt).Equal(stakedAmt.Add(rewardsInt)))
+}
+
+// TestSlashProviders tests that providers' collateral follows
+// slashing of their delegations.
+func TestSlashProviders(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	delAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(200e9))[0]
+	delAddr2 := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(200e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+	valAddr2 := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, tstaking.Denom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, tstaking.Denom)
+
+	// set up two validators
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddr2, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// the first delegator fully collateralizes its delegation to the slashed validator,
+	// and the second one only collateralizes half of its delegations
+	tstaking.Delegate(delAddr, valAddr, 100e9)
+	tshield.DepositCollateral(delAddr, 100e9, true)
+	tstaking.Delegate(delAddr2, valAddr, 50e9)
+	tstaking.Delegate(delAddr2, valAddr2, 50e9)
+	tshield.DepositCollateral(delAddr2, 50e9, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// slash the first validator by 10%
+	validator := tstaking.CheckValidator(valAddr, sdk.Bonded, false)
+	app.StakingKeeper.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), validator.ConsensusPower(), sdk.NewDecWithPrec(1, 1))
+
+	// collateral is reduced proportionally to the slashed delegations
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, delAddr)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(90e9)))
+	require.True(t, provider.DelegationBonded.Equal(sdk.NewInt(90e9)))
+	provider2, _ := app.ShieldKeeper.GetProvider(ctx, delAddr2)
+	require.True(t, provider2.Collateral.Equal(sdk.NewInt(47.5e9)))
+	require.True(t, provider2.DelegationBonded.Equal(sdk.NewInt(95e9)))
+	require.True(t, app.ShieldKeeper.GetTotalCollateral(ctx).Equal(sdk.NewInt(137.5e9)))
+
+	msg, broken := keeper.CollateralBackingInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.ProviderInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// TestSlashProvidersWithClaims tests that slashing reduces withdrawing
+// collateral backed by slashed unbondings and leaves claimed collateral
+// covered.
+func TestSlashProvidersWithClaims(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+	delAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+	valAddr2 := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up two validators
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddr2, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// the admin's collateral is locked for a claim
+	tstaking.Delegate(shieldAdmin, valAddr, 100e9)
+	tshield.DepositCollateral(shieldAdmin, 100e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 10e9, 500e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+	tshield.PurchaseShield(purchaser, 40e9, poolID, true)
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30e9))
+	err := app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 2, lossCoins, time.Hour)
+	require.NoError(t, err)
+
+	// another provider joins after the claim
+	tstaking.Delegate(delAddr, valAddr2, 50e9)
+	tshield.DepositCollateral(delAddr, 50e9, true)
+
+	// the admin unbonds a part of its delegation
+	validator := tstaking.CheckValidator(valAddr, sdk.Bonded, false)
+	power, infractionHeight := validator.ConsensusPower(), ctx.BlockHeight()
+	tstaking.Undelegate(shieldAdmin, valAddr, 20e9, true)
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, provider.Withdrawing.Equal(sdk.NewInt(20e9)))
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	// slash the validator by 80%, more than the admin's collateral not locked for the claim
+	app.StakingKeeper.Slash(ctx, validator.GetConsAddr(), infractionHeight, power, sdk.NewDecWithPrec(8, 1))
+
+	// the slash of 64 bonded and 16 unbonding is capped at 70, leaving 30 for the claim,
+	// and the 10 not backed by the remaining delegation is withdrawn
+	provider, _ = app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(30e9)))
+	require.True(t, provider.Withdrawing.Equal(sdk.NewInt(14e9)))
+	require.True(t, provider.DelegationBonded.Equal(sdk.NewInt(16e9)))
+	withdrawn := sdk.ZeroInt()
+	for _, withdraw := range app.ShieldKeeper.GetWithdrawsByProvider(ctx, shieldAdmin) {
+		withdrawn = withdrawn.Add(withdraw.Amount)
+	}
+	require.True(t, withdrawn.Equal(provider.Withdrawing))
+	require.True(t, app.ShieldKeeper.GetTotalCollateral(ctx).Equal(sdk.NewInt(80e9)))
+	require.True(t, app.ShieldKeeper.GetTotalWithdrawing(ctx).Equal(sdk.NewInt(14e9)))
+
+	msg, broken := keeper.CollateralBackingInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.ProviderInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+
+	// the rest of the purchase can still be claimed
+	lossCoins = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10e9))
+	require.NotPanics(t, func() {
+		err = app.ShieldKeeper.SecureCollaterals(ctx, 2, poolID, purchaser, 2, lossCoins, time.Hour)
+	})
+	require.NoError(t, err)
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).Equal(sdk.NewInt(40e9)))
+}
+
+// TestForeignReimbursement tests that a claim for a loss in a foreign
+// denom is settled from the sponsor's foreign deposit first, and in
+// the bond denom for the remainder.
+func TestForeignReimbursement(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1))[0]
+	purchaser := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10e9))[0]
+	simapp.AddCoinsToAcc(app, ctx, shieldAdmin, sdk.NewCoins(sdk.NewInt64Coin("uusdt", 10e9)))
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+
+	// the sponsor's foreign deposit is added to the pool
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 500e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+	foreignDeposit := types.MixedCoins{Foreign: sdk.NewCoins(sdk.NewInt64Coin("uusdt", 10e9))}
+	_, err := app.ShieldKeeper.UpdatePool(ctx, poolID, "", shieldAdmin, sdk.NewCoins(), foreignDeposit, sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	pool, _ := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.ForeignDeposit.IsEqual(foreignDeposit.Foreign))
+	tshield.PurchaseShield(purchaser, 40e9, poolID, true)
+
+	// foreign losses cannot be claimed without a rate confirmed by the oracle
+	lossCoins := sdk.NewCoins(sdk.NewInt64Coin("uusdt", 30e9))
+	err = app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 2, lossCoins, time.Hour)
+	require.True(t, types.ErrUnsupportedLossDenom.Is(err))
+
+	params := app.ShieldKeeper.GetClaimProposalParams(ctx)
+	params.ConversionRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdt", sdk.NewDecWithPrec(5, 1)))
+	app.ShieldKeeper.SetClaimProposalParams(ctx, params)
+	err = app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 2, lossCoins, time.Hour)
+	require.True(t, types.ErrConversionUnavailable.Is(err))
+
+	task := oracle.NewTask("uusdt", types.PegTaskFunction, ctx.BlockHeight(), sdk.NewCoins(), "", ctx.BlockTime(), shieldAdmin, ctx.BlockHeight(), 0)
+	task.Status = oracle.TaskStatusSucceeded
+	task.Result = sdk.NewInt(95)
+	app.OracleKeeper.SetTask(ctx, task)
+	lossAmt, err := app.ShieldKeeper.ConvertLoss(ctx, lossCoins)
+	require.NoError(t, err)
+	require.True(t, lossAmt.Equal(sdk.NewInt(15e9)))
+
+	// the loss is secured by its worth in the bond denom
+	err = app.ShieldKeeper.SecureCollaterals(ctx, 1, poolID, purchaser, 2, lossCoins, time.Hour)
+	require.NoError(t, err)
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).Equal(sdk.NewInt(15e9)))
+
+	// a rate change after submission does not affect the claim
+	params.ConversionRates = sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdt", sdk.NewDec(1)))
+	app.ShieldKeeper.SetClaimProposalParams(ctx, params)
+
+	// the foreign deposit pays 10e9 uusdt, and providers pay 10e9 for the rest
+	err = app.ShieldKeeper.CreateReimbursement(ctx, 1, poolID, lossCoins, purchaser)
+	require.NoError(t, err)
+	reimbursement, err := app.ShieldKeeper.GetReimbursement(ctx, 1)
+	require.NoError(t, err)
+	expected := sdk.NewCoins(sdk.NewInt64Coin("uusdt", 10e9), sdk.NewInt64Coin(bondDenom, 10e9))
+	require.True(t, reimbursement.Amount.IsEqual(expected))
+	pool, _ = app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.ForeignDeposit.IsZero())
+	provider, _ := app.ShieldKeeper.GetProvider(ctx, shieldAdmin)
+	require.True(t, provider.Collateral.Equal(sdk.NewInt(190e9)))
+	require.True(t, app.ShieldKeeper.GetTotalClaimed(ctx).IsZero())
+	_, found := app.ShieldKeeper.GetClaimConversion(ctx, 1)
+	require.False(t, found)
+
+	msg, broken := keeper.ModuleAccountInvariant(app.ShieldKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// TestPoolRoles tests the permissions of pool operators and sponsors.
+func TestPoolRoles(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now().UTC()})
+
+	// create and add addresses
+	shieldAdmin := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(250e9))[0]
+	app.ShieldKeeper.SetAdmin(ctx, shieldAdmin)
+	sponsorAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1e9))[0]
+	operator := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1e9))[0]
+
+	// validator addresses
+	valAddr := sdk.ValAddress(simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(100e6))[0])
+
+	// set up testing helpers
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	bondDenom := tstaking.Denom
+	tshield := testshield.NewHelper(t, ctx, app.ShieldKeeper, bondDenom)
+	tgov := testgov.NewHelper(t, ctx, app.GovKeeper, bondDenom)
+
+	// set up a validator
+	tstaking.CreateValidatorWithValPower(valAddr, 100, true)
+	ctx = nextBlock(ctx, tstaking, tshield, tgov)
+
+	tstaking.Delegate(shieldAdmin, valAddr, 200e9)
+	tshield.DepositCollateral(shieldAdmin, 200e9, true)
+	tshield.CreatePool(shieldAdmin, sponsorAddr, 200e6, 50e9, 500e9, "CertiK", "fake_description")
+	poolID := app.ShieldKeeper.GetAllPools(ctx)[0].ID
+
+	// the sponsor can top up fees and update the description, but nothing else
+	before := app.BankKeeper.GetCoins(ctx, sponsorAddr).AmountOf(bondDenom)
+	_, err := app.ShieldKeeper.UpdatePool(ctx, poolID, "new_description", sponsorAddr, sdk.NewCoins(), types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100e6))}, sdk.ZeroInt(), time.Time{})
+	require.NoError(t, err)
+	after := app.BankKeeper.GetCoins(ctx, sponsorAddr).AmountOf(bondDenom)
+	require.True(t, before.Sub(after).Equal(sdk.NewInt(100e6)))
+	pool, _ := app.ShieldKeeper.GetPool(ctx, poolID)
+	require.Equal(t, "new_description", pool.Description)
+	_, err = app.ShieldKeeper.UpdatePool(ctx, poolID, "", sponsorAddr, sdk.NewCoins(), types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1e12))}, sdk.ZeroInt(), time.Time{})
+	require.Error(t, err)
+	require.True(t, app.BankKeeper.GetCoins(ctx, sponsorAddr).AmountOf(bondDenom).Equal(after))
+	for _, invariant := range []sdk.Invariant{
+		keeper.ModuleAccountInvariant(app.ShieldKeeper),
+		keeper.ProviderInvariant(app.ShieldKeeper),
+		keeper.ShieldInvariant(app.ShieldKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+	tshield.UpdatePool(sponsorAddr, poolID, 0, 0, 600e9, time.Time{}, false)
+	tshield.PausePool(sponsorAddr, poolID, false)
+
+	// operators are added by governance and can manage the pool
+	tshield.UpdatePool(operator, poolID, 0, 0, 600e9, time.Time{}, false)
+	tshield.PoolOperatorUpdateProposal(poolID, operator, false, true)
+	tshield.PoolOperatorUpdateProposal(poolID, operator, false, false)
+	require.True(t, app.ShieldKeeper.IsPoolOperator(ctx, poolID, operator))
+	tshield.UpdatePool(operator, poolID, 0, 0, 600e9, time.Time{}, true)
+	pool, _ = app.ShieldKeeper.GetPool(ctx, poolID)
+	require.True(t, pool.ShieldLimit.Equal(sdk.NewInt(600e9)))
+	tshield.PausePool(operator, poolID, true)
+
+	// removed operators can no longer manage the pool
+	tshield.PoolOperatorUpdateProposal(poolID, operator, true, true)
+	tshield.PoolOperatorUpdateProposal(poolID, operator, true, false)
+	tshield.UpdatePool(operator, poolID, 0, 0, 700e9, time.Time{}, false)
+}
@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"strconv"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/certikfoundation/shentu/x/shield/types"
 )
@@ -162,6 +166,9 @@ func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, shield sdk.C
 	// Set the new project pool.
 	poolID := k.GetNextPoolID(ctx)
 	pool := types.NewPool(poolID, description, sponsor, sponsorAddr, shieldLimit, sdk.ZeroInt())
+	if err := k.depositForeign(ctx, &pool, creator, serviceFees.Foreign); err != nil {
+		return 0, err
+	}
 	k.SetPool(ctx, pool)
 	k.SetNextPoolID(ctx, poolID+1)
 
@@ -173,21 +180,49 @@ func (k Keeper) CreatePool(ctx sdk.Context, creator sdk.AccAddress, shield sdk.C
 	return poolID, nil
 }
 
-// UpdatePool updates pool info and shield for B.
-func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, updater sdk.AccAddress, shield sdk.Coins, serviceFees types.MixedCoins, shieldLimit sdk.Int) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
-	}
-
+// UpdatePool updates pool info and shield for B. If the effective time
+// is in the future, the shield limit and service fee deposit are
+// scheduled to take effect at that time. The shield admin and pool
+// operators can update any field, while the sponsor of the pool can only
+// top up deposits and update the description.
+func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, updater sdk.AccAddress, shield sdk.Coins, serviceFees types.MixedCoins, shieldLimit sdk.Int, effectiveTime time.Time) (types.Pool, error) {
 	// Update pool info.
 	pool, found := k.GetPool(ctx, poolID)
 	if !found {
 		return types.Pool{}, types.ErrNoPoolFound
 	}
+	if !k.isPoolManager(ctx, poolID, updater) {
+		if !updater.Equals(pool.SponsorAddress) {
+			return types.Pool{}, types.ErrNotPoolOperator
+		}
+		// Sponsors can only top up deposits and update descriptions.
+		if !shield.IsZero() || !shieldLimit.IsZero() {
+			return types.Pool{}, types.ErrSponsorNotAllowed
+		}
+	}
+	if pool.Sunset {
+		return types.Pool{}, types.ErrPoolSunset
+	}
 	if description != "" {
 		pool.Description = description
 	}
+	if err := k.depositForeign(ctx, &pool, updater, serviceFees.Foreign); err != nil {
+		return types.Pool{}, err
+	}
+
+	// Schedule the update if it takes effect in the future.
+	if effectiveTime.After(ctx.BlockTime()) {
+		if !shield.IsZero() {
+			return types.Pool{}, types.ErrInvalidEffectiveTime
+		}
+		update := types.NewPoolUpdate(poolID, shieldLimit, types.MixedCoins{Native: serviceFees.Native}, updater, effectiveTime)
+		if err := k.SchedulePoolUpdate(ctx, update); err != nil {
+			return types.Pool{}, err
+		}
+		k.SetPool(ctx, pool)
+		return pool, nil
+	}
+
 	if !shieldLimit.IsZero() {
 		pool.ShieldLimit = shieldLimit
 	}
@@ -200,22 +235,30 @@ func (k Keeper) UpdatePool(ctx sdk.Context, poolID uint64, description string, u
 		}
 	} else if !serviceFees.Native.IsZero() {
 		// Allow adding service fees without purchasing more shield.
-		totalServiceFees := k.GetServiceFees(ctx)
-		totalServiceFees = totalServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees.Native...)})
-		k.SetServiceFees(ctx, totalServiceFees)
-		totalRemainingServiceFees := k.GetRemainingServiceFees(ctx)
-		totalRemainingServiceFees = totalRemainingServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees.Native...)})
-		k.SetRemainingServiceFees(ctx, totalRemainingServiceFees)
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, updater, types.ModuleName, serviceFees.Native); err != nil {
+			return pool, err
+		}
+		k.addServiceFees(ctx, serviceFees.Native)
 	}
 
 	return pool, nil
 }
 
+// addServiceFees adds service fees held by the module account to the
+// service fees to be distributed.
+func (k Keeper) addServiceFees(ctx sdk.Context, serviceFees sdk.Coins) {
+	totalServiceFees := k.GetServiceFees(ctx)
+	totalServiceFees = totalServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
+	k.SetServiceFees(ctx, totalServiceFees)
+	totalRemainingServiceFees := k.GetRemainingServiceFees(ctx)
+	totalRemainingServiceFees = totalRemainingServiceFees.Add(types.MixedDecCoins{Native: sdk.NewDecCoinsFromCoins(serviceFees...)})
+	k.SetRemainingServiceFees(ctx, totalRemainingServiceFees)
+}
+
 // PausePool sets an active pool to be inactive.
 func (k Keeper) PausePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
+	if !k.isPoolManager(ctx, id, updater) {
+		return types.Pool{}, types.ErrNotPoolOperator
 	}
 	pool, found := k.GetPool(ctx, id)
 	if !found {
@@ -231,14 +274,16 @@ func (k Keeper) PausePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (t
 
 // ResumePool sets an inactive pool to be active.
 func (k Keeper) ResumePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
+	if !k.isPoolManager(ctx, id, updater) {
+		return types.Pool{}, types.ErrNotPoolOperator
 	}
 	pool, found := k.GetPool(ctx, id)
 	if !found {
 		return types.Pool{}, types.ErrNoPoolFound
 	}
+	if pool.Sunset {
+		return types.Pool{}, types.ErrPoolSunset
+	}
 	if pool.Active {
 		return types.Pool{}, types.ErrPoolAlreadyActive
 	}
@@ -247,6 +292,26 @@ func (k Keeper) ResumePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (
 	return pool, nil
 }
 
+// SunsetPool stops new purchases of a pool permanently. Existing
+// purchases remain valid until they expire, after which the pool is
+// closed and its pending service fee deposits are refunded.
+func (k Keeper) SunsetPool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (types.Pool, error) {
+	if !k.isPoolManager(ctx, id, updater) {
+		return types.Pool{}, types.ErrNotPoolOperator
+	}
+	pool, found := k.GetPool(ctx, id)
+	if !found {
+		return types.Pool{}, types.ErrNoPoolFound
+	}
+	if pool.Sunset {
+		return types.Pool{}, types.ErrPoolSunset
+	}
+	pool.Active = false
+	pool.Sunset = true
+	k.SetPool(ctx, pool)
+	return pool, nil
+}
+
 // GetAllPools retrieves all pools in the store.
 func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
 	k.IterateAllPools(ctx, func(pool types.Pool) bool {
@@ -256,20 +321,80 @@ func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
 	return pools
 }
 
-// ClosePool closes the pool.
+// ClosePool closes the pool, refunds its pending service fee deposits
+// and the sponsor's unused foreign deposit, and prunes the providers'
+// allocations to the pool.
 func (k Keeper) ClosePool(ctx sdk.Context, pool types.Pool) {
+	k.RefundPoolUpdates(ctx, pool.ID)
+	for _, operator := range k.GetPoolOperators(ctx, pool.ID) {
+		k.DeletePoolOperator(ctx, pool.ID, operator.Address)
+	}
+	k.pruneAllocations(ctx, pool.ID)
+	k.refundForeignDeposit(ctx, pool)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPoolKey(pool.ID))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClosePool,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.ID, 10)),
+		),
+	)
+}
+
+// refundForeignDeposit returns the unused foreign deposit of a pool to
+// its sponsor, or to the shield admin if the pool has no sponsor address.
+func (k Keeper) refundForeignDeposit(ctx sdk.Context, pool types.Pool) {
+	if pool.ForeignDeposit.IsZero() {
+		return
+	}
+	recipient := pool.SponsorAddress
+	if recipient.Empty() {
+		recipient = k.GetAdmin(ctx)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, pool.ForeignDeposit); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundPoolDeposit,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(pool.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, pool.ForeignDeposit.String()),
+		),
+	)
+}
+
+// depositForeign transfers foreign coins from the depositor to the pool
+// as the sponsor's foreign deposit.
+func (k Keeper) depositForeign(ctx sdk.Context, pool *types.Pool, depositor sdk.AccAddress, deposit sdk.Coins) error {
+	if deposit.IsZero() {
+		return nil
+	}
+	if bondDenom := k.BondDenom(ctx); deposit.AmountOf(bondDenom).IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "foreign deposit cannot be in %s", bondDenom)
+	}
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, deposit); err != nil {
+		return err
+	}
+	pool.ForeignDeposit = pool.ForeignDeposit.Add(deposit...)
+	return nil
 }
 
-// ClosePools closes pools when both of the pool's shield and shield limit is non-positive.
+// ClosePools closes pools when both of the pool's shield and shield limit is non-positive,
+// and sunset pools whose purchases have all expired.
 func (k Keeper) ClosePools(ctx sdk.Context) {
+	var closing []types.Pool
 	k.IterateAllPools(ctx, func(pool types.Pool) bool {
-		if !pool.Shield.IsPositive() && !pool.ShieldLimit.IsPositive() {
-			k.ClosePool(ctx, pool)
+		if !pool.Shield.IsPositive() && (!pool.ShieldLimit.IsPositive() || pool.Sunset) {
+			closing = append(closing, pool)
 		}
 		return false
 	})
+	for _, pool := range closing {
+		k.ClosePool(ctx, pool)
+	}
 }
 
 // IterateAllPools iterates over the all the stored pools and performs a callback function.
@@ -290,10 +415,9 @@ func (k Keeper) IterateAllPools(ctx sdk.Context, callback func(pool types.Pool)
 
 // UpdateSponsor updates the sponsor information of a given pool.
 func (k Keeper) UpdateSponsor(ctx sdk.Context, poolID uint64, newSponsor string, newSponsorAddr, updater sdk.AccAddress) (types.Pool, error) {
-	// Check admin status of the updater.
-	admin := k.GetAdmin(ctx)
-	if !updater.Equals(admin) {
-		return types.Pool{}, types.ErrNotShieldAdmin
+	// Check admin or operator status of the updater.
+	if !k.isPoolManager(ctx, poolID, updater) {
+		return types.Pool{}, types.ErrNotPoolOperator
 	}
 
 	// Retrieve the pool and update its sponsor information.
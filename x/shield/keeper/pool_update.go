@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SchedulePoolUpdate escrows the service fee deposit of a pool update
+// and inserts the update into the pool update queue.
+func (k Keeper) SchedulePoolUpdate(ctx sdk.Context, update types.PoolUpdate) error {
+	if !update.ServiceFees.Native.IsZero() {
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, update.Depositor, types.ModuleName, update.ServiceFees.Native); err != nil {
+			return err
+		}
+	}
+	k.InsertPoolUpdateQueue(ctx, update)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSchedulePoolUpdate,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(update.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyShieldLimit, update.ShieldLimit.String()),
+			sdk.NewAttribute(types.AttributeKeyServiceFees, update.ServiceFees.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, update.EffectiveTime.String()),
+		),
+	)
+	return nil
+}
+
+// InsertPoolUpdateQueue inserts a pool update into the pool update queue.
+func (k Keeper) InsertPoolUpdateQueue(ctx sdk.Context, update types.PoolUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPoolUpdateTimeKey(update.EffectiveTime)
+	var timeSlice []types.PoolUpdate
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &timeSlice)
+	}
+	timeSlice = append(timeSlice, update)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(timeSlice))
+}
+
+// IteratePoolUpdates iterates through all scheduled pool updates.
+func (k Keeper) IteratePoolUpdates(ctx sdk.Context, callback func(update types.PoolUpdate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolUpdateQueueKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.PoolUpdate
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		for _, update := range timeSlice {
+			if callback(update) {
+				return
+			}
+		}
+	}
+}
+
+// GetAllPoolUpdates retrieves all scheduled pool updates.
+func (k Keeper) GetAllPoolUpdates(ctx sdk.Context) (updates []types.PoolUpdate) {
+	k.IteratePoolUpdates(ctx, func(update types.PoolUpdate) bool {
+		updates = append(updates, update)
+		return false
+	})
+	return updates
+}
+
+// GetPoolUpdatesByPool retrieves all scheduled updates of a pool.
+func (k Keeper) GetPoolUpdatesByPool(ctx sdk.Context, poolID uint64) (updates []types.PoolUpdate) {
+	k.IteratePoolUpdates(ctx, func(update types.PoolUpdate) bool {
+		if update.PoolID == poolID {
+			updates = append(updates, update)
+		}
+		return false
+	})
+	return updates
+}
+
+// ApplyPoolUpdates applies pool updates that have become effective by
+// the current block time. Service fee deposits of updates to sunset or
+// closed pools are refunded instead.
+func (k Keeper) ApplyPoolUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PoolUpdateQueueKey, sdk.InclusiveEndBytes(types.GetPoolUpdateTimeKey(ctx.BlockTime())))
+	var keys [][]byte
+	var updates []types.PoolUpdate
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.PoolUpdate
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		keys = append(keys, iterator.Key())
+		updates = append(updates, timeSlice...)
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, update := range updates {
+		pool, found := k.GetPool(ctx, update.PoolID)
+		if !found || pool.Sunset {
+			k.refundPoolUpdate(ctx, update)
+			continue
+		}
+		if update.ShieldLimit.IsPositive() {
+			pool.ShieldLimit = update.ShieldLimit
+			k.SetPool(ctx, pool)
+		}
+		k.addServiceFees(ctx, update.ServiceFees.Native)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeApplyPoolUpdate,
+				sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(update.PoolID, 10)),
+				sdk.NewAttribute(types.AttributeKeyShieldLimit, pool.ShieldLimit.String()),
+				sdk.NewAttribute(types.AttributeKeyServiceFees, update.ServiceFees.String()),
+			),
+		)
+	}
+}
+
+// RefundPoolUpdates cancels all scheduled updates of a pool and refunds
+// their service fee deposits.
+func (k Keeper) RefundPoolUpdates(ctx sdk.Context, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolUpdateQueueKey)
+	remaining := make(map[string][]types.PoolUpdate)
+	var keys [][]byte
+	var refunds []types.PoolUpdate
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.PoolUpdate
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		var kept []types.PoolUpdate
+		for _, update := range timeSlice {
+			if update.PoolID == poolID {
+				refunds = append(refunds, update)
+			} else {
+				kept = append(kept, update)
+			}
+		}
+		if len(kept) != len(timeSlice) {
+			keys = append(keys, iterator.Key())
+			remaining[string(iterator.Key())] = kept
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		if kept := remaining[string(key)]; len(kept) > 0 {
+			store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(kept))
+		} else {
+			store.Delete(key)
+		}
+	}
+	for _, update := range refunds {
+		k.refundPoolUpdate(ctx, update)
+	}
+}
+
+// refundPoolUpdate returns the service fee deposit of a pool update to
+// its depositor.
+func (k Keeper) refundPoolUpdate(ctx sdk.Context, update types.PoolUpdate) {
+	if update.ServiceFees.Native.IsZero() {
+		return
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, update.Depositor, update.ServiceFees.Native); err != nil {
+		panic(err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundPoolDeposit,
+			sdk.NewAttribute(types.AttributeKeyPoolID, strconv.FormatUint(update.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, update.Depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, update.ServiceFees.Native.String()),
+		),
+	)
+}
@@ -17,9 +17,14 @@ import (
 
 // SecureCollaterals is called after a claim is submitted to secure
 // the given amount of collaterals for the duration and adjust shield
-// module states accordingly.
-func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, purchaseID uint64, loss sdk.Coins, duration time.Duration) error {
-	lossAmt := loss.AmountOf(k.sk.BondDenom(ctx))
+// module states accordingly. Losses in foreign denoms are secured by
+// their bond denom worth at the current conversion rates.
+func (k Keeper) SecureCollaterals(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, purchaseID uint64, loss sdk.Coins, duration time.Duration) error {
+	rates, err := k.ConversionRates(ctx, loss)
+	if err != nil {
+		return err
+	}
+	lossAmt := convertLoss(loss, rates, k.sk.BondDenom(ctx))
 
 	// Verify shield.
 	pool, found := k.GetPool(ctx, poolID)
@@ -32,7 +37,8 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 
 	// Verify collateral availability.
 	totalCollateral := k.GetTotalCollateral(ctx)
-	totalSecureAmt := k.GetTotalClaimed(ctx).Add(lossAmt)
+	totalClaimed := k.GetTotalClaimed(ctx)
+	totalSecureAmt := totalClaimed.Add(lossAmt)
 	if totalSecureAmt.GT(totalCollateral) {
 		panic("total secure amount surpassed total collateral")
 	}
@@ -55,11 +61,15 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 	}
 
 	// Secure the updated loss ratio from each provider to cover total claimed.
+	// Previous claims are covered by all providers' collateral, while the loss
+	// is covered by the collateral exposed to the pool, junior tranche first.
 	providers := k.GetAllProviders(ctx)
-	claimedRatio := totalSecureAmt.ToDec().Quo(totalCollateral.ToDec())
+	claimedRatio := totalClaimed.ToDec().Quo(totalCollateral.ToDec())
+	losses := lossShares(providers, poolID, lossAmt.ToDec())
 	remaining := totalSecureAmt
 	for i := range providers {
-		secureAmt := sdk.MinInt(providers[i].Collateral.ToDec().Mul(claimedRatio).TruncateInt(), remaining)
+		share := providers[i].Collateral.ToDec().Mul(claimedRatio).Add(losses[i])
+		secureAmt := sdk.MinInt(share.TruncateInt(), remaining)
 
 		// Require each provider to secure one more unit, if possible,
 		// so that the last provider does not have to cover combined
@@ -69,6 +79,11 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 		}
 		k.SecureFromProvider(ctx, providers[i], secureAmt, duration)
 		remaining = remaining.Sub(secureAmt)
+
+		if lossShare := losses[i].TruncateInt(); lossShare.IsPositive() {
+			lock := types.NewClaimLock(providers[i].Address, proposalID, poolID, purchaseID, lossShare, ctx.BlockTime().Add(duration))
+			k.InsertClaimLockQueue(ctx, lock)
+		}
 	}
 
 	// Update purchase states.
@@ -88,6 +103,11 @@ func (k Keeper) SecureCollaterals(ctx sdk.Context, poolID uint64, purchaser sdk.
 	k.SetTotalShield(ctx, totalShield)
 	k.SetTotalClaimed(ctx, totalSecureAmt)
 
+	// Keep the rates so that the claim is settled as it was secured.
+	if !rates.Empty() {
+		k.SetClaimConversion(ctx, types.NewClaimConversion(proposalID, rates))
+	}
+
 	return nil
 }
 
@@ -131,15 +151,18 @@ func (k Keeper) SecureFromProvider(ctx sdk.Context, provider types.Provider, amo
 
 // ClaimEnd ends a claim process by updating the total claimed amount.
 func (k Keeper) ClaimEnd(ctx sdk.Context, id, poolID uint64, loss sdk.Coins) {
-	lossAmt := loss.AmountOf(k.sk.BondDenom(ctx))
+	lossAmt := k.claimLossAmount(ctx, id, loss)
 	totalClaimed := k.GetTotalClaimed(ctx).Sub(lossAmt)
 	k.SetTotalClaimed(ctx, totalClaimed)
+	k.DeleteClaimConversion(ctx, id)
+	k.DeleteClaimLocks(ctx, id)
 }
 
 // RestoreShield restores shield-related states as they were prior to
 // the claim proposal submission.
-func (k Keeper) RestoreShield(ctx sdk.Context, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error {
-	lossAmt := loss.AmountOf(k.sk.BondDenom(ctx))
+func (k Keeper) RestoreShield(ctx sdk.Context, proposalID, poolID uint64, purchaser sdk.AccAddress, id uint64, loss sdk.Coins) error {
+	lossAmt := k.claimLossAmount(ctx, proposalID, loss)
+	k.DeleteClaimLocks(ctx, proposalID)
 
 	// Update the total shield.
 	totalShield := k.GetTotalShield(ctx).Add(lossAmt)
@@ -240,33 +263,61 @@ func (k Keeper) GetAllProposalIDReimbursementPairs(ctx sdk.Context) []types.Prop
 	return pRPairs
 }
 
-// CreateReimbursement creates a reimbursement.
-func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID uint64, amount sdk.Coins, beneficiary sdk.AccAddress) error {
+// CreateReimbursement creates a reimbursement for a loss in the given
+// pool. Losses in foreign denoms are settled from the sponsor's foreign
+// deposit first, and the rest of the loss is converted to the bond denom
+// and paid by providers in proportion to their exposures to the pool.
+func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID, poolID uint64, amount sdk.Coins, beneficiary sdk.AccAddress) error {
 	bondDenom := k.BondDenom(ctx)
+	lossAmt := k.claimLossAmount(ctx, proposalID, amount)
+	conversion, _ := k.GetClaimConversion(ctx, proposalID)
+	k.DeleteClaimLocks(ctx, proposalID)
+
+	foreignPayout := sdk.NewCoins()
+	if pool, found := k.GetPool(ctx, poolID); found {
+		for _, coin := range amount {
+			if coin.Denom == bondDenom {
+				continue
+			}
+			if deposit := pool.ForeignDeposit.AmountOf(coin.Denom); deposit.IsPositive() {
+				foreignPayout = foreignPayout.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(deposit, coin.Amount)))
+			}
+		}
+		pool.ForeignDeposit = pool.ForeignDeposit.Sub(foreignPayout)
+		k.SetPool(ctx, pool)
+	}
+	nativePayout := convertLoss(amount.Sub(foreignPayout), conversion.Rates, bondDenom)
+
 	totalCollateral := k.GetTotalCollateral(ctx)
 	totalPurchased := k.GetTotalShield(ctx)
-	totalPayout := amount.AmountOf(bondDenom)
-	purchaseRatio := totalPurchased.ToDec().Quo(totalCollateral.ToDec())
-	payoutRatio := totalPayout.ToDec().Quo(totalCollateral.ToDec())
-	for _, provider := range k.GetAllProviders(ctx) {
+	totalPayout := nativePayout
+	providers := k.GetAllProviders(ctx)
+	backed, totalBacked := k.backedShields(ctx, providers)
+	payouts := lossShares(providers, poolID, totalPayout.ToDec())
+	for i, provider := range providers {
 		if !totalPayout.IsPositive() {
 			break
 		}
-		purchased := provider.Collateral.ToDec().Mul(purchaseRatio).TruncateInt()
+		purchased := sdk.ZeroInt()
+		if totalBacked.IsPositive() {
+			purchased = backed[i].Mul(totalPurchased.ToDec()).Quo(totalBacked).TruncateInt()
+		}
 		if purchased.GT(totalPurchased) {
 			purchased = totalPurchased
 		}
-		payout := provider.Collateral.ToDec().Mul(payoutRatio).TruncateInt()
+		payout := payouts[i].TruncateInt()
 		if payout.GT(totalPayout) {
 			payout = totalPayout
 		}
+		// Junior providers may pay out collateral backing their purchases.
+		purchased = sdk.MinInt(purchased, sdk.MaxInt(provider.Collateral.Sub(payout), sdk.ZeroInt()))
 
 		// Require providers to cover (purchased + 1) and (payout + 1) if it's possible,
 		// so that the last provider will not be asked to cover all truncated amount.
 		if purchased.LT(totalPurchased) && provider.Collateral.GT(payout.Add(purchased)) {
 			purchased = purchased.Add(sdk.OneInt())
 		}
-		if payout.LT(totalPayout) && provider.Collateral.GT(payout.Add(purchased)) {
+		if payout.LT(totalPayout) && payouts[i].IsPositive() && provider.Collateral.GT(payout.Add(purchased)) {
 			payout = payout.Add(sdk.OneInt())
 		}
 
@@ -284,12 +335,14 @@ func (k Keeper) CreateReimbursement(ctx sdk.Context, proposalID uint64, amount s
 	if totalPayout.IsPositive() {
 		panic("not enough payout made")
 	}
-	reimbursement := types.NewReimbursement(amount, beneficiary, ctx.BlockTime().Add(k.GetClaimProposalParams(ctx).PayoutPeriod))
+	payout := foreignPayout.Add(sdk.NewCoin(bondDenom, nativePayout))
+	reimbursement := types.NewReimbursement(payout, beneficiary, ctx.BlockTime().Add(k.GetClaimProposalParams(ctx).PayoutPeriod))
 	k.SetReimbursement(ctx, proposalID, reimbursement)
+	k.DeleteClaimConversion(ctx, proposalID)
 
-	totalCollateral = totalCollateral.Sub(amount.AmountOf(bondDenom))
+	totalCollateral = totalCollateral.Sub(nativePayout)
 	totalClaimed := k.GetTotalClaimed(ctx)
-	totalClaimed = totalClaimed.Sub(amount.AmountOf(bondDenom))
+	totalClaimed = totalClaimed.Sub(lossAmt)
 	k.SetTotalCollateral(ctx, totalCollateral)
 	k.SetTotalClaimed(ctx, totalClaimed)
 
@@ -113,8 +113,7 @@ func (k Keeper) purchaseShield(ctx sdk.Context, poolID uint64, shield sdk.Coins,
 	// Check pool shield limit.
 	poolParams := k.GetPoolParams(ctx)
 	protectionEndTime := ctx.BlockTime().Add(poolParams.ProtectionPeriod)
-	maxShield := sdk.MinInt(pool.ShieldLimit, totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt())
-	if shieldAmt.Add(pool.Shield).GT(maxShield) {
+	if shieldAmt.Add(pool.Shield).GT(k.GetPoolMaxShield(ctx, pool)) {
 		return types.Purchase{}, types.ErrPoolShieldExceedsLimit
 	}
 
@@ -205,16 +204,19 @@ func (k Keeper) RemoveExpiredPurchasesAndDistributeFees(ctx sdk.Context) {
 
 				// If purchaseProtectionEndTime > previousBlockTime, update service fees.
 				// Otherwise services fees were updated in the last block.
-				if entry.ProtectionEndTime.After(lastUpdateTime) && entry.ServiceFees.Native.IsAllPositive() {
-					// Add purchaseServiceFees * (purchaseProtectionEndTime - previousBlockTime) / protectionPeriod.
-					serviceFees = serviceFees.Add(entry.ServiceFees.MulDec(
-						sdk.NewDec(entry.ProtectionEndTime.Sub(lastUpdateTime).Nanoseconds()).Quo(
-							sdk.NewDec(k.GetPoolParams(ctx).ProtectionPeriod.Nanoseconds()))))
-					// Remove purchaseServiceFees from total service fees.
-					totalServiceFees = totalServiceFees.Sub(entry.ServiceFees)
-					// Set purchaseServiceFees to zero because it can be reached again.
-					purchaseList.Entries[i].ServiceFees = types.InitMixedDecCoins()
+				if entry.ProtectionEndTime.After(lastUpdateTime) {
+					if entry.ServiceFees.Native.IsAllPositive() {
+						// Add purchaseServiceFees * (purchaseProtectionEndTime - previousBlockTime) / protectionPeriod.
+						serviceFees = serviceFees.Add(entry.ServiceFees.MulDec(
+							sdk.NewDec(entry.ProtectionEndTime.Sub(lastUpdateTime).Nanoseconds()).Quo(
+								sdk.NewDec(k.GetPoolParams(ctx).ProtectionPeriod.Nanoseconds()))))
+						// Remove purchaseServiceFees from total service fees.
+						totalServiceFees = totalServiceFees.Sub(entry.ServiceFees)
+						// Set purchaseServiceFees to zero because it can be reached again.
+						purchaseList.Entries[i].ServiceFees = types.InitMixedDecCoins()
+					}
 
+					// Staking purchases do not pay service fees, so they are processed separately.
 					originalStaking := k.GetOriginalStaking(ctx, entry.PurchaseID)
 					if !originalStaking.IsZero() {
 						// keep track of the list to be updated to avoid overwriting the purchase list
@@ -272,17 +274,19 @@ func (k Keeper) RemoveExpiredPurchasesAndDistributeFees(ctx sdk.Context) {
 		serviceFees.Native = remainingServiceFees.Native
 	}
 
-	// Add block service fees that need to be distributed for this block
+	// Add block service fees that need to be distributed for this block,
+	// after setting aside the share of stake-for-shield stakers.
 	blockServiceFees := k.GetBlockServiceFees(ctx)
+	blockServiceFees.Native = blockServiceFees.Native.Sub(k.distributeStakingRewards(ctx, blockServiceFees.Native))
 	serviceFees = serviceFees.Add(blockServiceFees)
 	k.DeleteBlockServiceFees(ctx)
 
-	// Distribute service fees.
-	totalCollateral := k.GetTotalCollateral(ctx)
+	// Distribute service fees by provider weights.
 	providers := k.GetAllProviders(ctx)
-	for _, provider := range providers {
-		// fees * providerCollateral / totalCollateral
-		nativeFees := serviceFees.Native.MulDec(sdk.NewDecFromInt(provider.Collateral).QuoInt(totalCollateral))
+	weights, totalWeight := k.feeWeights(ctx, providers)
+	for i, provider := range providers {
+		// fees * providerWeight / totalWeight
+		nativeFees := serviceFees.Native.MulDec(weights[i].Quo(totalWeight))
 		if nativeFees.AmountOf(bondDenom).GT(remainingServiceFees.Native.AmountOf(bondDenom)) {
 			nativeFees = remainingServiceFees.Native
 		}
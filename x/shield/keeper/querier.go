@@ -48,6 +48,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryReimbursement(ctx, path[1:], k)
 		case types.QueryReimbursements:
 			return queryReimbursements(ctx, path[1:], k)
+		case types.QueryPoolCapacity:
+			return queryPoolCapacity(ctx, path[1:], k)
+		case types.QueryProviderStats:
+			return queryProviderStats(ctx, path[1:], k)
+		case types.QueryStakerStats:
+			return queryStakerStats(ctx, path[1:], k)
+		case types.QueryPoolOperators:
+			return queryPoolOperators(ctx, path[1:], k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -172,6 +180,24 @@ func queryPoolPurchases(ctx sdk.Context, path []string, k Keeper) (res []byte, e
 	return res, nil
 }
 
+// queryPoolOperators queries the operators of a pool.
+func queryPoolOperators(ctx sdk.Context, path []string, k Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err = codec.MarshalJSONIndent(k.cdc, k.GetPoolOperators(ctx, id))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
 // queryPurchases queries all purchases.
 func queryPurchases(ctx sdk.Context, path []string, k Keeper) (res []byte, err error) {
 	if err := validatePathLength(path, 0); err != nil {
@@ -345,3 +371,66 @@ func queryReimbursements(ctx sdk.Context, path []string, k Keeper) (res []byte,
 	}
 	return res, nil
 }
+
+func queryPoolCapacity(ctx sdk.Context, path []string, k Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseUint(path[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	pool, found := k.GetPool(ctx, id)
+	if !found {
+		return nil, types.ErrNoPoolFound
+	}
+
+	capacity := types.NewQueryResPoolCapacity(pool.ID, k.GetPoolCapacity(ctx, pool.ID), k.GetPoolMaxShield(ctx, pool), pool.Shield)
+	res, err = codec.MarshalJSONIndent(k.cdc, capacity)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryProviderStats(ctx sdk.Context, path []string, k Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+	provider, found := k.GetProvider(ctx, address)
+	if !found {
+		return nil, types.ErrProviderNotFound
+	}
+
+	stats := types.NewQueryResProviderStats(provider, k.EstimateProviderAPR(ctx, address), k.GetClaimLocksByProvider(ctx, address),
+		k.GetWithdrawsByProvider(ctx, address), k.GetUnbondingDelaysByProvider(ctx, address))
+	res, err = codec.MarshalJSONIndent(k.cdc, stats)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
+
+func queryStakerStats(ctx sdk.Context, path []string, k Keeper) (res []byte, err error) {
+	if err := validatePathLength(path, 1); err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	stats := types.NewQueryResStakerStats(address, k.GetStakeForShieldsByPurchaser(ctx, address), k.GetStakeUnbondingsByPurchaser(ctx, address))
+	res, err = codec.MarshalJSONIndent(k.cdc, stats)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
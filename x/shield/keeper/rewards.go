@@ -47,6 +47,10 @@ func (k Keeper) PayoutNativeRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.C
 	rewards.Native = sdk.DecCoins{}
 	k.SetRewards(ctx, addr, rewards)
 
+	provider, _ := k.GetProvider(ctx, addr)
+	provider.RealizedRewards = provider.RealizedRewards.Add(ctkRewards...)
+	k.SetProvider(ctx, addr, provider)
+
 	// Add leftovers as service fees.
 	remainingServiceFees := k.GetRemainingServiceFees(ctx)
 	remainingServiceFees.Native = remainingServiceFees.Native.Add(change...)
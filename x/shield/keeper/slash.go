@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SlashProviders updates providers delegating to a validator that is
+// about to be slashed by the given fraction. Their collateral is reduced
+// in proportion to the slashed delegations, and their withdrawing
+// collateral by the slashed unbonding delegations, as long as claimed
+// collateral remains covered. The collateral no longer backed by the
+// remaining delegations is withdrawn.
+func (k Keeper) SlashProviders(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
+	if !fraction.IsPositive() {
+		return
+	}
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	providers := k.GetAllProviders(ctx)
+	for _, provider := range providers {
+		slashed := sdk.ZeroInt()
+		if delegation, found := k.sk.GetDelegation(ctx, provider.Address, valAddr); found {
+			slashed = validator.TokensFromShares(delegation.Shares).Mul(fraction).Ceil().TruncateInt()
+			slashed = sdk.MinInt(slashed, provider.DelegationBonded)
+		}
+		unbondingSlashed := k.computeUnbondingSlash(ctx, provider.Address, valAddr, fraction)
+		if !slashed.IsPositive() && !unbondingSlashed.IsPositive() {
+			continue
+		}
+
+		// Active collateral is reduced in proportion to the slashed
+		// bonded delegations and withdrawing collateral by the slashed
+		// unbonding delegations.
+		reduction := sdk.ZeroInt()
+		if slashed.IsPositive() {
+			active := provider.Collateral.Sub(provider.Withdrawing)
+			reduction = sdk.MinInt(active, active.Mul(slashed).Quo(provider.DelegationBonded))
+		}
+		withdrawReduction := sdk.MinInt(provider.Withdrawing, unbondingSlashed)
+		reduction = reduction.Add(withdrawReduction)
+
+		// Do not reduce the collateral locked for claims.
+		reduction = sdk.MinInt(reduction, k.computeSlashableCollateral(ctx, provider))
+		withdrawReduction = sdk.MinInt(withdrawReduction, reduction)
+		if reduction.IsPositive() {
+			provider.Collateral = provider.Collateral.Sub(reduction)
+			provider.Withdrawing = provider.Withdrawing.Sub(withdrawReduction)
+			k.SetProvider(ctx, provider.Address, provider)
+			k.ReduceWithdraws(ctx, provider.Address, withdrawReduction)
+
+			totalCollateral := k.GetTotalCollateral(ctx)
+			totalCollateral = totalCollateral.Sub(reduction)
+			k.SetTotalCollateral(ctx, totalCollateral)
+			totalWithdrawing := k.GetTotalWithdrawing(ctx)
+			totalWithdrawing = totalWithdrawing.Sub(withdrawReduction)
+			k.SetTotalWithdrawing(ctx, totalWithdrawing)
+		}
+		k.updateProviderForDelegationChanges(ctx, provider.Address, provider.DelegationBonded.Sub(slashed))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashCollateral,
+				sdk.NewAttribute(types.AttributeKeyAccountAddress, provider.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, reduction.String()),
+			),
+		)
+	}
+}
+
+// computeUnbondingSlash computes the amount slashed by the given
+// fraction from the provider's immature unbonding delegations to
+// the validator. The staking module slashes unbonding delegations
+// before calling the hook, so the amount is bounded by the balance
+// an entry has already lost.
+func (k Keeper) computeUnbondingSlash(ctx sdk.Context, provider sdk.AccAddress, valAddr sdk.ValAddress, fraction sdk.Dec) sdk.Int {
+	ubd, found := k.sk.GetUnbondingDelegation(ctx, provider, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	slashed := sdk.ZeroInt()
+	for _, entry := range ubd.Entries {
+		if entry.IsMature(ctx.BlockHeader().Time) {
+			continue
+		}
+		entrySlashed := entry.InitialBalance.ToDec().Mul(fraction).Ceil().TruncateInt()
+		slashed = slashed.Add(sdk.MinInt(entrySlashed, entry.InitialBalance.Sub(entry.Balance)))
+	}
+	return slashed
+}
+
+// computeSlashableCollateral computes the amount of the provider's
+// collateral that can be slashed without leaving its claim locks or
+// the total claimed amount uncovered.
+func (k Keeper) computeSlashableCollateral(ctx sdk.Context, provider types.Provider) sdk.Int {
+	locked := sdk.ZeroInt()
+	for _, lock := range k.GetClaimLocksByProvider(ctx, provider.Address) {
+		locked = locked.Add(lock.Amount)
+	}
+	slashable := sdk.MinInt(provider.Collateral.Sub(locked), k.GetTotalCollateral(ctx).Sub(k.GetTotalClaimed(ctx)))
+	return sdk.MaxInt(slashable, sdk.ZeroInt())
+}
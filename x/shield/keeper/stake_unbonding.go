@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// UnbondStake inserts an unstaked deposit into the stake unbonding queue.
+func (k Keeper) UnbondStake(ctx sdk.Context, unbonding types.StakeUnbonding) {
+	k.InsertStakeUnbondingQueue(ctx, unbonding)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondStake,
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, unbonding.Purchaser.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRewards, unbonding.Rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, unbonding.CompletionTime.String()),
+		),
+	)
+}
+
+// InsertStakeUnbondingQueue inserts a stake unbonding into the
+// stake unbonding queue.
+func (k Keeper) InsertStakeUnbondingQueue(ctx sdk.Context, unbonding types.StakeUnbonding) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetStakeUnbondingTimeKey(unbonding.CompletionTime)
+	var timeSlice []types.StakeUnbonding
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &timeSlice)
+	}
+	timeSlice = append(timeSlice, unbonding)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(timeSlice))
+}
+
+// IterateStakeUnbondings iterates through all stake unbondings.
+func (k Keeper) IterateStakeUnbondings(ctx sdk.Context, callback func(unbonding types.StakeUnbonding) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.StakeUnbondingQueueKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.StakeUnbonding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		for _, unbonding := range timeSlice {
+			if callback(unbonding) {
+				return
+			}
+		}
+	}
+}
+
+// GetAllStakeUnbondings retrieves all stake unbondings.
+func (k Keeper) GetAllStakeUnbondings(ctx sdk.Context) (unbondings []types.StakeUnbonding) {
+	k.IterateStakeUnbondings(ctx, func(unbonding types.StakeUnbonding) bool {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+	return unbondings
+}
+
+// GetStakeUnbondingsByPurchaser retrieves all stake unbondings of a purchaser.
+func (k Keeper) GetStakeUnbondingsByPurchaser(ctx sdk.Context, purchaser sdk.AccAddress) (unbondings []types.StakeUnbonding) {
+	k.IterateStakeUnbondings(ctx, func(unbonding types.StakeUnbonding) bool {
+		if unbonding.Purchaser.Equals(purchaser) {
+			unbondings = append(unbondings, unbonding)
+		}
+		return false
+	})
+	return unbondings
+}
+
+// GetStakeForShieldsByPurchaser retrieves all stake-for-shield stakings of a purchaser.
+func (k Keeper) GetStakeForShieldsByPurchaser(ctx sdk.Context, purchaser sdk.AccAddress) (stakings []types.ShieldStaking) {
+	k.IterateStakeForShields(ctx, func(staking types.ShieldStaking) bool {
+		if staking.Purchaser.Equals(purchaser) {
+			stakings = append(stakings, staking)
+		}
+		return false
+	})
+	return stakings
+}
+
+// DequeueCompletedStakeUnbondings releases matured stake unbondings
+// and their accrued rewards to the purchasers.
+func (k Keeper) DequeueCompletedStakeUnbondings(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.StakeUnbondingQueueKey, sdk.InclusiveEndBytes(types.GetStakeUnbondingTimeKey(ctx.BlockTime())))
+	var keys [][]byte
+	var unbondings []types.StakeUnbonding
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.StakeUnbonding
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		keys = append(keys, iterator.Key())
+		unbondings = append(unbondings, timeSlice...)
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	for _, unbonding := range unbondings {
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, unbonding.Amount))
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, unbonding.Purchaser, coins); err != nil {
+			panic(err)
+		}
+		if err := k.payoutStakingRewards(ctx, unbonding.Purchaser, unbonding.Rewards); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnstake,
+				sdk.NewAttribute(types.AttributeKeyAccountAddress, unbonding.Purchaser.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, unbonding.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRewards, unbonding.Rewards.String()),
+			),
+		)
+	}
+}
+
+// payoutStakingRewards sends accrued staking rewards to the purchaser.
+// Leftovers are added to the remaining service fees.
+func (k Keeper) payoutStakingRewards(ctx sdk.Context, purchaser sdk.AccAddress, rewards sdk.DecCoins) error {
+	coins, change := rewards.TruncateDecimal()
+	if !change.IsZero() {
+		remainingServiceFees := k.GetRemainingServiceFees(ctx)
+		remainingServiceFees.Native = remainingServiceFees.Native.Add(change...)
+		k.SetRemainingServiceFees(ctx, remainingServiceFees)
+	}
+	if coins.IsZero() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, coins)
+}
+
+// distributeStakingRewards distributes the stakers' share of block
+// rewards pro rata to stake-for-shield stakings, and returns the
+// distributed amount.
+func (k Keeper) distributeStakingRewards(ctx sdk.Context, blockRewards sdk.DecCoins) sdk.DecCoins {
+	globalPool := k.GetGlobalShieldStakingPool(ctx)
+	rate := k.GetPoolParams(ctx).StakingRewardsRate
+	if !globalPool.IsPositive() || rate.IsNil() || !rate.IsPositive() || blockRewards.IsZero() {
+		return sdk.DecCoins{}
+	}
+
+	stakingRewards := blockRewards.MulDecTruncate(rate)
+	distributed := sdk.DecCoins{}
+	for _, staking := range k.GetAllStakeForShields(ctx) {
+		// rewards * stakingAmount / globalStakingPool
+		rewards := stakingRewards.MulDecTruncate(staking.Amount.ToDec().Quo(globalPool.ToDec()))
+		staking.Rewards = staking.Rewards.Add(rewards...)
+		k.SetStakeForShield(ctx, staking.PoolID, staking.Purchaser, staking)
+		distributed = distributed.Add(rewards...)
+	}
+	return distributed
+}
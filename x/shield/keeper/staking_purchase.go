@@ -162,8 +162,6 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	if amount.IsZero() {
 		return nil
 	}
-	refundCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, amount))
-	k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, refundCoins)
 
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOriginalStakingKey(purchaseID))
@@ -172,15 +170,30 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	pool = pool.Sub(amount)
 	k.SetGlobalShieldStakingPool(ctx, pool)
 
-	renew := amount.Sub(staked.WithdrawRequested)
-	if renew.IsNegative() {
-		renew = sdk.NewInt(0)
+	// Requested withdraws enter the unbonding queue together with
+	// their share of accrued rewards, and the rest is renewed.
+	withdrawAmt := sdk.MinInt(staked.WithdrawRequested, amount)
+	renew := amount.Sub(withdrawAmt)
+	if withdrawAmt.IsPositive() {
+		rewards := staked.Rewards.MulDecTruncate(withdrawAmt.ToDec().Quo(staked.Amount.ToDec()))
+		staked.Rewards = staked.Rewards.Sub(rewards)
+		k.UnbondStake(ctx, types.NewStakeUnbonding(poolID, purchaser, withdrawAmt, rewards,
+			ctx.BlockTime().Add(k.GetPoolParams(ctx).StakingUnbondingPeriod)))
+	}
+	if renew.IsPositive() {
+		refundCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, renew))
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, purchaser, refundCoins); err != nil {
+			return err
+		}
 	}
 
 	staked.Amount = staked.Amount.Sub(amount)
-	withdrawAmt := sdk.MinInt(staked.WithdrawRequested, amount)
 	staked.WithdrawRequested = staked.WithdrawRequested.Sub(withdrawAmt)
 	if staked.Amount.IsZero() {
+		// Pay out the remaining rewards before the staking is renewed.
+		if err := k.payoutStakingRewards(ctx, purchaser, staked.Rewards); err != nil {
+			return err
+		}
 		store.Delete(types.GetStakeForShieldKey(poolID, purchaser))
 	} else {
 		k.SetStakeForShield(ctx, poolID, purchaser, staked)
@@ -191,7 +204,7 @@ func (k Keeper) ProcessStakeForShieldExpiration(ctx sdk.Context, poolID, purchas
 	}
 
 	sPRate := k.GetShieldStakingRate(ctx)
-	renewShieldInt := amount.ToDec().Quo(sPRate).TruncateInt()
+	renewShieldInt := renew.ToDec().Quo(sPRate).TruncateInt()
 	renewShield := sdk.NewCoins(sdk.NewCoin(bondDenom, renewShieldInt))
 	if renewShieldInt.IsZero() {
 		return nil
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// InsertClaimLockQueue inserts a claim lock into the claim lock queue.
+func (k Keeper) InsertClaimLockQueue(ctx sdk.Context, lock types.ClaimLock) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetClaimLockEndTimeKey(lock.EndTime)
+	var timeSlice []types.ClaimLock
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &timeSlice)
+	}
+	timeSlice = append(timeSlice, lock)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(timeSlice))
+}
+
+// DeleteClaimLocks removes the claim locks of a claim proposal that
+// has ended before the locks expire.
+func (k Keeper) DeleteClaimLocks(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimLockQueueKey)
+	var keys [][]byte
+	var timeSlices [][]types.ClaimLock
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice, kept []types.ClaimLock
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		for _, lock := range timeSlice {
+			if lock.ProposalID != proposalID {
+				kept = append(kept, lock)
+			}
+		}
+		if len(kept) != len(timeSlice) {
+			keys = append(keys, iterator.Key())
+			timeSlices = append(timeSlices, kept)
+		}
+	}
+	iterator.Close()
+	for i, key := range keys {
+		if len(timeSlices[i]) == 0 {
+			store.Delete(key)
+			continue
+		}
+		store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(timeSlices[i]))
+	}
+}
+
+// IterateClaimLocks iterates through all claim locks.
+func (k Keeper) IterateClaimLocks(ctx sdk.Context, callback func(lock types.ClaimLock) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimLockQueueKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.ClaimLock
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		for _, lock := range timeSlice {
+			if callback(lock) {
+				return
+			}
+		}
+	}
+}
+
+// GetAllClaimLocks retrieves all claim locks.
+func (k Keeper) GetAllClaimLocks(ctx sdk.Context) (locks []types.ClaimLock) {
+	k.IterateClaimLocks(ctx, func(lock types.ClaimLock) bool {
+		locks = append(locks, lock)
+		return false
+	})
+	return locks
+}
+
+// GetClaimLocksByProvider retrieves all claim locks of a provider.
+func (k Keeper) GetClaimLocksByProvider(ctx sdk.Context, providerAddr sdk.AccAddress) (locks []types.ClaimLock) {
+	k.IterateClaimLocks(ctx, func(lock types.ClaimLock) bool {
+		if lock.Address.Equals(providerAddr) {
+			locks = append(locks, lock)
+		}
+		return false
+	})
+	return locks
+}
+
+// InsertUnbondingDelayQueue inserts an unbonding delay into the
+// unbonding delay queue.
+func (k Keeper) InsertUnbondingDelayQueue(ctx sdk.Context, delay types.UnbondingDelay) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetUnbondingDelayTimeKey(delay.DelayedTime)
+	var timeSlice []types.UnbondingDelay
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &timeSlice)
+	}
+	timeSlice = append(timeSlice, delay)
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(timeSlice))
+}
+
+// IterateUnbondingDelays iterates through all unbonding delays.
+func (k Keeper) IterateUnbondingDelays(ctx sdk.Context, callback func(delay types.UnbondingDelay) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingDelayQueueKey)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var timeSlice []types.UnbondingDelay
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &timeSlice)
+		for _, delay := range timeSlice {
+			if callback(delay) {
+				return
+			}
+		}
+	}
+}
+
+// GetAllUnbondingDelays retrieves all unbonding delays.
+func (k Keeper) GetAllUnbondingDelays(ctx sdk.Context) (delays []types.UnbondingDelay) {
+	k.IterateUnbondingDelays(ctx, func(delay types.UnbondingDelay) bool {
+		delays = append(delays, delay)
+		return false
+	})
+	return delays
+}
+
+// GetUnbondingDelaysByProvider retrieves all unbonding delays of a provider.
+func (k Keeper) GetUnbondingDelaysByProvider(ctx sdk.Context, providerAddr sdk.AccAddress) (delays []types.UnbondingDelay) {
+	k.IterateUnbondingDelays(ctx, func(delay types.UnbondingDelay) bool {
+		if delay.Address.Equals(providerAddr) {
+			delays = append(delays, delay)
+		}
+		return false
+	})
+	return delays
+}
+
+// DequeueExpiredClaimLocksAndDelays removes claim locks and unbonding
+// delays that have ended by the current block time.
+func (k Keeper) DequeueExpiredClaimLocksAndDelays(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keys := range [][2][]byte{
+		{types.ClaimLockQueueKey, types.GetClaimLockEndTimeKey(ctx.BlockTime())},
+		{types.UnbondingDelayQueueKey, types.GetUnbondingDelayTimeKey(ctx.BlockTime())},
+	} {
+		iterator := store.Iterator(keys[0], sdk.InclusiveEndBytes(keys[1]))
+		var expired [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			expired = append(expired, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range expired {
+			store.Delete(key)
+		}
+	}
+}
+
+// EstimateProviderAPR estimates the annual percentage rate of service
+// fees earned by the provider's collateral, assuming service fees of
+// active purchases keep being distributed at the current rate.
+func (k Keeper) EstimateProviderAPR(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	provider, found := k.GetProvider(ctx, addr)
+	if !found || !provider.Collateral.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	providers := k.GetAllProviders(ctx)
+	weights, totalWeight := k.feeWeights(ctx, providers)
+	if !totalWeight.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	weight := sdk.ZeroDec()
+	for i := range providers {
+		if providers[i].Address.Equals(addr) {
+			weight = weights[i]
+			break
+		}
+	}
+
+	// Service fees are released linearly over the protection period,
+	// limited by the remaining service fees.
+	bondDenom := k.BondDenom(ctx)
+	fees := sdk.MinDec(k.GetServiceFees(ctx).Native.AmountOf(bondDenom), k.GetRemainingServiceFees(ctx).Native.AmountOf(bondDenom))
+	year := time.Hour * 24 * 365
+	annualFees := fees.MulInt64(int64(year)).QuoInt64(int64(k.GetPoolParams(ctx).ProtectionPeriod))
+	return annualFees.Mul(weight).Quo(totalWeight).QuoInt(provider.Collateral)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetProviderTranche sets the risk tranche of a provider's collateral.
+// The tranche cannot be changed while there are pending claims, so that
+// junior providers cannot escape losses they are about to absorb.
+func (k Keeper) SetProviderTranche(ctx sdk.Context, addr sdk.AccAddress, tranche types.Tranche) error {
+	if tranche != types.TrancheSenior && tranche != types.TrancheJunior {
+		return types.ErrInvalidTranche
+	}
+	provider, found := k.GetProvider(ctx, addr)
+	if !found {
+		return types.ErrProviderNotFound
+	}
+	if provider.Tranche == tranche {
+		return nil
+	}
+	if k.GetTotalClaimed(ctx).IsPositive() {
+		return types.ErrTrancheLocked
+	}
+	provider.Tranche = tranche
+	k.SetProvider(ctx, addr, provider)
+	return nil
+}
+
+// lossShares splits a loss of the pool among the providers. Junior
+// collateral exposed to the pool absorbs the loss first and senior
+// collateral covers the remainder, both pro rata to their exposures.
+func lossShares(providers []types.Provider, poolID uint64, loss sdk.Dec) []sdk.Dec {
+	exposures, _ := poolExposures(providers, poolID)
+	juniorExposure, seniorExposure := sdk.ZeroDec(), sdk.ZeroDec()
+	for i, provider := range providers {
+		if provider.Tranche == types.TrancheJunior {
+			juniorExposure = juniorExposure.Add(exposures[i])
+		} else {
+			seniorExposure = seniorExposure.Add(exposures[i])
+		}
+	}
+
+	juniorLoss := sdk.MinDec(loss, juniorExposure)
+	if !seniorExposure.IsPositive() {
+		juniorLoss = loss
+	}
+	seniorLoss := loss.Sub(juniorLoss)
+
+	shares := make([]sdk.Dec, len(providers))
+	for i, provider := range providers {
+		shares[i] = sdk.ZeroDec()
+		if provider.Tranche == types.TrancheJunior {
+			if juniorLoss.IsPositive() {
+				shares[i] = exposures[i].Mul(juniorLoss).Quo(juniorExposure)
+			}
+		} else if seniorLoss.IsPositive() {
+			shares[i] = exposures[i].Mul(seniorLoss).Quo(seniorExposure)
+		}
+	}
+	return shares
+}
+
+// feeWeights returns the weights of providers in service fee
+// distribution and their sum. Junior collateral earns a larger share
+// of the fees for absorbing losses first.
+func (k Keeper) feeWeights(ctx sdk.Context, providers []types.Provider) ([]sdk.Dec, sdk.Dec) {
+	// Weight by the shield backed by each provider, and fall back
+	// to collateral if no shield is backed.
+	weights, totalWeight := k.backedShields(ctx, providers)
+	if !totalWeight.IsPositive() {
+		for i := range providers {
+			weights[i] = providers[i].Collateral.ToDec()
+		}
+	}
+
+	juniorFeesRate := k.GetPoolParams(ctx).JuniorFeesRate
+	totalWeight = sdk.ZeroDec()
+	for i := range providers {
+		if providers[i].Tranche == types.TrancheJunior {
+			weights[i] = weights[i].Mul(juniorFeesRate)
+		}
+		totalWeight = totalWeight.Add(weights[i])
+	}
+	return weights, totalWeight
+}
@@ -273,6 +273,37 @@ func (k Keeper) DelayWithdraws(ctx sdk.Context, provider sdk.AccAddress, amount
 	return nil
 }
 
+// ReduceWithdraws reduces the given amount of the provider's
+// withdraws, starting with the latest withdraw completion time.
+func (k Keeper) ReduceWithdraws(ctx sdk.Context, provider sdk.AccAddress, amount sdk.Int) {
+	withdraws := k.GetWithdrawsByProvider(ctx, provider)
+	remaining := amount
+	for i := len(withdraws) - 1; i >= 0 && remaining.IsPositive(); i-- {
+		timeSlice := k.GetWithdrawQueueTimeSlice(ctx, withdraws[i].CompletionTime)
+		for j := len(timeSlice) - 1; j >= 0; j-- {
+			if !timeSlice[j].Address.Equals(provider) || !timeSlice[j].Amount.Equal(withdraws[i].Amount) {
+				continue
+			}
+			reduction := sdk.MinInt(remaining, timeSlice[j].Amount)
+			timeSlice[j].Amount = timeSlice[j].Amount.Sub(reduction)
+			if timeSlice[j].Amount.IsZero() {
+				timeSlice = append(timeSlice[:j], timeSlice[j+1:]...)
+			}
+			remaining = remaining.Sub(reduction)
+			break
+		}
+		if len(timeSlice) == 0 {
+			k.RemoveTimeSliceFromWithdrawQueue(ctx, withdraws[i].CompletionTime)
+		} else {
+			k.SetWithdrawQueueTimeSlice(ctx, withdraws[i].CompletionTime, timeSlice)
+		}
+	}
+
+	if remaining.IsPositive() {
+		panic("failed to reduce enough withdraws")
+	}
+}
+
 func (k Keeper) DelayUnbonding(ctx sdk.Context, provider sdk.AccAddress, amount sdk.Int, delayedTime time.Time) error {
 	// Retrieve delay candidates, which are unbondings
 	// ending before the delay duration from now.
@@ -328,6 +359,7 @@ func (k Keeper) DelayUnbonding(ctx sdk.Context, provider sdk.AccAddress, amount
 
 		k.sk.InsertUBDQueue(ctx, unbondingDels, delayedTime)
 		k.sk.SetUnbondingDelegation(ctx, unbondingDels)
+		k.InsertUnbondingDelayQueue(ctx, types.NewUnbondingDelay(provider, ubds[i].validator, amount, ubds[i].completionTime, delayedTime))
 
 		remaining = remaining.Sub(amount)
 	}
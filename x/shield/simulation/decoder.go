@@ -17,11 +17,13 @@ import (
 func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 	switch {
 	case bytes.Equal(kvA.Key[:1], types.ShieldAdminKey):
-		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvA.Value))
+		return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 
 	case bytes.Equal(kvA.Key[:1], types.TotalCollateralKey),
+		bytes.Equal(kvA.Key[:1], types.TotalWithdrawingKey),
 		bytes.Equal(kvA.Key[:1], types.TotalShieldKey),
-		bytes.Equal(kvA.Key[:1], types.TotalClaimedKey):
+		bytes.Equal(kvA.Key[:1], types.TotalClaimedKey),
+		bytes.Equal(kvA.Key[:1], types.GlobalStakeForShieldPoolKey):
 		var totalA, totalB sdk.Int
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &totalA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &totalB)
@@ -53,12 +55,24 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &purchaseB)
 		return fmt.Sprintf("%v\n%v", purchaseA, purchaseB)
 
+	case bytes.Equal(kvA.Key[:1], types.PurchaseQueueKey):
+		var ppPairsA, ppPairsB []types.PoolPurchaser
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &ppPairsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &ppPairsB)
+		return fmt.Sprintf("%v\n%v", ppPairsA, ppPairsB)
+
 	case bytes.Equal(kvA.Key[:1], types.ProviderKey):
 		var providerA, providerB types.Provider
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &providerA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &providerB)
 		return fmt.Sprintf("%v\n%v", providerA, providerB)
 
+	case bytes.Equal(kvA.Key[:1], types.WithdrawQueueKey):
+		var withdrawsA, withdrawsB []types.Withdraw
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &withdrawsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &withdrawsB)
+		return fmt.Sprintf("%v\n%v", withdrawsA, withdrawsB)
+
 	case bytes.Equal(kvA.Key[:1], types.LastUpdateTimeKey):
 		var timeA, timeB time.Time
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
@@ -83,6 +97,48 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rateB)
 		return fmt.Sprintf("%v\n%v", rateA, rateB)
 
+	case bytes.Equal(kvA.Key[:1], types.ReimbursementKey):
+		var reimbursementA, reimbursementB types.Reimbursement
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &reimbursementA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &reimbursementB)
+		return fmt.Sprintf("%v\n%v", reimbursementA, reimbursementB)
+
+	case bytes.Equal(kvA.Key[:1], types.ClaimLockQueueKey):
+		var locksA, locksB []types.ClaimLock
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &locksA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &locksB)
+		return fmt.Sprintf("%v\n%v", locksA, locksB)
+
+	case bytes.Equal(kvA.Key[:1], types.UnbondingDelayQueueKey):
+		var delaysA, delaysB []types.UnbondingDelay
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &delaysA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &delaysB)
+		return fmt.Sprintf("%v\n%v", delaysA, delaysB)
+
+	case bytes.Equal(kvA.Key[:1], types.PoolUpdateQueueKey):
+		var updatesA, updatesB []types.PoolUpdate
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &updatesA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &updatesB)
+		return fmt.Sprintf("%v\n%v", updatesA, updatesB)
+
+	case bytes.Equal(kvA.Key[:1], types.StakeUnbondingQueueKey):
+		var unbondingsA, unbondingsB []types.StakeUnbonding
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &unbondingsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &unbondingsB)
+		return fmt.Sprintf("%v\n%v", unbondingsA, unbondingsB)
+
+	case bytes.Equal(kvA.Key[:1], types.ClaimConversionKey):
+		var conversionA, conversionB types.ClaimConversion
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &conversionA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &conversionB)
+		return fmt.Sprintf("%v\n%v", conversionA, conversionB)
+
+	case bytes.Equal(kvA.Key[:1], types.PoolOperatorKey):
+		var operatorA, operatorB types.PoolOperator
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &operatorA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &operatorB)
+		return fmt.Sprintf("%v\n%v", operatorA, operatorB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
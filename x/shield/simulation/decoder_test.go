@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+var (
+	delPk1   = ed25519.GenPrivKey().PubKey()
+	delAddr1 = sdk.AccAddress(delPk1.Address())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	return cdc
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+
+	now := time.Now().UTC()
+	totalWithdrawing := sdk.NewInt(1000)
+	stakingPool := sdk.NewInt(2000)
+	ppPairs := []types.PoolPurchaser{{PoolID: 1, Purchaser: delAddr1}}
+	withdraws := []types.Withdraw{types.NewWithdraw(delAddr1, sdk.NewInt(500), now)}
+	reimbursement := types.NewReimbursement(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), delAddr1, now)
+
+	kvPairs := kv.Pairs{
+		kv.Pair{Key: types.GetTotalWithdrawingKey(), Value: cdc.MustMarshalBinaryLengthPrefixed(totalWithdrawing)},
+		kv.Pair{Key: types.GetGlobalStakeForShieldPoolKey(), Value: cdc.MustMarshalBinaryLengthPrefixed(stakingPool)},
+		kv.Pair{Key: types.GetPurchaseExpirationTimeKey(now), Value: cdc.MustMarshalBinaryLengthPrefixed(ppPairs)},
+		kv.Pair{Key: types.GetWithdrawCompletionTimeKey(now), Value: cdc.MustMarshalBinaryLengthPrefixed(withdraws)},
+		kv.Pair{Key: types.GetReimbursementKey(1), Value: cdc.MustMarshalBinaryLengthPrefixed(reimbursement)},
+		kv.Pair{Key: []byte{0x10}, Value: []byte{0x10}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"TotalWithdrawing", fmt.Sprintf("%v\n%v", totalWithdrawing, totalWithdrawing)},
+		{"GlobalStakeForShieldPool", fmt.Sprintf("%v\n%v", stakingPool, stakingPool)},
+		{"PurchaseQueue", fmt.Sprintf("%v\n%v", ppPairs, ppPairs)},
+		{"WithdrawQueue", fmt.Sprintf("%v\n%v", withdraws, withdraws)},
+		{"Reimbursement", fmt.Sprintf("%v\n%v", reimbursement, reimbursement)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 { // nolint
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name) // nolint
+			} else {
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name) // nolint
+			}
+		})
+	}
+}
@@ -47,8 +47,12 @@ func GenPoolParams(r *rand.Rand) types.PoolParams {
 	withdrawPeriod := time.Duration(sim.RandIntBetween(r, 60*1, 60*60*24*3)) * time.Second
 	shieldFeesRate := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 0, 50)), 3)
 	poolShieldLimit := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 1, 20)), 2)
+	juniorFeesRate := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 100, 300)), 2)
+	stakingUnbondingPeriod := time.Duration(sim.RandIntBetween(r, 0, 60*60*24*2)) * time.Second
+	stakingRewardsRate := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 0, 50)), 2)
 
-	return types.NewPoolParams(protectionPeriod, withdrawPeriod, shieldFeesRate, poolShieldLimit, sdk.Coins{})
+	return types.NewPoolParams(protectionPeriod, withdrawPeriod, shieldFeesRate, poolShieldLimit, sdk.Coins{}, juniorFeesRate,
+		stakingUnbondingPeriod, stakingRewardsRate)
 }
 
 // GenClaimProposalParams returns a randomized ClaimProposalParams object.
@@ -59,7 +63,7 @@ func GenClaimProposalParams(r *rand.Rand) types.ClaimProposalParams {
 	depositRate := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 0, 100)), 3)
 	feesRate := sdk.NewDecWithPrec(int64(sim.RandIntBetween(r, 0, 50)), 3)
 
-	return types.NewClaimProposalParams(claimPeriod, payoutPeriod, minDeposit, depositRate, feesRate)
+	return types.NewClaimProposalParams(claimPeriod, payoutPeriod, minDeposit, depositRate, feesRate, sdk.DecCoins{})
 }
 
 // GenShieldStakingRateParam returns a randomized staking-shield rate.
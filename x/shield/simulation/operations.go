@@ -3,6 +3,7 @@ package simulation
 import (
 	"math/rand"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +33,9 @@ const (
 	OpWeightStakeForShield        = "op_weight_msg_stake_for_shield"
 	OpWeightUnstakeFromShield     = "op_weight_msg_unstake_from_shield"
 	OpWeightWithdrawReimbursement = "op_weight_msg_withdraw_reimbursement"
+
+	// Governance proposals
+	OpWeightPoolOperatorUpdateProposal = "op_weight_pool_operator_update_proposal"
 )
 
 var (
@@ -46,7 +50,7 @@ var (
 	DefaultWeightShieldClaimProposal      = 5
 	DefaultWeightMsgWithdrawReimbursement = 5
 
-	DefaultIntMax = 100000000000
+	DefaultWeightPoolOperatorUpdateProposal = 5
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -99,7 +103,7 @@ func WeightedOperations(appParams simulation.AppParams, cdc *codec.Codec, k keep
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(weightMsgCreatePool, SimulateMsgCreatePool(k, ak, sk)),
-		simulation.NewWeightedOperation(weightMsgCreatePool, SimulateMsgUpdatePool(k, ak, sk)),
+		simulation.NewWeightedOperation(weightMsgUpdatePool, SimulateMsgUpdatePool(k, ak, sk)),
 		simulation.NewWeightedOperation(weightMsgDepositCollateral, SimulateMsgDepositCollateral(k, ak, sk)),
 		simulation.NewWeightedOperation(weightMsgWithdrawCollateral, SimulateMsgWithdrawCollateral(k, ak, sk)),
 		simulation.NewWeightedOperation(weightMsgWithdrawRewards, SimulateMsgWithdrawRewards(k, ak)),
@@ -163,11 +167,7 @@ func SimulateMsgCreatePool(k keeper.Keeper, ak types.AccountKeeper, sk types.Sta
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		nativeServiceFees := sdk.NewCoins(sdk.NewCoin(bondDenom, nativeAmount))
-		foreignAmount, err := simulation.RandPositiveInt(r, sdk.NewInt(int64(DefaultIntMax)))
-		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
-		}
-		foreignServiceFees := sdk.NewCoins(sdk.NewCoin(sponsor, foreignAmount))
+		foreignServiceFees := randomForeignDeposit(r, ctx, account, bondDenom)
 
 		serviceFees := types.MixedCoins{Native: nativeServiceFees, Foreign: foreignServiceFees}
 		sponsorAcc, _ := simulation.RandomAcc(r, accs)
@@ -241,16 +241,12 @@ func SimulateMsgUpdatePool(k keeper.Keeper, ak types.AccountKeeper, sk types.Sta
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 		nativeServiceFees := sdk.NewCoins(sdk.NewCoin(bondDenom, nativeAmount))
-		foreignAmount, err := simulation.RandPositiveInt(r, sdk.NewInt(int64(DefaultIntMax)))
-		if err != nil {
-			return simulation.NoOpMsg(types.ModuleName), nil, nil
-		}
-		foreignServiceFees := sdk.NewCoins(sdk.NewCoin(pool.Sponsor, foreignAmount))
+		foreignServiceFees := randomForeignDeposit(r, ctx, account, bondDenom)
 
 		serviceFees := types.MixedCoins{Native: nativeServiceFees, Foreign: foreignServiceFees}
 		description := simulation.RandStringOfLength(r, 42)
 
-		msg := types.NewMsgUpdatePool(simAccount.Address, shield, serviceFees, poolID, description, sdk.ZeroInt())
+		msg := types.NewMsgUpdatePool(simAccount.Address, shield, serviceFees, poolID, description, sdk.ZeroInt(), time.Time{})
 
 		fees := sdk.Coins{}
 		tx := helpers.GenTx(
@@ -468,6 +464,11 @@ func ProposalContents(k keeper.Keeper, sk types.StakingKeeper) []simulation.Weig
 			DefaultWeight:      DefaultWeightShieldClaimProposal,
 			ContentSimulatorFn: SimulateShieldClaimProposalContent(k, sk),
 		},
+		{
+			AppParamsKey:       OpWeightPoolOperatorUpdateProposal,
+			DefaultWeight:      DefaultWeightPoolOperatorUpdateProposal,
+			ContentSimulatorFn: SimulatePoolOperatorUpdateProposalContent(k),
+		},
 	}
 }
 
@@ -476,17 +477,20 @@ func SimulateShieldClaimProposalContent(k keeper.Keeper, sk types.StakingKeeper)
 	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
 		bondDenom := sk.BondDenom(ctx)
 		purchaseList, found := keeper.RandomPurchaseList(r, k, ctx)
-		if len(purchaseList.Entries) == 0 {
+		if !found || len(purchaseList.Entries) == 0 {
 			return nil
 		}
 		i := r.Intn(len(purchaseList.Entries))
 		poolID := purchaseList.PoolID
 		purchaser := purchaseList.Purchaser
 		purchase := purchaseList.Entries[i]
-		if !found || purchase.ProtectionEndTime.Before(ctx.BlockTime()) {
+		if purchase.ProtectionEndTime.Before(ctx.BlockTime()) {
 			return nil
 		}
-		lossAmount, err := simulation.RandPositiveInt(r, purchase.Shield)
+
+		// The loss cannot exceed collaterals that are not yet secured for other claims.
+		maxLoss := sdk.MinInt(purchase.Shield, k.GetTotalCollateral(ctx).Sub(k.GetTotalClaimed(ctx)))
+		lossAmount, err := simulation.RandPositiveInt(r, maxLoss)
 		if err != nil {
 			return nil
 		}
@@ -495,12 +499,31 @@ func SimulateShieldClaimProposalContent(k keeper.Keeper, sk types.StakingKeeper)
 			sdk.NewCoins(sdk.NewCoin(bondDenom, lossAmount)),
 			purchase.PurchaseID,
 			simulation.RandStringOfLength(r, 500),
+			types.ClaimEvidence{},
 			simulation.RandStringOfLength(r, 500),
 			purchaser,
 		)
 	}
 }
 
+// SimulatePoolOperatorUpdateProposalContent generates random pool operator update proposal content.
+func SimulatePoolOperatorUpdateProposalContent(k keeper.Keeper) simulation.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simulation.Account) govtypes.Content {
+		poolID, _, found := keeper.RandomPoolInfo(r, k, ctx)
+		if !found {
+			return nil
+		}
+		operator, _ := simulation.RandomAcc(r, accs)
+		return types.NewPoolOperatorUpdateProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			poolID,
+			operator.Address,
+			k.IsPoolOperator(ctx, poolID, operator.Address),
+		)
+	}
+}
+
 // SimulateMsgStakeForShield generates a MsgPurchaseShield object with all of its fields randomized.
 func SimulateMsgStakeForShield(k keeper.Keeper, ak types.AccountKeeper, sk types.StakingKeeper) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
@@ -557,6 +580,7 @@ func SimulateMsgStakeForShield(k keeper.Keeper, ak types.AccountKeeper, sk types
 		if _, _, err := app.Deliver(tx); err != nil {
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
+
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
@@ -646,6 +670,18 @@ func SimulateMsgWithdrawReimbursement(k keeper.Keeper, ak types.AccountKeeper, s
 	}
 }
 
+// randomForeignDeposit returns a random subset of the account's spendable
+// coins other than the bond denom, which can be deposited to a pool.
+func randomForeignDeposit(r *rand.Rand, ctx sdk.Context, account authexported.Account, bondDenom string) sdk.Coins {
+	var foreign sdk.Coins
+	for _, coin := range account.SpendableCoins(ctx.BlockTime()) {
+		if coin.Denom != bondDenom {
+			foreign = append(foreign, coin)
+		}
+	}
+	return sdk.NewCoins(simulation.RandSubsetCoins(r, foreign)...)
+}
+
 func computeMaxShield(pool types.Pool, totalCollateral, totalWithdrawing, totalClaimed, totalShield sdk.Int, poolParams types.PoolParams) sdk.Int {
 	poolLimit := pool.ShieldLimit.Sub(pool.Shield)
 	globalLimit := sdk.MinInt(totalCollateral.Sub(totalWithdrawing).Sub(totalClaimed).ToDec().Mul(poolParams.PoolShieldLimit).TruncateInt().Sub(pool.Shield),
@@ -2,6 +2,7 @@ package testshield
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,6 +42,16 @@ func (sh *Helper) WithdrawCollateral(addr sdk.AccAddress, amount int64, ok bool)
 	sh.Handle(msg, ok)
 }
 
+func (sh *Helper) AllocateCollateral(addr sdk.AccAddress, allocations []types.PoolAllocation, ok bool) {
+	msg := types.NewMsgAllocateCollateral(addr, allocations)
+	sh.Handle(msg, ok)
+}
+
+func (sh *Helper) SetTranche(addr sdk.AccAddress, tranche string, ok bool) {
+	msg := types.NewMsgSetTranche(addr, tranche)
+	sh.Handle(msg, ok)
+}
+
 func (sh *Helper) CreatePool(addr, sponsorAddr sdk.AccAddress, nativeDeposit, shield, shieldLimit int64, sponsor, description string) {
 	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
 	depositCoins := types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(sh.denom, nativeDeposit))}
@@ -49,15 +60,50 @@ func (sh *Helper) CreatePool(addr, sponsorAddr sdk.AccAddress, nativeDeposit, sh
 	sh.Handle(msg, true)
 }
 
+func (sh *Helper) UpdatePool(addr sdk.AccAddress, poolID uint64, nativeDeposit, shield, shieldLimit int64, effectiveTime time.Time, ok bool) {
+	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
+	depositCoins := types.MixedCoins{Native: sdk.NewCoins(sdk.NewInt64Coin(sh.denom, nativeDeposit))}
+	limit := sdk.NewInt(shieldLimit)
+	msg := types.NewMsgUpdatePool(addr, shieldCoins, depositCoins, poolID, "", limit, effectiveTime)
+	sh.Handle(msg, ok)
+}
+
+func (sh *Helper) SunsetPool(addr sdk.AccAddress, poolID uint64, ok bool) {
+	msg := types.NewMsgSunsetPool(addr, poolID)
+	sh.Handle(msg, ok)
+}
+
+func (sh *Helper) PausePool(addr sdk.AccAddress, poolID uint64, ok bool) {
+	msg := types.NewMsgPausePool(addr, poolID)
+	sh.Handle(msg, ok)
+}
+
 func (sh *Helper) PurchaseShield(purchaser sdk.AccAddress, shield int64, poolID uint64, ok bool) {
 	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
 	msg := types.NewMsgPurchaseShield(poolID, shieldCoins, "test_purchase", purchaser)
 	sh.Handle(msg, ok)
 }
 
+func (sh *Helper) StakeForShield(purchaser sdk.AccAddress, shield int64, poolID uint64, ok bool) {
+	shieldCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, shield))
+	msg := types.NewMsgStakeForShield(poolID, shieldCoins, "test_stake", purchaser)
+	sh.Handle(msg, ok)
+}
+
+func (sh *Helper) UnstakeFromShield(purchaser sdk.AccAddress, amount int64, poolID uint64, ok bool) {
+	amountCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, amount))
+	msg := types.NewMsgUnstakeFromShield(poolID, amountCoins, purchaser)
+	sh.Handle(msg, ok)
+}
+
 func (sh *Helper) ShieldClaimProposal(proposer sdk.AccAddress, loss int64, poolID, purchaseID uint64, ok bool) {
 	lossCoins := sdk.NewCoins(sdk.NewInt64Coin(sh.denom, loss))
-	proposal := types.NewShieldClaimProposal(poolID, lossCoins, purchaseID, "test_claim_evidence", "test_claim_description", proposer)
+	proposal := types.NewShieldClaimProposal(poolID, lossCoins, purchaseID, "test_claim_evidence", types.ClaimEvidence{}, "test_claim_description", proposer)
+	sh.HandleProposal(proposal, ok)
+}
+
+func (sh *Helper) PoolOperatorUpdateProposal(poolID uint64, operator sdk.AccAddress, remove bool, ok bool) {
+	proposal := types.NewPoolOperatorUpdateProposal("test_title", "test_description", poolID, operator, remove)
 	sh.HandleProposal(proposal, ok)
 }
 
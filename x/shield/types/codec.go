@@ -10,17 +10,21 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUpdatePool{}, "shield/MsgUpdatePool", nil)
 	cdc.RegisterConcrete(MsgPausePool{}, "shield/MsgPausePool", nil)
 	cdc.RegisterConcrete(MsgResumePool{}, "shield/MsgResumePool", nil)
+	cdc.RegisterConcrete(MsgSunsetPool{}, "shield/MsgSunsetPool", nil)
 	cdc.RegisterConcrete(MsgDepositCollateral{}, "shield/MsgDepositCollateral", nil)
 	cdc.RegisterConcrete(MsgWithdrawCollateral{}, "shield/MsgWithdrawCollateral", nil)
 	cdc.RegisterConcrete(MsgWithdrawRewards{}, "shield/MsgWithdrawRewards", nil)
 	cdc.RegisterConcrete(MsgWithdrawForeignRewards{}, "shield/MsgWithdrawForeignRewards", nil)
 	cdc.RegisterConcrete(MsgClearPayouts{}, "shield/MsgClearPayouts", nil)
 	cdc.RegisterConcrete(ShieldClaimProposal{}, "shield/ShieldClaimProposal", nil)
+	cdc.RegisterConcrete(PoolOperatorUpdateProposal{}, "shield/PoolOperatorUpdateProposal", nil)
 	cdc.RegisterConcrete(MsgPurchaseShield{}, "shield/MsgPurchaseShield", nil)
 	cdc.RegisterConcrete(MsgWithdrawReimbursement{}, "shield/MsgWithdrawReimbursement", nil)
 	cdc.RegisterConcrete(MsgUpdateSponsor{}, "shield/MsgUpdateSponsor", nil)
 	cdc.RegisterConcrete(MsgStakeForShield{}, "shield/MsgStakeForShield", nil)
 	cdc.RegisterConcrete(MsgUnstakeFromShield{}, "shield/MsgUnstakeFromShield", nil)
+	cdc.RegisterConcrete(MsgAllocateCollateral{}, "shield/MsgAllocateCollateral", nil)
+	cdc.RegisterConcrete(MsgSetTranche{}, "shield/MsgSetTranche", nil)
 }
 
 // ModuleCdc is the generic sealed codec to be used throughout module.
@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PegTaskFunction is the function name of the oracle task whose
+	// contract is a foreign denom and whose result scores its peg.
+	PegTaskFunction = "peg"
+)
+
+var (
+	// DefaultMinPegScore is the default minimum oracle score required
+	// for a foreign denom to be converted.
+	DefaultMinPegScore = sdk.NewInt(90)
+)
+
+// ReimbursementConverter converts claimed losses in foreign denoms to
+// the bond denom, in which provider collaterals are paid out.
+type ReimbursementConverter interface {
+	// ConversionRate returns the amount of bond denom per unit of the
+	// given denom.
+	ConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
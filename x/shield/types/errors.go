@@ -44,4 +44,16 @@ var (
 	ErrShieldAdminNotActive       = sdkerrors.Register(ModuleName, 139, "shield admin is not activated")
 	ErrPurchaseTooSmall           = sdkerrors.Register(ModuleName, 140, "purchase amount is too small")
 	ErrNotEnoughStaked            = sdkerrors.Register(ModuleName, 142, "not enough unlocked staking to be withdrawn")
+	ErrInvalidAllocation          = sdkerrors.Register(ModuleName, 143, "invalid collateral allocation")
+	ErrInvalidTranche             = sdkerrors.Register(ModuleName, 144, "invalid tranche")
+	ErrTrancheLocked              = sdkerrors.Register(ModuleName, 145, "tranche cannot be changed while claims are pending")
+	ErrInvalidEvidence            = sdkerrors.Register(ModuleName, 146, "invalid claim evidence")
+	ErrPoolSunset                 = sdkerrors.Register(ModuleName, 147, "pool is sunset")
+	ErrInvalidEffectiveTime       = sdkerrors.Register(ModuleName, 148, "invalid effective time for the pool update")
+	ErrUnsupportedLossDenom       = sdkerrors.Register(ModuleName, 149, "loss denom cannot be converted to the bond denom")
+	ErrConversionUnavailable      = sdkerrors.Register(ModuleName, 150, "conversion rate is not confirmed by the oracle")
+	ErrNotPoolOperator            = sdkerrors.Register(ModuleName, 151, "not the shield admin or an operator of the pool")
+	ErrOperatorAlreadyExists      = sdkerrors.Register(ModuleName, 152, "pool operator already exists")
+	ErrOperatorNotFound           = sdkerrors.Register(ModuleName, 153, "pool operator not found")
+	ErrSponsorNotAllowed          = sdkerrors.Register(ModuleName, 154, "sponsors can only top up deposits and update descriptions")
 )
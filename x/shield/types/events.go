@@ -16,6 +16,18 @@ const (
 	EventTypeCreateReimbursement    = "create_reimbursement"
 	EventTypeWithdrawReimbursement  = "withdraw_reimbursement"
 	EventTypeUpdateSponsor          = "update_sponsor"
+	EventTypeAllocateCollateral     = "allocate_collateral"
+	EventTypeSetTranche             = "set_tranche"
+	EventTypeSchedulePoolUpdate     = "schedule_pool_update"
+	EventTypeApplyPoolUpdate        = "apply_pool_update"
+	EventTypeSunsetPool             = "sunset_pool"
+	EventTypeRefundPoolDeposit      = "refund_pool_deposit"
+	EventTypeClosePool              = "close_pool"
+	EventTypeUnbondStake            = "unbond_stake"
+	EventTypeCompleteUnstake        = "complete_unstake"
+	EventTypeSlashCollateral        = "slash_collateral"
+	EventTypeAddPoolOperator        = "add_pool_operator"
+	EventTypeRemovePoolOperator     = "remove_pool_operator"
 
 	AttributeKeyShield              = "shield"
 	AttributeKeyDeposit             = "deposit"
@@ -36,5 +48,14 @@ const (
 	AttributeKeyPurchaseDescription = "purchase_description"
 	AttributeKeyServiceFees         = "service_fees"
 	AttributeKeyProtectionEndTime   = "protection_end_time"
+	AttributeKeyAllocations         = "allocations"
+	AttributeKeyTranche             = "tranche"
+	AttributeKeyShieldLimit         = "shield_limit"
+	AttributeKeyEffectiveTime       = "effective_time"
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyRewards             = "rewards"
+	AttributeKeyCompletionTime      = "completion_time"
+	AttributeKeyValidator           = "validator"
+	AttributeKeyOperator            = "operator"
 	AttributeValueCategory          = ModuleName
 )
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	"github.com/certikfoundation/shentu/x/oracle"
 )
 
 // AccountKeeper defines the expected account keeper.
@@ -81,3 +83,8 @@ type SupplyKeeper interface {
 type GovKeeper interface {
 	GetVotingParams(ctx sdk.Context) govTypes.VotingParams
 }
+
+// OracleKeeper defines the expected oracle keeper.
+type OracleKeeper interface {
+	GetTask(ctx sdk.Context, contract, function string) (oracle.Task, error)
+}
@@ -32,13 +32,21 @@ type GenesisState struct {
 	StakeForShields              []ShieldStaking               `json:"staking_purchases" yaml:"staking_purchases"`
 	OriginalStakings             []OriginalStaking             `json:"original_stakings" yaml:"original_stakings"`
 	ProposalIDReimbursementPairs []ProposalIDReimbursementPair `json:"proposalID_reimbursement_pairs" yaml:"proposalID_reimbursement_pairs"`
+	ClaimLocks                   []ClaimLock                   `json:"claim_locks" yaml:"claim_locks"`
+	UnbondingDelays              []UnbondingDelay              `json:"unbonding_delays" yaml:"unbonding_delays"`
+	PoolUpdates                  []PoolUpdate                  `json:"pool_updates" yaml:"pool_updates"`
+	StakeUnbondings              []StakeUnbonding              `json:"stake_unbondings" yaml:"stake_unbondings"`
+	ClaimConversions             []ClaimConversion             `json:"claim_conversions" yaml:"claim_conversions"`
+	PoolOperators                []PoolOperator                `json:"pool_operators" yaml:"pool_operators"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(shieldAdmin sdk.AccAddress, nextPoolID, nextPurchaseID uint64, poolParams PoolParams,
 	claimProposalParams ClaimProposalParams, totalCollateral, totalWithdrawing, totalShield, totalClaimed sdk.Int, serviceFees, remainingServiceFees MixedDecCoins,
 	pools []Pool, providers []Provider, purchase []PurchaseList, withdraws Withdraws, lastUpdateTime time.Time, sSRate sdk.Dec, globalStakingPool sdk.Int,
-	stakingPurchases []ShieldStaking, originalStaking []OriginalStaking, proposalIDReimbursementPairs []ProposalIDReimbursementPair) GenesisState {
+	stakingPurchases []ShieldStaking, originalStaking []OriginalStaking, proposalIDReimbursementPairs []ProposalIDReimbursementPair,
+	claimLocks []ClaimLock, unbondingDelays []UnbondingDelay, poolUpdates []PoolUpdate, stakeUnbondings []StakeUnbonding,
+	claimConversions []ClaimConversion, poolOperators []PoolOperator) GenesisState {
 	return GenesisState{
 		ShieldAdmin:                  shieldAdmin,
 		NextPoolID:                   nextPoolID,
@@ -61,6 +69,12 @@ func NewGenesisState(shieldAdmin sdk.AccAddress, nextPoolID, nextPurchaseID uint
 		StakeForShields:              stakingPurchases,
 		OriginalStakings:             originalStaking,
 		ProposalIDReimbursementPairs: proposalIDReimbursementPairs,
+		ClaimLocks:                   claimLocks,
+		UnbondingDelays:              unbondingDelays,
+		PoolUpdates:                  poolUpdates,
+		StakeUnbondings:              stakeUnbondings,
+		ClaimConversions:             claimConversions,
+		PoolOperators:                poolOperators,
 	}
 }
 
@@ -45,6 +45,12 @@ var (
 	BlockServiceFeesKey         = []byte{0x12}
 	OriginalStakingKey          = []byte{0x13}
 	ReimbursementKey            = []byte{0x14}
+	ClaimLockQueueKey           = []byte{0x15}
+	UnbondingDelayQueueKey      = []byte{0x16}
+	PoolUpdateQueueKey          = []byte{0x17}
+	StakeUnbondingQueueKey      = []byte{0x18}
+	ClaimConversionKey          = []byte{0x19}
+	PoolOperatorKey             = []byte{0x1A}
 )
 
 func GetTotalCollateralKey() []byte {
@@ -150,3 +156,50 @@ func GetReimbursementKey(proposalID uint64) []byte {
 	binary.LittleEndian.PutUint64(bz, proposalID)
 	return append(ReimbursementKey, bz...)
 }
+
+// GetClaimConversionKey gets the key for the conversion rates of a claim proposal.
+func GetClaimConversionKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, proposalID)
+	return append(ClaimConversionKey, bz...)
+}
+
+// GetPoolOperatorsKey gets the key prefix for the operators of a pool.
+func GetPoolOperatorsKey(poolID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.LittleEndian.PutUint64(bz, poolID)
+	return append(PoolOperatorKey, bz...)
+}
+
+// GetPoolOperatorKey gets the key for an operator of a pool.
+func GetPoolOperatorKey(poolID uint64, operator sdk.AccAddress) []byte {
+	return append(GetPoolOperatorsKey(poolID), operator...)
+}
+
+// GetClaimLockEndTimeKey gets a claim lock queue key,
+// which is obtained from the lock end time.
+func GetClaimLockEndTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(ClaimLockQueueKey, bz...)
+}
+
+// GetUnbondingDelayTimeKey gets an unbonding delay queue key,
+// which is obtained from the delayed completion time.
+func GetUnbondingDelayTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(UnbondingDelayQueueKey, bz...)
+}
+
+// GetPoolUpdateTimeKey gets a pool update queue key,
+// which is obtained from the effective time of the update.
+func GetPoolUpdateTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(PoolUpdateQueueKey, bz...)
+}
+
+// GetStakeUnbondingTimeKey gets a stake unbonding queue key,
+// which is obtained from the completion time.
+func GetStakeUnbondingTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(StakeUnbondingQueueKey, bz...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -70,17 +71,23 @@ type MsgUpdatePool struct {
 	PoolID      uint64         `json:"pool_id" yaml:"pool_id"`
 	Description string         `json:"description" yaml:"description"`
 	ShieldLimit sdk.Int        `json:"shield_limit" yaml:"shield_limit"`
+
+	// EffectiveTime schedules the shield limit and service fee deposit
+	// to take effect at a future time. They take effect immediately if
+	// it is zero.
+	EffectiveTime time.Time `json:"effective_time" yaml:"effective_time"`
 }
 
 // NewMsgUpdatePool creates a new MsgUpdatePool instance.
-func NewMsgUpdatePool(accAddr sdk.AccAddress, shield sdk.Coins, serviceFees MixedCoins, id uint64, description string, shieldLimit sdk.Int) MsgUpdatePool {
+func NewMsgUpdatePool(accAddr sdk.AccAddress, shield sdk.Coins, serviceFees MixedCoins, id uint64, description string, shieldLimit sdk.Int, effectiveTime time.Time) MsgUpdatePool {
 	return MsgUpdatePool{
-		From:        accAddr,
-		Shield:      shield,
-		ServiceFees: serviceFees,
-		PoolID:      id,
-		Description: description,
-		ShieldLimit: shieldLimit,
+		From:          accAddr,
+		Shield:        shield,
+		ServiceFees:   serviceFees,
+		PoolID:        id,
+		Description:   description,
+		ShieldLimit:   shieldLimit,
+		EffectiveTime: effectiveTime,
 	}
 }
 
@@ -112,6 +119,51 @@ func (msg MsgUpdatePool) ValidateBasic() error {
 	if !msg.Shield.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid shield")
 	}
+	if !msg.EffectiveTime.IsZero() && !msg.Shield.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidEffectiveTime, "shield purchases cannot be scheduled")
+	}
+	return nil
+}
+
+// MsgSunsetPool defines the attributes of sunsetting a shield pool.
+type MsgSunsetPool struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	PoolID uint64         `json:"pool_id" yaml:"pool_id"`
+}
+
+// NewMsgSunsetPool creates a new MsgSunsetPool instance.
+func NewMsgSunsetPool(accAddr sdk.AccAddress, id uint64) MsgSunsetPool {
+	return MsgSunsetPool{
+		From:   accAddr,
+		PoolID: id,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSunsetPool) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSunsetPool) Type() string { return EventTypeSunsetPool }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSunsetPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSunsetPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSunsetPool) ValidateBasic() error {
+	if msg.From.Empty() {
+		return ErrEmptySender
+	}
+	if msg.PoolID == 0 {
+		return ErrInvalidPoolID
+	}
 	return nil
 }
 
@@ -618,3 +670,97 @@ func (msg MsgUpdateSponsor) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MsgAllocateCollateral defines the attributes of a collateral allocation transaction.
+type MsgAllocateCollateral struct {
+	From        sdk.AccAddress   `json:"sender" yaml:"sender"`
+	Allocations []PoolAllocation `json:"allocations" yaml:"allocations"`
+}
+
+// NewMsgAllocateCollateral creates a new MsgAllocateCollateral instance.
+func NewMsgAllocateCollateral(from sdk.AccAddress, allocations []PoolAllocation) MsgAllocateCollateral {
+	return MsgAllocateCollateral{
+		From:        from,
+		Allocations: allocations,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) Type() string { return EventTypeAllocateCollateral }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgAllocateCollateral) ValidateBasic() error {
+	if msg.From.Empty() {
+		return ErrEmptySender
+	}
+	seen := make(map[uint64]bool)
+	for _, allocation := range msg.Allocations {
+		if allocation.PoolID == 0 {
+			return ErrInvalidPoolID
+		}
+		if seen[allocation.PoolID] {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "duplicate pool %d", allocation.PoolID)
+		}
+		seen[allocation.PoolID] = true
+		if allocation.Weight.IsNil() || !allocation.Weight.IsPositive() || allocation.Weight.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "weight for pool %d must be in (0, 1]", allocation.PoolID)
+		}
+	}
+	return nil
+}
+
+// MsgSetTranche defines the attributes of a set tranche transaction.
+type MsgSetTranche struct {
+	From    sdk.AccAddress `json:"sender" yaml:"sender"`
+	Tranche string         `json:"tranche" yaml:"tranche"`
+}
+
+// NewMsgSetTranche creates a new MsgSetTranche instance.
+func NewMsgSetTranche(from sdk.AccAddress, tranche string) MsgSetTranche {
+	return MsgSetTranche{
+		From:    from,
+		Tranche: tranche,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSetTranche) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSetTranche) Type() string { return EventTypeSetTranche }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSetTranche) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSetTranche) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSetTranche) ValidateBasic() error {
+	if msg.From.Empty() {
+		return ErrEmptySender
+	}
+	if TrancheFromString(msg.Tranche) == TrancheNil {
+		return ErrInvalidTranche
+	}
+	return nil
+}
@@ -13,11 +13,14 @@ import (
 // default parameter values
 var (
 	// default values for Shield pool's parameters
-	DefaultProtectionPeriod  = time.Hour * 24 * 21                                                   // 21 days
-	DefaultShieldFeesRate    = sdk.NewDecWithPrec(769, 5)                                            // 0.769%
-	DefaultWithdrawPeriod    = time.Hour * 24 * 21                                                   // 21 days
-	DefaultPoolShieldLimit   = sdk.NewDecWithPrec(50, 2)                                             // 50%
-	DefaultMinShieldPurchase = sdk.NewCoins(sdk.NewCoin(common.MicroCTKDenom, sdk.NewInt(50000000))) // 50 CTK
+	DefaultProtectionPeriod       = time.Hour * 24 * 21                                                   // 21 days
+	DefaultShieldFeesRate         = sdk.NewDecWithPrec(769, 5)                                            // 0.769%
+	DefaultWithdrawPeriod         = time.Hour * 24 * 21                                                   // 21 days
+	DefaultPoolShieldLimit        = sdk.NewDecWithPrec(50, 2)                                             // 50%
+	DefaultMinShieldPurchase      = sdk.NewCoins(sdk.NewCoin(common.MicroCTKDenom, sdk.NewInt(50000000))) // 50 CTK
+	DefaultJuniorFeesRate         = sdk.NewDecWithPrec(150, 2)                                            // 150%
+	DefaultStakingUnbondingPeriod = time.Hour * 24 * 7                                                    // 7 days
+	DefaultStakingRewardsRate     = sdk.NewDecWithPrec(20, 2)                                             // 20%
 
 	// default values for Shield claim proposal's parameters
 	DefaultClaimPeriod              = time.Hour * 24 * 21                                                    // 21 days
@@ -53,22 +56,36 @@ type PoolParams struct {
 	WithdrawPeriod    time.Duration `json:"withdraw_period" yaml:"withdraw_period"`
 	PoolShieldLimit   sdk.Dec       `json:"pool_shield_limit" yaml:"pool_shield_limit"`
 	MinShieldPurchase sdk.Coins     `json:"min_shield_purchase" yaml:"min_shield_purchase"`
+	JuniorFeesRate    sdk.Dec       `json:"junior_fees_rate" yaml:"junior_fees_rate"`
+
+	// StakingUnbondingPeriod is the maturity period of unstaked
+	// stake-for-shield deposits after their purchases expire.
+	StakingUnbondingPeriod time.Duration `json:"staking_unbonding_period" yaml:"staking_unbonding_period"`
+
+	// StakingRewardsRate is the share of block rewards distributed
+	// to stake-for-shield stakers instead of collateral providers.
+	StakingRewardsRate sdk.Dec `json:"staking_rewards_rate" yaml:"staking_rewards_rate"`
 }
 
 // NewPoolParams creates a new PoolParams object.
-func NewPoolParams(protectionPeriod, withdrawPeriod time.Duration, shieldFeesRate sdk.Dec, poolShieldLimit sdk.Dec, minShieldPurchase sdk.Coins) PoolParams {
+func NewPoolParams(protectionPeriod, withdrawPeriod time.Duration, shieldFeesRate sdk.Dec, poolShieldLimit sdk.Dec, minShieldPurchase sdk.Coins, juniorFeesRate sdk.Dec,
+	stakingUnbondingPeriod time.Duration, stakingRewardsRate sdk.Dec) PoolParams {
 	return PoolParams{
-		ProtectionPeriod:  protectionPeriod,
-		ShieldFeesRate:    shieldFeesRate,
-		WithdrawPeriod:    withdrawPeriod,
-		PoolShieldLimit:   poolShieldLimit,
-		MinShieldPurchase: minShieldPurchase,
+		ProtectionPeriod:       protectionPeriod,
+		ShieldFeesRate:         shieldFeesRate,
+		WithdrawPeriod:         withdrawPeriod,
+		PoolShieldLimit:        poolShieldLimit,
+		MinShieldPurchase:      minShieldPurchase,
+		JuniorFeesRate:         juniorFeesRate,
+		StakingUnbondingPeriod: stakingUnbondingPeriod,
+		StakingRewardsRate:     stakingRewardsRate,
 	}
 }
 
 // DefaultPoolParams returns a default PoolParams instance.
 func DefaultPoolParams() PoolParams {
-	return NewPoolParams(DefaultProtectionPeriod, DefaultWithdrawPeriod, DefaultShieldFeesRate, DefaultPoolShieldLimit, DefaultMinShieldPurchase)
+	return NewPoolParams(DefaultProtectionPeriod, DefaultWithdrawPeriod, DefaultShieldFeesRate, DefaultPoolShieldLimit, DefaultMinShieldPurchase, DefaultJuniorFeesRate,
+		DefaultStakingUnbondingPeriod, DefaultStakingRewardsRate)
 }
 
 func validatePoolParams(i interface{}) error {
@@ -81,6 +98,9 @@ func validatePoolParams(i interface{}) error {
 	withdrawPeriod := v.WithdrawPeriod
 	poolShieldLimit := v.PoolShieldLimit
 	minShieldPurchase := v.MinShieldPurchase
+	juniorFeesRate := v.JuniorFeesRate
+	stakingUnbondingPeriod := v.StakingUnbondingPeriod
+	stakingRewardsRate := v.StakingRewardsRate
 
 	if protectionPeriod <= 0 {
 		return fmt.Errorf("protection period must be positive: %s", protectionPeriod)
@@ -97,6 +117,15 @@ func validatePoolParams(i interface{}) error {
 	if !minShieldPurchase.IsValid() {
 		return fmt.Errorf("minimum shield purchase must be a valid sdk.Coins, is %s", minShieldPurchase.String())
 	}
+	if juniorFeesRate.IsNil() || juniorFeesRate.LT(sdk.OneDec()) {
+		return fmt.Errorf("junior fees rate should be greater or equal to one but is %s", juniorFeesRate)
+	}
+	if stakingUnbondingPeriod < 0 {
+		return fmt.Errorf("staking unbonding period must be non-negative: %s", stakingUnbondingPeriod)
+	}
+	if stakingRewardsRate.IsNil() || stakingRewardsRate.IsNegative() || stakingRewardsRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("staking rewards rate should be positive and less or equal to one but is %s", stakingRewardsRate)
+	}
 
 	return nil
 }
@@ -108,23 +137,28 @@ type ClaimProposalParams struct {
 	MinDeposit   sdk.Coins     `json:"min_deposit" json:"min_deposit"`
 	DepositRate  sdk.Dec       `json:"deposit_rate" yaml:"deposit_rate"`
 	FeesRate     sdk.Dec       `json:"fees_rate" yaml:"fees_rate"`
+
+	// ConversionRates are the fixed amounts of bond denom per unit of
+	// each foreign denom in which losses can be claimed.
+	ConversionRates sdk.DecCoins `json:"conversion_rates" yaml:"conversion_rates"`
 }
 
 // NewClaimProposalParams creates a new ClaimProposalParams instance.
-func NewClaimProposalParams(claimPeriod, payoutPeriod time.Duration, minDeposit sdk.Coins, depositRate, feesRate sdk.Dec) ClaimProposalParams {
+func NewClaimProposalParams(claimPeriod, payoutPeriod time.Duration, minDeposit sdk.Coins, depositRate, feesRate sdk.Dec, conversionRates sdk.DecCoins) ClaimProposalParams {
 	return ClaimProposalParams{
-		ClaimPeriod:  claimPeriod,
-		PayoutPeriod: payoutPeriod,
-		MinDeposit:   minDeposit,
-		DepositRate:  depositRate,
-		FeesRate:     feesRate,
+		ClaimPeriod:     claimPeriod,
+		PayoutPeriod:    payoutPeriod,
+		MinDeposit:      minDeposit,
+		DepositRate:     depositRate,
+		FeesRate:        feesRate,
+		ConversionRates: conversionRates,
 	}
 }
 
 // DefaultClaimProposalParams returns a default ClaimProposalParams instance.
 func DefaultClaimProposalParams() ClaimProposalParams {
 	return NewClaimProposalParams(DefaultClaimPeriod, DefaultPayoutPeriod,
-		DefaultMinClaimProposalDeposit, DefaultClaimProposalDepositRate, DefaultClaimProposalFeesRate)
+		DefaultMinClaimProposalDeposit, DefaultClaimProposalDepositRate, DefaultClaimProposalFeesRate, sdk.DecCoins{})
 }
 
 func validateClaimProposalParams(i interface{}) error {
@@ -137,6 +171,7 @@ func validateClaimProposalParams(i interface{}) error {
 	minDeposit := v.MinDeposit
 	depositRate := v.DepositRate
 	feesRate := v.FeesRate
+	conversionRates := v.ConversionRates
 
 	if claimPeriod <= 0 {
 		return fmt.Errorf("claim period must be positive: %s", claimPeriod)
@@ -156,6 +191,9 @@ func validateClaimProposalParams(i interface{}) error {
 		return fmt.Errorf("fees rate should be positive and less or equal to one but is %s",
 			feesRate.String())
 	}
+	if !conversionRates.IsValid() {
+		return fmt.Errorf("conversion rates must be valid and positive but are %s", conversionRates)
+	}
 
 	return nil
 }
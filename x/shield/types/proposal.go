@@ -1,12 +1,15 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -15,14 +18,23 @@ import (
 const (
 	// ProposalTypeShieldClaim defines the type for a ShieldClaimProposal.
 	ProposalTypeShieldClaim = "ShieldClaim"
+
+	// ProposalTypePoolOperatorUpdate defines the type for a PoolOperatorUpdateProposal.
+	ProposalTypePoolOperatorUpdate = "PoolOperatorUpdate"
 )
 
-// Assert ShieldClaimProposal implements govTypes.Content at compile-time.
-var _ govTypes.Content = ShieldClaimProposal{}
+// Assert ShieldClaimProposal and PoolOperatorUpdateProposal implement
+// govTypes.Content at compile-time.
+var (
+	_ govTypes.Content = ShieldClaimProposal{}
+	_ govTypes.Content = PoolOperatorUpdateProposal{}
+)
 
 func init() {
 	govTypes.RegisterProposalType(ProposalTypeShieldClaim)
 	govTypes.RegisterProposalTypeCodec(ShieldClaimProposal{}, "shield/ShieldClaimProposal")
+	govTypes.RegisterProposalType(ProposalTypePoolOperatorUpdate)
+	govTypes.RegisterProposalTypeCodec(PoolOperatorUpdateProposal{}, "shield/PoolOperatorUpdateProposal")
 }
 
 // ShieldClaimProposal defines the data structure of a shield claim proposal.
@@ -32,16 +44,18 @@ type ShieldClaimProposal struct {
 	PurchaseID  uint64         `json:"purchase_id" yaml:"purchase_id"`
 	Loss        sdk.Coins      `json:"loss" yaml:"loss"`
 	Evidence    string         `json:"evidence" yaml:"evidence"`
+	Attachments ClaimEvidence  `json:"attachments" yaml:"attachments"`
 	Description string         `json:"description" yaml:"description"`
 	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 }
 
 // NewShieldClaimProposal creates a new shield claim proposal.
-func NewShieldClaimProposal(poolID uint64, loss sdk.Coins, purchaseID uint64, evidence, description string, proposer sdk.AccAddress) ShieldClaimProposal {
+func NewShieldClaimProposal(poolID uint64, loss sdk.Coins, purchaseID uint64, evidence string, attachments ClaimEvidence, description string, proposer sdk.AccAddress) ShieldClaimProposal {
 	return ShieldClaimProposal{
 		PoolID:      poolID,
 		Loss:        loss,
 		Evidence:    evidence,
+		Attachments: attachments,
 		PurchaseID:  purchaseID,
 		Description: description,
 		Proposer:    proposer,
@@ -70,8 +84,13 @@ func (scp ShieldClaimProposal) ProposalType() string {
 
 // ValidateBasic runs basic stateless validity checks.
 func (scp ShieldClaimProposal) ValidateBasic() error {
-	// TODO
-	return nil
+	if scp.Proposer.Empty() {
+		return ErrEmptySender
+	}
+	if !scp.Loss.IsValid() || scp.Loss.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "loss: %s", scp.Loss)
+	}
+	return scp.Attachments.ValidateBasic()
 }
 
 // String implements the Stringer interface.
@@ -81,13 +100,197 @@ func (scp ShieldClaimProposal) String() string {
   PoolID:         %d
   Loss:           %s
   Evidence:       %s
+  Attachments:    %s
   PurchaseID:     %d
   Description:    %s
   Proposer:       %s
-`, scp.PoolID, scp.Loss, scp.Evidence, scp.PurchaseID, scp.Description, scp.Proposer))
+`, scp.PoolID, scp.Loss, scp.Evidence, scp.Attachments, scp.PurchaseID, scp.Description, scp.Proposer))
+	return b.String()
+}
+
+// PoolOperatorUpdateProposal adds or removes an operator of a pool.
+type PoolOperatorUpdateProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	PoolID      uint64         `json:"pool_id" yaml:"pool_id"`
+	Operator    sdk.AccAddress `json:"operator" yaml:"operator"`
+	Remove      bool           `json:"remove" yaml:"remove"`
+}
+
+// NewPoolOperatorUpdateProposal creates a new pool operator update proposal.
+func NewPoolOperatorUpdateProposal(title, description string, poolID uint64, operator sdk.AccAddress, remove bool) PoolOperatorUpdateProposal {
+	return PoolOperatorUpdateProposal{
+		Title:       title,
+		Description: description,
+		PoolID:      poolID,
+		Operator:    operator,
+		Remove:      remove,
+	}
+}
+
+// GetTitle returns the title of a pool operator update proposal.
+func (pup PoolOperatorUpdateProposal) GetTitle() string { return pup.Title }
+
+// GetDescription returns the description of a pool operator update proposal.
+func (pup PoolOperatorUpdateProposal) GetDescription() string { return pup.Description }
+
+// ProposalRoute returns the routing key of a pool operator update proposal.
+func (pup PoolOperatorUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a pool operator update proposal.
+func (pup PoolOperatorUpdateProposal) ProposalType() string { return ProposalTypePoolOperatorUpdate }
+
+// ValidateBasic runs basic stateless validity checks.
+func (pup PoolOperatorUpdateProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(pup); err != nil {
+		return err
+	}
+	if pup.PoolID == 0 {
+		return ErrInvalidPoolID
+	}
+	if pup.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty operator")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (pup PoolOperatorUpdateProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Pool Operator Update Proposal:
+  Title:       %s
+  Description: %s
+  PoolID:      %d
+  Operator:    %s
+  Remove:      %t
+`, pup.Title, pup.Description, pup.PoolID, pup.Operator, pup.Remove))
 	return b.String()
 }
 
+var (
+	// cidV0Regexp matches base58btc encoded IPFS CIDv0 hashes.
+	cidV0Regexp = regexp.MustCompile(`^Qm[1-9A-HJ-NP-Za-km-z]{44}$`)
+	// cidV1Regexp matches base32 encoded IPFS CIDv1 hashes.
+	cidV1Regexp = regexp.MustCompile(`^b[a-z2-7]{58,}$`)
+)
+
+// ExternalTx identifies a transaction on an external chain.
+type ExternalTx struct {
+	Chain  string `json:"chain" yaml:"chain"`
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
+}
+
+// NewExternalTx returns a new ExternalTx instance.
+func NewExternalTx(chain, txHash string) ExternalTx {
+	return ExternalTx{
+		Chain:  chain,
+		TxHash: txHash,
+	}
+}
+
+// ValidateBasic checks that the chain is given and the transaction
+// hash is a 32-byte hex string, optionally prefixed with 0x.
+func (tx ExternalTx) ValidateBasic() error {
+	if strings.TrimSpace(tx.Chain) == "" {
+		return sdkerrors.Wrap(ErrInvalidEvidence, "empty chain of external transaction")
+	}
+	hash := strings.TrimPrefix(strings.ToLower(tx.TxHash), "0x")
+	if bz, err := hex.DecodeString(hash); err != nil || len(bz) != 32 {
+		return sdkerrors.Wrapf(ErrInvalidEvidence, "invalid transaction hash %s", tx.TxHash)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (tx ExternalTx) String() string {
+	return fmt.Sprintf("%s:%s", tx.Chain, tx.TxHash)
+}
+
+// ClaimEvidence defines structured evidence attached to a claim proposal.
+type ClaimEvidence struct {
+	// Transactions are transactions of the incident on external chains.
+	Transactions []ExternalTx `json:"transactions" yaml:"transactions"`
+
+	// ContentHashes are IPFS CIDs or SHA-256 hashes of off-chain documents.
+	ContentHashes []string `json:"content_hashes" yaml:"content_hashes"`
+
+	// CertificateIDs are IDs of certificates issued in the cert module.
+	CertificateIDs []uint64 `json:"certificate_ids" yaml:"certificate_ids"`
+}
+
+// NewClaimEvidence returns a new ClaimEvidence instance.
+func NewClaimEvidence(transactions []ExternalTx, contentHashes []string, certificateIDs []uint64) ClaimEvidence {
+	return ClaimEvidence{
+		Transactions:   transactions,
+		ContentHashes:  contentHashes,
+		CertificateIDs: certificateIDs,
+	}
+}
+
+// Empty returns true if no evidence is attached.
+func (e ClaimEvidence) Empty() bool {
+	return len(e.Transactions) == 0 && len(e.ContentHashes) == 0 && len(e.CertificateIDs) == 0
+}
+
+// ValidateBasic runs stateless checks on the format of the evidence.
+func (e ClaimEvidence) ValidateBasic() error {
+	txs := make(map[string]bool)
+	for _, tx := range e.Transactions {
+		if err := tx.ValidateBasic(); err != nil {
+			return err
+		}
+		key := strings.ToLower(tx.Chain) + ":" + strings.TrimPrefix(strings.ToLower(tx.TxHash), "0x")
+		if txs[key] {
+			return sdkerrors.Wrapf(ErrInvalidEvidence, "duplicate transaction %s", tx)
+		}
+		txs[key] = true
+	}
+
+	hashes := make(map[string]bool)
+	for _, hash := range e.ContentHashes {
+		if !IsValidContentHash(hash) {
+			return sdkerrors.Wrapf(ErrInvalidEvidence, "invalid content hash %s", hash)
+		}
+		if hashes[hash] {
+			return sdkerrors.Wrapf(ErrInvalidEvidence, "duplicate content hash %s", hash)
+		}
+		hashes[hash] = true
+	}
+
+	ids := make(map[uint64]bool)
+	for _, id := range e.CertificateIDs {
+		if ids[id] {
+			return sdkerrors.Wrapf(ErrInvalidEvidence, "duplicate certificate ID %d", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (e ClaimEvidence) String() string {
+	txs := make([]string, len(e.Transactions))
+	for i, tx := range e.Transactions {
+		txs[i] = tx.String()
+	}
+	ids := make([]string, len(e.CertificateIDs))
+	for i, id := range e.CertificateIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	return fmt.Sprintf("transactions: [%s], content hashes: [%s], certificates: [%s]",
+		strings.Join(txs, ", "), strings.Join(e.ContentHashes, ", "), strings.Join(ids, ", "))
+}
+
+// IsValidContentHash returns true if the hash is an IPFS CIDv0, a base32
+// IPFS CIDv1 or a hex encoded SHA-256 hash.
+func IsValidContentHash(hash string) bool {
+	if cidV0Regexp.MatchString(hash) || cidV1Regexp.MatchString(hash) {
+		return true
+	}
+	bz, err := hex.DecodeString(hash)
+	return err == nil && len(bz) == 32
+}
+
 // LockedCollateral defines the data type of locked collateral for a claim proposal.
 type LockedCollateral struct {
 	ProposalID uint64  `json:"proposal_id" yaml:"proposal_id"`
@@ -102,6 +305,22 @@ func NewLockedCollateral(proposalID uint64, lockedAmt sdk.Int) LockedCollateral
 	}
 }
 
+// ClaimConversion stores the rates, in bond denom per unit, at which
+// the non-bond denoms of a claim's loss were converted when the claim
+// was submitted.
+type ClaimConversion struct {
+	ProposalID uint64       `json:"proposal_id" yaml:"proposal_id"`
+	Rates      sdk.DecCoins `json:"rates" yaml:"rates"`
+}
+
+// NewClaimConversion returns a new ClaimConversion instance.
+func NewClaimConversion(proposalID uint64, rates sdk.DecCoins) ClaimConversion {
+	return ClaimConversion{
+		ProposalID: proposalID,
+		Rates:      rates,
+	}
+}
+
 // NewUnbondingDelegation returns a new UnbondingDelegation instance.
 func NewUnbondingDelegation(delAddr sdk.AccAddress, valAddr sdk.ValAddress, entry stakingTypes.UnbondingDelegationEntry) staking.UnbondingDelegation {
 	return staking.UnbondingDelegation{
@@ -22,6 +22,10 @@ const (
 	QueryShieldStakingRate   = "shield_staking_rate"
 	QueryReimbursement       = "reimbursement"
 	QueryReimbursements      = "reimbursements"
+	QueryPoolCapacity        = "pool_capacity"
+	QueryProviderStats       = "provider_stats"
+	QueryStakerStats         = "staker_stats"
+	QueryPoolOperators       = "pool_operators"
 )
 
 type QueryResStatus struct {
@@ -45,6 +49,84 @@ func NewQueryResStatus(totalCollateral, totalShield, totalWithdrawing sdk.Int, c
 	}
 }
 
+// QueryResPoolCapacity is the result of the pool capacity query.
+type QueryResPoolCapacity struct {
+	PoolID    uint64  `json:"pool_id" yaml:"pool_id"`
+	Capacity  sdk.Int `json:"capacity" yaml:"capacity"`
+	MaxShield sdk.Int `json:"max_shield" yaml:"max_shield"`
+	Shield    sdk.Int `json:"shield" yaml:"shield"`
+}
+
+// NewQueryResPoolCapacity creates a new instance of QueryResPoolCapacity.
+func NewQueryResPoolCapacity(poolID uint64, capacity, maxShield, shield sdk.Int) QueryResPoolCapacity {
+	return QueryResPoolCapacity{
+		PoolID:    poolID,
+		Capacity:  capacity,
+		MaxShield: maxShield,
+		Shield:    shield,
+	}
+}
+
+// QueryResProviderStats is the result of the provider stats query.
+type QueryResProviderStats struct {
+	Address         sdk.AccAddress   `json:"address" yaml:"address"`
+	Collateral      sdk.Int          `json:"collateral" yaml:"collateral"`
+	Withdrawing     sdk.Int          `json:"withdrawing" yaml:"withdrawing"`
+	Rewards         MixedDecCoins    `json:"rewards" yaml:"rewards"`
+	RealizedRewards sdk.Coins        `json:"realized_rewards" yaml:"realized_rewards"`
+	EstimatedAPR    sdk.Dec          `json:"estimated_apr" yaml:"estimated_apr"`
+	ClaimLocks      []ClaimLock      `json:"claim_locks" yaml:"claim_locks"`
+	Withdraws       Withdraws        `json:"withdraws" yaml:"withdraws"`
+	UnbondingDelays []UnbondingDelay `json:"unbonding_delays" yaml:"unbonding_delays"`
+}
+
+// NewQueryResProviderStats creates a new instance of QueryResProviderStats.
+func NewQueryResProviderStats(provider Provider, estimatedAPR sdk.Dec, claimLocks []ClaimLock, withdraws Withdraws,
+	unbondingDelays []UnbondingDelay) QueryResProviderStats {
+	return QueryResProviderStats{
+		Address:         provider.Address,
+		Collateral:      provider.Collateral,
+		Withdrawing:     provider.Withdrawing,
+		Rewards:         provider.Rewards,
+		RealizedRewards: provider.RealizedRewards,
+		EstimatedAPR:    estimatedAPR,
+		ClaimLocks:      claimLocks,
+		Withdraws:       withdraws,
+		UnbondingDelays: unbondingDelays,
+	}
+}
+
+// QueryResStakerStats is the result of the staker stats query.
+type QueryResStakerStats struct {
+	Address   sdk.AccAddress   `json:"address" yaml:"address"`
+	Stakings  []ShieldStaking  `json:"stakings" yaml:"stakings"`
+	Unstakes  []StakeUnbonding `json:"unstakes" yaml:"unstakes"`
+	Staked    sdk.Int          `json:"staked" yaml:"staked"`
+	Unbonding sdk.Int          `json:"unbonding" yaml:"unbonding"`
+	Rewards   sdk.DecCoins     `json:"rewards" yaml:"rewards"`
+}
+
+// NewQueryResStakerStats creates a new instance of QueryResStakerStats.
+func NewQueryResStakerStats(address sdk.AccAddress, stakings []ShieldStaking, unstakes []StakeUnbonding) QueryResStakerStats {
+	staked, unbonding, rewards := sdk.ZeroInt(), sdk.ZeroInt(), sdk.DecCoins{}
+	for _, staking := range stakings {
+		staked = staked.Add(staking.Amount)
+		rewards = rewards.Add(staking.Rewards...)
+	}
+	for _, unstake := range unstakes {
+		unbonding = unbonding.Add(unstake.Amount)
+		rewards = rewards.Add(unstake.Rewards...)
+	}
+	return QueryResStakerStats{
+		Address:   address,
+		Stakings:  stakings,
+		Unstakes:  unstakes,
+		Staked:    staked,
+		Unbonding: unbonding,
+		Rewards:   rewards,
+	}
+}
+
 // QueryPaginationParams provides basic pagination parameters
 // for queries in shield module.
 type QueryPaginationParams struct {
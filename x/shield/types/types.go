@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +31,14 @@ type Pool struct {
 
 	// Shield is the amount of all active purchased shields.
 	Shield sdk.Int `json:"shield" yaml:"shield"`
+
+	// Sunset means the pool no longer accepts purchases and is closed
+	// once its existing purchases expire.
+	Sunset bool `json:"sunset" yaml:"sunset"`
+
+	// ForeignDeposit is the sponsor's deposit in foreign denoms, used
+	// first to settle claims for losses in those denoms.
+	ForeignDeposit sdk.Coins `json:"foreign_deposit" yaml:"foreign_deposit"`
 }
 
 // NewPool creates a new project pool.
@@ -43,6 +54,21 @@ func NewPool(id uint64, description, sponsor string, sponsorAddress sdk.AccAddre
 	}
 }
 
+// PoolOperator is an account allowed to manage a pool on behalf of
+// the shield admin.
+type PoolOperator struct {
+	PoolID  uint64         `json:"pool_id" yaml:"pool_id"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewPoolOperator creates a new pool operator.
+func NewPoolOperator(poolID uint64, address sdk.AccAddress) PoolOperator {
+	return PoolOperator{
+		PoolID:  poolID,
+		Address: address,
+	}
+}
+
 // Provider tracks total delegation, total collateral, and rewards of a provider.
 type Provider struct {
 	// Address is the address of the provider.
@@ -64,6 +90,16 @@ type Provider struct {
 
 	// Rewards is the pooling rewards to be collected.
 	Rewards MixedDecCoins `json:"rewards" yaml:"rewards"`
+
+	// Allocations restricts the pools underwritten by the provider's
+	// collateral. The collateral backs all pools if it is empty.
+	Allocations []PoolAllocation `json:"allocations,omitempty" yaml:"allocations,omitempty"`
+
+	// Tranche is the risk tranche of the provider's collateral.
+	Tranche Tranche `json:"tranche" yaml:"tranche"`
+
+	// RealizedRewards is the total rewards paid out to the provider.
+	RealizedRewards sdk.Coins `json:"realized_rewards" yaml:"realized_rewards"`
 }
 
 // NewProvider creates a new provider object.
@@ -77,6 +113,112 @@ func NewProvider(addr sdk.AccAddress) Provider {
 	}
 }
 
+// PoolWeight returns the portion of the provider's collateral
+// underwriting the given pool.
+func (p Provider) PoolWeight(poolID uint64) sdk.Dec {
+	if len(p.Allocations) == 0 {
+		return sdk.OneDec()
+	}
+	for _, allocation := range p.Allocations {
+		if allocation.PoolID == poolID {
+			return allocation.Weight
+		}
+	}
+	return sdk.ZeroDec()
+}
+
+// Tranche is the risk tranche of a provider's collateral. Junior
+// collateral absorbs losses before senior collateral and earns a
+// larger share of service fees in return.
+type Tranche byte
+
+const (
+	TrancheSenior Tranche = iota
+	TrancheJunior
+	TrancheNil Tranche = 0xFF
+)
+
+// String returns the string for a tranche.
+func (t Tranche) String() string {
+	switch t {
+	case TrancheSenior:
+		return "Senior"
+	case TrancheJunior:
+		return "Junior"
+	default:
+		return "UnknownTranche"
+	}
+}
+
+// TrancheFromString returns a tranche by parsing a string.
+func TrancheFromString(s string) Tranche {
+	switch strings.ToUpper(s) {
+	case "SENIOR":
+		return TrancheSenior
+	case "JUNIOR":
+		return TrancheJunior
+	default:
+		return TrancheNil
+	}
+}
+
+// PoolAllocation is the weight of a provider's collateral
+// underwriting a pool.
+type PoolAllocation struct {
+	// PoolID is the id of the underwritten pool.
+	PoolID uint64 `json:"pool_id" yaml:"pool_id"`
+
+	// Weight is the portion of the collateral exposed to the pool.
+	Weight sdk.Dec `json:"weight" yaml:"weight"`
+}
+
+// NewPoolAllocation creates a new pool allocation object.
+func NewPoolAllocation(poolID uint64, weight sdk.Dec) PoolAllocation {
+	return PoolAllocation{
+		PoolID: poolID,
+		Weight: weight,
+	}
+}
+
+// PoolAllocations is a collection of pool allocations.
+type PoolAllocations []PoolAllocation
+
+// String implements the Stringer interface.
+func (pas PoolAllocations) String() string {
+	strs := make([]string, len(pas))
+	for i, pa := range pas {
+		strs[i] = fmt.Sprintf("%d:%s", pa.PoolID, pa.Weight)
+	}
+	return strings.Join(strs, ",")
+}
+
+// ParsePoolAllocations parses a comma-separated list of pool allocations
+// in the form of "<pool_id>:<weight>".
+func ParsePoolAllocations(allocationsStr string) (PoolAllocations, error) {
+	allocationsStr = strings.TrimSpace(allocationsStr)
+	if allocationsStr == "" {
+		return PoolAllocations{}, nil
+	}
+
+	var allocations PoolAllocations
+	for _, allocationStr := range strings.Split(allocationsStr, ",") {
+		parts := strings.Split(strings.TrimSpace(allocationStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid pool allocation: %s", allocationStr)
+		}
+		poolID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, NewPoolAllocation(poolID, weight))
+	}
+	return allocations, nil
+}
+
 // Purchase record an individual purchase.
 type Purchase struct {
 	// PurchaseID is the purchase_id.
@@ -164,11 +306,108 @@ func NewWithdraw(addr sdk.AccAddress, amount sdk.Int, completionTime time.Time)
 // Withdraws contains multiple withdraws.
 type Withdraws []Withdraw
 
+// ClaimLock records the collateral of a provider secured for a
+// pending claim.
+type ClaimLock struct {
+	// Address is the address of the provider.
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+
+	// ProposalID is the id of the claim proposal.
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+
+	// PoolID is the id of the pool the claim is made against.
+	PoolID uint64 `json:"pool_id" yaml:"pool_id"`
+
+	// PurchaseID is the id of the purchase the claim is made for.
+	PurchaseID uint64 `json:"purchase_id" yaml:"purchase_id"`
+
+	// Amount is the provider's share of the claimed loss.
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+
+	// EndTime is the time until which the collateral is secured.
+	EndTime time.Time `json:"end_time" yaml:"end_time"`
+}
+
+// NewClaimLock creates a new claim lock object.
+func NewClaimLock(addr sdk.AccAddress, proposalID, poolID, purchaseID uint64, amount sdk.Int, endTime time.Time) ClaimLock {
+	return ClaimLock{
+		Address:    addr,
+		ProposalID: proposalID,
+		PoolID:     poolID,
+		PurchaseID: purchaseID,
+		Amount:     amount,
+		EndTime:    endTime,
+	}
+}
+
+// UnbondingDelay records an unbonding of a provider delayed to
+// secure collateral for pending claims.
+type UnbondingDelay struct {
+	// Address is the address of the provider.
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+
+	// Validator is the address of the validator unbonded from.
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+
+	// Amount is the amount of the delayed unbonding.
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+
+	// CompletionTime is the original completion time of the unbonding.
+	CompletionTime time.Time `json:"completion_time" yaml:"completion_time"`
+
+	// DelayedTime is the delayed completion time of the unbonding.
+	DelayedTime time.Time `json:"delayed_time" yaml:"delayed_time"`
+}
+
+// NewUnbondingDelay creates a new unbonding delay object.
+func NewUnbondingDelay(addr sdk.AccAddress, validator sdk.ValAddress, amount sdk.Int, completionTime, delayedTime time.Time) UnbondingDelay {
+	return UnbondingDelay{
+		Address:        addr,
+		Validator:      validator,
+		Amount:         amount,
+		CompletionTime: completionTime,
+		DelayedTime:    delayedTime,
+	}
+}
+
+// PoolUpdate is a change of a pool's shield limit and service fee
+// deposit scheduled to take effect at a future time.
+type PoolUpdate struct {
+	// PoolID is the id of the pool to be updated.
+	PoolID uint64 `json:"pool_id" yaml:"pool_id"`
+
+	// ShieldLimit is the new shield limit of the pool. The limit is
+	// unchanged if it is zero.
+	ShieldLimit sdk.Int `json:"shield_limit" yaml:"shield_limit"`
+
+	// ServiceFees is the service fee deposit, which is held by the
+	// module until the update takes effect.
+	ServiceFees MixedCoins `json:"service_fees" yaml:"service_fees"`
+
+	// Depositor is the address paying the service fee deposit.
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+
+	// EffectiveTime is the time when the update takes effect.
+	EffectiveTime time.Time `json:"effective_time" yaml:"effective_time"`
+}
+
+// NewPoolUpdate creates a new pool update object.
+func NewPoolUpdate(poolID uint64, shieldLimit sdk.Int, serviceFees MixedCoins, depositor sdk.AccAddress, effectiveTime time.Time) PoolUpdate {
+	return PoolUpdate{
+		PoolID:        poolID,
+		ShieldLimit:   shieldLimit,
+		ServiceFees:   serviceFees,
+		Depositor:     depositor,
+		EffectiveTime: effectiveTime,
+	}
+}
+
 type ShieldStaking struct {
 	PoolID            uint64         `json:"pool_id" yaml:"pool_id"`
 	Purchaser         sdk.AccAddress `json:"purchaser" yaml:"purchaser"`
 	Amount            sdk.Int        `json:"amount" yaml:"amount"`
 	WithdrawRequested sdk.Int        `json:"withdraw_requested" yaml:"withdraw_requested"`
+	Rewards           sdk.DecCoins   `json:"rewards" yaml:"rewards"`
 }
 
 func NewShieldStaking(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int) ShieldStaking {
@@ -177,5 +416,27 @@ func NewShieldStaking(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int) S
 		Purchaser:         purchaser,
 		Amount:            amount,
 		WithdrawRequested: sdk.NewInt(0),
+		Rewards:           sdk.DecCoins{},
+	}
+}
+
+// StakeUnbonding is an unstaked stake-for-shield deposit waiting
+// for maturity, together with its share of accrued block rewards.
+type StakeUnbonding struct {
+	PoolID         uint64         `json:"pool_id" yaml:"pool_id"`
+	Purchaser      sdk.AccAddress `json:"purchaser" yaml:"purchaser"`
+	Amount         sdk.Int        `json:"amount" yaml:"amount"`
+	Rewards        sdk.DecCoins   `json:"rewards" yaml:"rewards"`
+	CompletionTime time.Time      `json:"completion_time" yaml:"completion_time"`
+}
+
+// NewStakeUnbonding creates a new stake unbonding object.
+func NewStakeUnbonding(poolID uint64, purchaser sdk.AccAddress, amount sdk.Int, rewards sdk.DecCoins, completionTime time.Time) StakeUnbonding {
+	return StakeUnbonding{
+		PoolID:         poolID,
+		Purchaser:      purchaser,
+		Amount:         amount,
+		Rewards:        rewards,
+		CompletionTime: completionTime,
 	}
 }
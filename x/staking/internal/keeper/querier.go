@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/certikfoundation/shentu/x/staking/internal/types"
+)
+
+// NewQuerier returns the staking querier, which serves the validators with a current
+// host platform certificate and defers all other queries to the Cosmos SDK querier.
+func NewQuerier(k Keeper, certKeeper types.CertKeeper, cosmosQuerier sdk.Querier) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryPlatformCertifiedValidators:
+			return queryPlatformCertifiedValidators(ctx, req, k, certKeeper)
+		default:
+			return cosmosQuerier(ctx, path, req)
+		}
+	}
+}
+
+// queryPlatformCertifiedValidators returns the validators whose host platform
+// certificate has not expired, optionally filtered by bonding status. Platform
+// certificates do not restrict delegations, so delegators filter with this query.
+func queryPlatformCertifiedValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper, certKeeper types.CertKeeper) ([]byte, error) {
+	var params stakingTypes.QueryValidatorsParams
+	if err := stakingTypes.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validators := k.GetAllValidators(ctx)
+	filteredVals := make([]stakingTypes.Validator, 0, len(validators))
+	for _, val := range validators {
+		if params.Status != "" && !strings.EqualFold(val.GetStatus().String(), params.Status) {
+			continue
+		}
+		if certKeeper.HasCurrentPlatformCertificate(ctx, val.GetConsPubKey()) {
+			filteredVals = append(filteredVals, val)
+		}
+	}
+
+	start, end := client.Paginate(len(filteredVals), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
+	if start < 0 || end < 0 {
+		filteredVals = []stakingTypes.Validator{}
+	} else {
+		filteredVals = filteredVals[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(stakingTypes.ModuleCdc, filteredVals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return res, nil
+}
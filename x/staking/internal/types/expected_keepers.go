@@ -9,4 +9,5 @@ import (
 type CertKeeper interface {
 	GetValidator(ctx sdk.Context, validator crypto.PubKey) ([]byte, bool)
 	IsValidatorCertified(ctx sdk.Context, validator crypto.PubKey) bool
+	HasCurrentPlatformCertificate(ctx sdk.Context, validator crypto.PubKey) bool
 }
@@ -0,0 +1,7 @@
+package types
+
+const (
+	// QueryPlatformCertifiedValidators is the query endpoint for the validators
+	// with a current host platform certificate.
+	QueryPlatformCertifiedValidators = "platform_certified_validators"
+)
@@ -150,7 +150,9 @@ func (am AppModule) NewHandler() sdk.Handler {
 func (am AppModule) QuerierRoute() string { return am.cosmosAppModule.QuerierRoute() }
 
 // NewQuerierHandler returns the staking module sdk.Querier.
-func (am AppModule) NewQuerierHandler() sdk.Querier { return am.cosmosAppModule.NewQuerierHandler() }
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(am.keeper, am.certKeeper, am.cosmosAppModule.NewQuerierHandler())
+}
 
 // InitGenesis performs genesis initialization for the staking module.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {